@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	goLibConfig "github.com/dipdup-net/go-lib/config"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func init() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{
+		Out:        os.Stderr,
+		TimeFormat: "2006-01-02 15:04:05",
+	})
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+}
+
+func parseConfig(configPath string) (config.Config, error) {
+	var cfg config.Config
+	if err := goLibConfig.Parse(configPath, &cfg); err != nil {
+		return cfg, errors.Wrap(err, "parsing config file")
+	}
+	return cfg, nil
+}
+
+func initDatabase(ctx context.Context, configPath string) (postgres.Storage, error) {
+	cfg, err := parseConfig(configPath)
+	if err != nil {
+		return postgres.Storage{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	return postgres.Create(ctx, cfg.Database, cfg.Indexer.ScriptsDir)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	keyLength    = 32
+	prefixLength = 8
+	listLimit    = 1000
+)
+
+func keysCmd(configPath *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage API keys",
+	}
+
+	var (
+		name       string
+		rps        float64
+		dailyQuota int64
+		groups     []string
+	)
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Create API key. The key is printed once and only its hash is stored",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withKeys(cmd.Context(), *configPath, func(keys storage.IApiKey) error {
+				return createKey(cmd.Context(), keys, cmd.OutOrStdout(), storage.ApiKey{
+					Name:       name,
+					Rps:        rps,
+					DailyQuota: dailyQuota,
+					Groups:     groups,
+				})
+			})
+		},
+	}
+	create.Flags().StringVar(&name, "name", "", "name of the key owner")
+	create.Flags().Float64Var(&rps, "rps", 0, "requests per second limit. Zero means unlimited")
+	create.Flags().Int64Var(&dailyQuota, "daily-quota", 0, "requests per day limit. Zero means unlimited")
+	create.Flags().StringSliceVar(&groups, "groups", nil, "allowed endpoint groups, for example: block,tx. All groups are allowed by default")
+	_ = create.MarkFlagRequired("name")
+
+	revoke := &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke API key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "parsing key id")
+			}
+			return withKeys(cmd.Context(), *configPath, func(keys storage.IApiKey) error {
+				if err := keys.Revoke(cmd.Context(), id); err != nil {
+					return err
+				}
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "key %d is revoked\n", id)
+				return err
+			})
+		},
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List API keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withKeys(cmd.Context(), *configPath, func(keys storage.IApiKey) error {
+				return listKeys(cmd.Context(), keys, cmd.OutOrStdout())
+			})
+		},
+	}
+
+	cmd.AddCommand(create, revoke, list)
+	return cmd
+}
+
+func withKeys(ctx context.Context, configPath string, f func(keys storage.IApiKey) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	db, err := initDatabase(ctx, configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return f(db.ApiKey)
+}
+
+func createKey(ctx context.Context, keys storage.IApiKey, w io.Writer, key storage.ApiKey) error {
+	raw := make([]byte, keyLength)
+	if _, err := rand.Read(raw); err != nil {
+		return errors.Wrap(err, "generating key")
+	}
+	secret := hex.EncodeToString(raw)
+
+	key.Prefix = secret[:prefixLength]
+	key.Hash = storage.HashApiKey(secret)
+	key.CreatedAt = time.Now().UTC()
+	if err := keys.Save(ctx, &key); err != nil {
+		return errors.Wrap(err, "saving key")
+	}
+
+	_, err := fmt.Fprintf(w, "id:  %d\nkey: %s\n\nStore the key now. It can't be shown again.\n", key.Id, secret)
+	return err
+}
+
+func listKeys(ctx context.Context, keys storage.IApiKey, w io.Writer) error {
+	items, err := keys.List(ctx, listLimit, 0, sdk.SortOrderAsc)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tPREFIX\tRPS\tDAILY QUOTA\tGROUPS\tREQUESTS\tLAST USED\tSTATUS")
+	for i := range items {
+		status := "active"
+		if items[i].IsRevoked() {
+			status = "revoked"
+		}
+		lastUsed := "-"
+		if items[i].LastUsedAt != nil {
+			lastUsed = items[i].LastUsedAt.UTC().Format(time.RFC3339)
+		}
+		groups := "*"
+		if len(items[i].Groups) > 0 {
+			groups = strings.Join(items[i].Groups, ",")
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%g\t%d\t%s\t%d\t%s\t%s\n",
+			items[i].Id, items[i].Name, items[i].Prefix, items[i].Rps, items[i].DailyQuota,
+			groups, items[i].TotalRequests, lastUsed, status,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := mock.NewMockIApiKey(ctrl)
+
+	var saved *storage.ApiKey
+	keys.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *storage.ApiKey) error {
+			key.Id = 3
+			saved = key
+			return nil
+		}).
+		Times(1)
+
+	var buf bytes.Buffer
+	err := createKey(context.Background(), keys, &buf, storage.ApiKey{
+		Name:   "explorer",
+		Rps:    5,
+		Groups: []string{"block"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+
+	var secret string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, "key: "); ok {
+			secret = value
+		}
+	}
+	require.Len(t, secret, keyLength*2)
+	require.Equal(t, secret[:prefixLength], saved.Prefix)
+	require.Equal(t, storage.HashApiKey(secret), saved.Hash)
+	require.Equal(t, "explorer", saved.Name)
+	require.EqualValues(t, 5, saved.Rps)
+	require.Contains(t, buf.String(), "id:  3")
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "DipDup Verticals | Astria Indexer administration",
+}
+
+func main() {
+	var configPath string
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "dipdup.yml", "path to YAML config file")
+	rootCmd.AddCommand(keysCmd(&configPath))
+	rootCmd.AddCommand(migrateCmd(&configPath))
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Err(err).Msg("command line execute")
+		os.Exit(1)
+	}
+}
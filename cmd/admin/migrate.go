@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/spf13/cobra"
+)
+
+func migrateCmd(configPath *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Run database migrations which aren't applied on start",
+	}
+
+	hypertables := &cobra.Command{
+		Use:   "hypertables",
+		Short: "Convert tables with data to hypertables. Tables are locked until their rows are copied, so indexer and API must be stopped",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			cfg, err := parseConfig(*configPath)
+			if err != nil {
+				return err
+			}
+
+			tables, err := postgres.MigrateHypertables(ctx, cfg.Database)
+			for i := range tables {
+				if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s is converted to hypertable\n", tables[i]); err != nil {
+					return err
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if len(tables) == 0 {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), "nothing to migrate")
+			}
+			return err
+		},
+	}
+
+	rollupSenders := &cobra.Command{
+		Use:   "rollup-senders",
+		Short: "Fill senders of rollup actions indexed before senders were stored. Actions are updated day by day, so indexer can keep running",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			cfg, err := parseConfig(*configPath)
+			if err != nil {
+				return err
+			}
+
+			updated, err := postgres.MigrateRollupActionSenders(ctx, cfg.Database)
+			if _, printErr := fmt.Fprintf(cmd.OutOrStdout(), "%d rollup actions are updated\n", updated); printErr != nil && err == nil {
+				err = printErr
+			}
+			return err
+		},
+	}
+
+	cmd.AddCommand(hypertables, rollupSenders)
+	return cmd
+}
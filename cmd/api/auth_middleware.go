@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/subtle"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+// ApiKeyMiddleware - allows requests containing configured key in the X-API-Key header only
+func ApiKeyMiddleware(key string) echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		KeyLookup: "header:" + apiKeyHeader,
+		Validator: func(value string, _ echo.Context) (bool, error) {
+			return subtle.ConstantTimeCompare([]byte(value), []byte(key)) == 1, nil
+		},
+	})
+}
@@ -17,8 +17,10 @@ import (
 )
 
 type Dispatcher struct {
-	listener storage.Listener
-	blocks   storage.IBlock
+	listener   storage.Listener
+	blocks     storage.IBlock
+	txs        storage.ITx
+	watchlists storage.IWatchlist
 
 	mx        *sync.RWMutex
 	observers []*Observer
@@ -29,17 +31,21 @@ type Dispatcher struct {
 func NewDispatcher(
 	factory storage.ListenerFactory,
 	blocks storage.IBlock,
+	txs storage.ITx,
+	watchlists storage.IWatchlist,
 ) (*Dispatcher, error) {
 	if factory == nil {
 		return nil, errors.New("nil listener factory")
 	}
 	listener := factory.CreateListener()
 	return &Dispatcher{
-		listener:  listener,
-		blocks:    blocks,
-		observers: make([]*Observer, 0),
-		mx:        new(sync.RWMutex),
-		g:         workerpool.NewGroup(),
+		listener:   listener,
+		blocks:     blocks,
+		txs:        txs,
+		watchlists: watchlists,
+		observers:  make([]*Observer, 0),
+		mx:         new(sync.RWMutex),
+		g:          workerpool.NewGroup(),
 	}, nil
 }
 
@@ -55,7 +61,14 @@ func (d *Dispatcher) Observe(channels ...string) *Observer {
 }
 
 func (d *Dispatcher) Start(ctx context.Context) {
-	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock); err != nil {
+	channels := []string{storage.ChannelHead, storage.ChannelBlock}
+	if d.txs != nil {
+		channels = append(channels, storage.ChannelTx)
+	}
+	if d.watchlists != nil {
+		channels = append(channels, storage.ChannelAlert)
+	}
+	if err := d.listener.Subscribe(ctx, channels...); err != nil {
 		log.Err(err).Msg("subscribe on postgres notifications")
 		return
 	}
@@ -108,6 +121,18 @@ func (d *Dispatcher) handleNotification(ctx context.Context, notification *pq.No
 		return d.handleBlock(ctx, id)
 	case storage.ChannelHead:
 		return d.handleHead(ctx, notification.Extra)
+	case storage.ChannelTx:
+		id, err := strconv.ParseUint(notification.Extra, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse tx id: %s", notification.Extra)
+		}
+		return d.handleTx(ctx, id)
+	case storage.ChannelAlert:
+		id, err := strconv.ParseUint(notification.Extra, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse alert id: %s", notification.Extra)
+		}
+		return d.handleAlert(ctx, id)
 	default:
 		return errors.Errorf("unknown channel name: %s", notification.Channel)
 	}
@@ -126,6 +151,19 @@ func (d *Dispatcher) handleBlock(ctx context.Context, id uint64) error {
 	return nil
 }
 
+func (d *Dispatcher) handleTx(ctx context.Context, id uint64) error {
+	tx, err := d.txs.ByIdWithRelations(ctx, id)
+	if err != nil {
+		return err
+	}
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyTxs(&tx)
+	}
+	d.mx.RUnlock()
+	return nil
+}
+
 func (d *Dispatcher) handleHead(ctx context.Context, msg string) error {
 	var state storage.State
 	if err := json.Unmarshal([]byte(msg), &state); err != nil {
@@ -139,3 +177,17 @@ func (d *Dispatcher) handleHead(ctx context.Context, msg string) error {
 	d.mx.RUnlock()
 	return nil
 }
+
+func (d *Dispatcher) handleAlert(ctx context.Context, id uint64) error {
+	alert, err := d.watchlists.Alert(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyAlerts(&alert)
+	}
+	d.mx.RUnlock()
+	return nil
+}
@@ -11,9 +11,13 @@ import (
 type Observer struct {
 	blocks chan *storage.Block
 	head   chan *storage.State
+	alerts chan *storage.WatchlistAlert
+	txs    chan *storage.Tx
 
 	listenHead   bool
 	listenBlocks bool
+	listenAlerts bool
+	listenTxs    bool
 
 	g workerpool.Group
 }
@@ -26,6 +30,8 @@ func NewObserver(channels ...string) *Observer {
 	observer := &Observer{
 		blocks: make(chan *storage.Block, 1024),
 		head:   make(chan *storage.State, 1024),
+		alerts: make(chan *storage.WatchlistAlert, 1024),
+		txs:    make(chan *storage.Tx, 1024),
 		g:      workerpool.NewGroup(),
 	}
 
@@ -35,6 +41,10 @@ func NewObserver(channels ...string) *Observer {
 			observer.listenBlocks = true
 		case storage.ChannelHead:
 			observer.listenHead = true
+		case storage.ChannelAlert:
+			observer.listenAlerts = true
+		case storage.ChannelTx:
+			observer.listenTxs = true
 		}
 	}
 
@@ -45,6 +55,8 @@ func (observer Observer) Close() error {
 	observer.g.Wait()
 	close(observer.blocks)
 	close(observer.head)
+	close(observer.alerts)
+	close(observer.txs)
 	return nil
 }
 
@@ -59,6 +71,18 @@ func (observer Observer) notifyState(state *storage.State) {
 	}
 }
 
+func (observer Observer) notifyAlerts(alert *storage.WatchlistAlert) {
+	if observer.listenAlerts {
+		observer.alerts <- alert
+	}
+}
+
+func (observer Observer) notifyTxs(tx *storage.Tx) {
+	if observer.listenTxs {
+		observer.txs <- tx
+	}
+}
+
 func (observer Observer) Blocks() <-chan *storage.Block {
 	return observer.blocks
 }
@@ -66,3 +90,11 @@ func (observer Observer) Blocks() <-chan *storage.Block {
 func (observer Observer) Head() <-chan *storage.State {
 	return observer.head
 }
+
+func (observer Observer) Alerts() <-chan *storage.WatchlistAlert {
+	return observer.alerts
+}
+
+func (observer Observer) Txs() <-chan *storage.Tx {
+	return observer.txs
+}
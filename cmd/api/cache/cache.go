@@ -4,40 +4,96 @@
 package cache
 
 import (
+	"container/list"
 	"context"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-io/workerpool"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
+const defaultMaxBytes = 64 * 1024 * 1024
+
+// Rule - caching policy of the route.
+//
+// Immutable responses (finalized blocks, transactions) are kept until they are evicted by size limit.
+// Mutable responses are kept for TTL. Zero TTL means the response expires with the next head.
+type Rule struct {
+	Immutable bool
+	TTL       time.Duration
+}
+
+type Config struct {
+	// MaxBytes - limit of total size of cached responses in bytes
+	MaxBytes int64
+	// MaxEntitiesCount - limit of cached responses count. Zero means unlimited.
+	MaxEntitiesCount int
+	// Rules - caching policies by route path, for example `/v1/block/:height`.
+	// Routes without rule are cached till the next head.
+	Rules map[string]Rule
+}
+
+type item struct {
+	key       string
+	data      []byte
+	immutable bool
+	height    types.Level
+	expiresAt time.Time
+}
+
+func (i *item) size() int64 {
+	return int64(len(i.key) + len(i.data))
+}
+
+// Cache - LRU cache of responses bounded by total size. It's aware of the current head:
+// mutable entries cached under the previous head are expired without scanning the cache.
 type Cache struct {
+	maxBytes         int64
 	maxEntitiesCount int
+	rules            map[string]Rule
 	observer         *bus.Observer
+	metrics          *metrics
 
-	m     map[string][]byte
-	queue []string
-	mx    *sync.RWMutex
+	m     map[string]*list.Element
+	lru   *list.List
+	bytes int64
+	head  types.Level
+	mx    *sync.Mutex
 	g     workerpool.Group
-}
 
-type Config struct {
-	MaxEntitiesCount int
+	now func() time.Time
 }
 
 func NewCache(cfg Config, observer *bus.Observer) *Cache {
-	return &Cache{
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = defaultMaxBytes
+	}
+	rules := cfg.Rules
+	if rules == nil {
+		rules = make(map[string]Rule)
+	}
+	c := &Cache{
+		maxBytes:         cfg.MaxBytes,
 		maxEntitiesCount: cfg.MaxEntitiesCount,
+		rules:            rules,
 		observer:         observer,
-		m:                make(map[string][]byte),
-		queue:            make([]string, cfg.MaxEntitiesCount),
-		mx:               new(sync.RWMutex),
+		m:                make(map[string]*list.Element),
+		lru:              list.New(),
+		mx:               new(sync.Mutex),
 		g:                workerpool.NewGroup(),
+		now:              time.Now,
 	}
+	c.metrics = newMetrics(c)
+	return c
 }
 
 func (c *Cache) Start(ctx context.Context) {
@@ -49,8 +105,11 @@ func (c *Cache) listen(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case <-c.observer.Head():
-			c.Clear()
+		case state, ok := <-c.observer.Head():
+			if !ok {
+				return
+			}
+			c.SetHead(state.LastHeight)
 		}
 	}
 }
@@ -60,40 +119,139 @@ func (c *Cache) Close() error {
 	return nil
 }
 
+// Collectors - returns prometheus collectors of the cache. They should be registered by the caller.
+func (c *Cache) Collectors() []prometheus.Collector {
+	return c.metrics.collectors()
+}
+
+// Head - returns height of the current head
+func (c *Cache) Head() types.Level {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.head
+}
+
+// SetHead - updates the current head. Mutable entries cached till the next head become expired.
+// If height decreases (rollback) the whole cache is cleared because immutable entries may be rolled back too.
+func (c *Cache) SetHead(height types.Level) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if height < c.head {
+		c.clear()
+	}
+	c.head = height
+}
+
+// Rule - returns caching policy of the route
+func (c *Cache) Rule(path string) Rule {
+	return c.rules[path]
+}
+
+// RequestRule - returns caching policy of the request. Responses of the head and heights above it can't be immutable:
+// the block is not indexed yet or can still be rolled back, so the response will change.
+func (c *Cache) RequestRule(ctx echo.Context) Rule {
+	rule := c.Rule(ctx.Path())
+	if !rule.Immutable {
+		return rule
+	}
+	if value := ctx.Param("height"); value != "" {
+		height, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || height <= 0 || types.Level(height) >= c.Head() {
+			rule.Immutable = false
+		}
+	}
+	return rule
+}
+
 func (c *Cache) Get(key string) ([]byte, bool) {
-	c.mx.RLock()
-	data, ok := c.m[key]
-	c.mx.RUnlock()
-	return data, ok
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	elem, ok := c.m[key]
+	if !ok {
+		c.metrics.misses.Inc()
+		return nil, false
+	}
+	i := elem.Value.(*item)
+	if c.expired(i) {
+		c.remove(elem)
+		c.metrics.evictions.WithLabelValues("expired").Inc()
+		c.metrics.misses.Inc()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	c.metrics.hits.Inc()
+	return i.data, true
 }
 
-func (c *Cache) Set(key string, data []byte) {
+func (c *Cache) expired(i *item) bool {
+	switch {
+	case i.immutable:
+		return false
+	case !i.expiresAt.IsZero():
+		return !c.now().Before(i.expiresAt)
+	default:
+		return i.height != c.head
+	}
+}
+
+func (c *Cache) Set(key string, data []byte, rule Rule) {
 	c.mx.Lock()
-	queueIdx := len(c.m)
-	if _, ok := c.m[key]; ok {
-		c.m[key] = data
+	defer c.mx.Unlock()
+
+	i := &item{
+		key:       key,
+		data:      data,
+		immutable: rule.Immutable,
+		height:    c.head,
+	}
+	if !rule.Immutable && rule.TTL > 0 {
+		i.expiresAt = c.now().Add(rule.TTL)
+	}
+	if i.size() > c.maxBytes {
+		return
+	}
+
+	if elem, ok := c.m[key]; ok {
+		c.bytes -= elem.Value.(*item).size()
+		elem.Value = i
+		c.lru.MoveToFront(elem)
 	} else {
-		c.m[key] = data
-		if queueIdx == c.maxEntitiesCount {
-			keyForRemove := c.queue[queueIdx-1]
-			c.queue = append([]string{key}, c.queue[:queueIdx-1]...)
-			delete(c.m, keyForRemove)
-		} else {
-			c.queue[queueIdx] = key
-		}
+		c.m[key] = c.lru.PushFront(i)
 	}
-	c.mx.Unlock()
+	c.bytes += i.size()
+
+	for c.bytes > c.maxBytes || (c.maxEntitiesCount > 0 && c.lru.Len() > c.maxEntitiesCount) {
+		c.remove(c.lru.Back())
+		c.metrics.evictions.WithLabelValues("size").Inc()
+	}
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	i := c.lru.Remove(elem).(*item)
+	delete(c.m, i.key)
+	c.bytes -= i.size()
 }
 
 func (c *Cache) Clear() {
 	c.mx.Lock()
-	for key := range c.m {
-		delete(c.m, key)
-	}
-	c.queue = make([]string, c.maxEntitiesCount)
+	c.clear()
 	c.mx.Unlock()
 }
 
+func (c *Cache) clear() {
+	c.m = make(map[string]*list.Element)
+	c.lru.Init()
+	c.bytes = 0
+}
+
+func (c *Cache) stats() (int, int64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.lru.Len(), c.bytes
+}
+
 type CacheMiddleware struct {
 	cache   *Cache
 	skipper middleware.Skipper
@@ -114,7 +272,7 @@ func (m *CacheMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 				return next(c)
 			}
 		}
-		path := c.Request().URL.String()
+		path := ratelimit.StripApiKey(c.Request().URL).String()
 
 		if data, ok := m.cache.Get(path); ok {
 			entry := new(CacheEntry)
@@ -124,28 +282,34 @@ func (m *CacheMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 			return entry.Replay(c.Response())
 		}
 
+		rule := m.cache.RequestRule(c)
+
 		recorder := NewResponseRecorder(c.Response().Writer)
 		c.Response().Writer = recorder
 
 		if err := next(c); err != nil {
 			return err
 		}
-		return m.cacheResult(path, recorder)
+		return m.cacheResult(path, recorder, rule)
 	}
 }
 
-func (m *CacheMiddleware) cacheResult(key string, r *ResponseRecorder) error {
+func (m *CacheMiddleware) cacheResult(key string, r *ResponseRecorder, rule Rule) error {
 	result := r.Result()
 	if !m.isStatusCacheable(result) {
 		return nil
 	}
+	// empty response of not indexed entity will change, so it expires like mutable responses
+	if result.StatusCode != http.StatusOK {
+		rule.Immutable = false
+	}
 
 	data, err := result.Encode()
 	if err != nil {
 		return errors.Wrap(err, "unable to read recorded response")
 	}
 
-	m.cache.Set(key, data)
+	m.cache.Set(key, data, rule)
 	return nil
 }
 
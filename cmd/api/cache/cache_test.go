@@ -5,16 +5,20 @@ package cache
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 )
 
 func TestCache_SetGet(t *testing.T) {
 	t.Run("set and get key from cache", func(t *testing.T) {
 		c := NewCache(Config{MaxEntitiesCount: 2}, nil)
-		c.Set("test", []byte{0, 1, 2, 3})
+		c.Set("test", []byte{0, 1, 2, 3}, Rule{})
 
 		got, ok := c.Get("test")
 		require.True(t, ok)
@@ -24,13 +28,13 @@ func TestCache_SetGet(t *testing.T) {
 		require.False(t, exists)
 	})
 
-	t.Run("overflow set queue", func(t *testing.T) {
+	t.Run("overflow entities count", func(t *testing.T) {
 		c := NewCache(Config{MaxEntitiesCount: 2}, nil)
 		for i := 0; i < 100; i++ {
-			c.Set(fmt.Sprintf("%d", i), []byte{byte(i)})
+			c.Set(fmt.Sprintf("%d", i), []byte{byte(i)}, Rule{})
 		}
 
-		require.Len(t, c.queue, 2)
+		require.Equal(t, 2, c.lru.Len())
 		require.Len(t, c.m, 2)
 
 		got, ok := c.Get("99")
@@ -45,6 +49,40 @@ func TestCache_SetGet(t *testing.T) {
 		require.False(t, exists)
 	})
 
+	t.Run("overflow bytes", func(t *testing.T) {
+		c := NewCache(Config{MaxBytes: 20}, nil)
+		c.Set("a", make([]byte, 9), Rule{})
+		c.Set("b", make([]byte, 9), Rule{})
+		require.EqualValues(t, 20, c.bytes)
+
+		// touch `a` to make `b` least recently used
+		_, ok := c.Get("a")
+		require.True(t, ok)
+
+		c.Set("c", make([]byte, 4), Rule{})
+		require.EqualValues(t, 15, c.bytes)
+
+		_, ok = c.Get("b")
+		require.False(t, ok)
+		_, ok = c.Get("a")
+		require.True(t, ok)
+		_, ok = c.Get("c")
+		require.True(t, ok)
+
+		c.Set("too_big", make([]byte, 100), Rule{})
+		_, ok = c.Get("too_big")
+		require.False(t, ok)
+		require.EqualValues(t, 15, c.bytes)
+	})
+
+	t.Run("replace entry", func(t *testing.T) {
+		c := NewCache(Config{MaxBytes: 100}, nil)
+		c.Set("a", make([]byte, 10), Rule{})
+		c.Set("a", make([]byte, 5), Rule{})
+		require.EqualValues(t, 6, c.bytes)
+		require.Equal(t, 1, c.lru.Len())
+	})
+
 	t.Run("overflow set queue multithread", func(t *testing.T) {
 		c := NewCache(Config{MaxEntitiesCount: 2}, nil)
 
@@ -55,30 +93,159 @@ func TestCache_SetGet(t *testing.T) {
 			go func(c *Cache, wg *sync.WaitGroup) {
 				defer wg.Done()
 				for i := 0; i < 100; i++ {
-					c.Set(fmt.Sprintf("%d", i), []byte{byte(i)})
+					c.Set(fmt.Sprintf("%d", i), []byte{byte(i)}, Rule{})
+					c.Get(fmt.Sprintf("%d", i-1))
 				}
 			}(c, &wg)
 		}
 
 		wg.Wait()
 
-		require.Len(t, c.queue, 2)
+		require.Equal(t, 2, c.lru.Len())
 		require.Len(t, c.m, 2)
 	})
 }
 
+func TestCache_Head(t *testing.T) {
+	t.Run("mutable entries expire with head", func(t *testing.T) {
+		c := NewCache(Config{}, nil)
+		c.SetHead(100)
+		c.Set("mutable", []byte{1}, Rule{})
+		c.Set("immutable", []byte{2}, Rule{Immutable: true})
+
+		_, ok := c.Get("mutable")
+		require.True(t, ok)
+
+		c.SetHead(101)
+
+		_, ok = c.Get("mutable")
+		require.False(t, ok)
+		got, ok := c.Get("immutable")
+		require.True(t, ok)
+		require.Equal(t, []byte{2}, got)
+		require.Equal(t, 1, c.lru.Len())
+	})
+
+	t.Run("ttl entries survive head", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		c := NewCache(Config{}, nil)
+		c.now = func() time.Time { return now }
+		c.Set("ttl", []byte{1}, Rule{TTL: time.Minute})
+
+		c.SetHead(101)
+		_, ok := c.Get("ttl")
+		require.True(t, ok)
+
+		now = now.Add(time.Minute)
+		_, ok = c.Get("ttl")
+		require.False(t, ok)
+	})
+
+	t.Run("rollback clears cache", func(t *testing.T) {
+		c := NewCache(Config{}, nil)
+		c.SetHead(100)
+		c.Set("immutable", []byte{2}, Rule{Immutable: true})
+
+		c.SetHead(99)
+		_, ok := c.Get("immutable")
+		require.False(t, ok)
+		require.EqualValues(t, 0, c.bytes)
+	})
+}
+
 func TestCache_Clear(t *testing.T) {
 	t.Run("set and get key from cache", func(t *testing.T) {
 		c := NewCache(Config{MaxEntitiesCount: 100}, nil)
 		for i := 0; i < 100; i++ {
-			c.Set(fmt.Sprintf("%d", i), []byte{byte(i)})
+			c.Set(fmt.Sprintf("%d", i), []byte{byte(i)}, Rule{})
 		}
 		c.Clear()
 
-		require.Len(t, c.queue, 100)
-		for i := 0; i < 100; i++ {
-			require.EqualValues(t, c.queue[i], "")
-		}
+		require.Equal(t, 0, c.lru.Len())
 		require.Len(t, c.m, 0)
+		require.EqualValues(t, 0, c.bytes)
 	})
 }
+
+func TestCacheMiddleware(t *testing.T) {
+	c := NewCache(Config{
+		Rules: map[string]Rule{
+			"/v1/block/:height": {Immutable: true},
+		},
+	}, nil)
+	c.SetHead(100)
+
+	var calls int
+	e := echo.New()
+	e.Use(Middleware(c, nil))
+	v1 := e.Group("v1")
+	v1.GET("/block/:height", func(ctx echo.Context) error {
+		calls++
+		return ctx.String(http.StatusOK, ctx.Param("height"))
+	})
+
+	request := func(path string) string {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	require.Equal(t, "99", request("/v1/block/99"))
+	require.Equal(t, "100", request("/v1/block/100"))
+	require.Equal(t, "101", request("/v1/block/101"))
+	require.Equal(t, 3, calls)
+
+	c.SetHead(101)
+
+	require.Equal(t, "99", request("/v1/block/99"))
+	require.Equal(t, 3, calls, "finalized block is served from cache")
+
+	require.Equal(t, "100", request("/v1/block/100"))
+	require.Equal(t, 4, calls, "head block was cached till the next head")
+
+	require.Equal(t, "101", request("/v1/block/101"))
+	require.Equal(t, 5, calls, "block above head was cached till the next head")
+}
+
+func TestCacheMiddleware_NoContent(t *testing.T) {
+	c := NewCache(Config{
+		Rules: map[string]Rule{
+			"/v1/tx/:hash": {Immutable: true},
+		},
+	}, nil)
+	c.SetHead(100)
+
+	var (
+		calls   int
+		indexed bool
+	)
+	e := echo.New()
+	e.Use(Middleware(c, nil))
+	v1 := e.Group("v1")
+	v1.GET("/tx/:hash", func(ctx echo.Context) error {
+		calls++
+		if !indexed {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		return ctx.String(http.StatusOK, ctx.Param("hash"))
+	})
+
+	request := func(path string) int {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusNoContent, request("/v1/tx/abcd"))
+	require.Equal(t, http.StatusNoContent, request("/v1/tx/abcd"))
+	require.Equal(t, 1, calls, "unknown tx is cached till the next head")
+
+	indexed = true
+	c.SetHead(101)
+
+	require.Equal(t, http.StatusOK, request("/v1/tx/abcd"))
+	require.Equal(t, 2, calls)
+	require.Equal(t, http.StatusOK, request("/v1/tx/abcd"))
+	require.Equal(t, 2, calls, "indexed tx is immutable")
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	immutableCacheControl = "public, max-age=31536000, immutable"
+	gzipScheme            = "gzip"
+)
+
+type HeadersConfig struct {
+	// MaxAge - lifetime of mutable responses without TTL in the rule. Usually it's the block period.
+	MaxAge time.Duration
+}
+
+// HeadersMiddleware - sets `ETag` and `Cache-Control` headers of successful responses and answers
+// `If-None-Match` requests with `304 Not Modified`. Immutable responses (see Rule) are marked as long-lived.
+// The middleware buffers the whole response, so streaming endpoints have to be skipped.
+// `Last-Modified` isn't set: the middleware doesn't know time of blocks behind the route and responses served from cache
+// keep only the body. Validation by `ETag` is enough, clients prefer `If-None-Match` over `If-Modified-Since` if both are available.
+func HeadersMiddleware(cache *Cache, cfg HeadersConfig, skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+
+			res := c.Response()
+			original := res.Writer
+			buffer := &bufferedWriter{ResponseWriter: original}
+			res.Writer = buffer
+
+			err := next(c)
+			res.Writer = original
+			if err != nil || buffer.status() != http.StatusOK {
+				if flushErr := buffer.writeTo(original); err == nil {
+					err = flushErr
+				}
+				return err
+			}
+
+			header := res.Header()
+			etag := makeETag(buffer.body.Bytes(), isEncoded(c))
+			header.Set(echo.HeaderCacheControl, cacheControl(cache.RequestRule(c), cfg.MaxAge))
+			header.Set("ETag", etag)
+
+			if matchETag(c.Request().Header.Get("If-None-Match"), etag) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				res.Status = http.StatusNotModified
+				original.WriteHeader(http.StatusNotModified)
+				return nil
+			}
+			return buffer.writeTo(original)
+		}
+	}
+}
+
+func cacheControl(rule Rule, maxAge time.Duration) string {
+	switch {
+	case rule.Immutable:
+		return immutableCacheControl
+	case rule.TTL > 0:
+		maxAge = rule.TTL
+	}
+	return fmt.Sprintf("public, max-age=%d", int64(maxAge.Seconds()))
+}
+
+// isEncoded - returns true if the response will be compressed by gzip middleware. Compressed and plain
+// representations have different bytes, so they must have different strong ETags.
+func isEncoded(c echo.Context) bool {
+	if !strings.Contains(c.Request().Header.Get(echo.HeaderAcceptEncoding), gzipScheme) {
+		return false
+	}
+	for _, value := range c.Response().Header().Values(echo.HeaderVary) {
+		if strings.Contains(value, echo.HeaderAcceptEncoding) {
+			return true
+		}
+	}
+	return false
+}
+
+func makeETag(body []byte, encoded bool) string {
+	hash := sha256.Sum256(body)
+	tag := hex.EncodeToString(hash[:16])
+	if encoded {
+		tag += "-" + gzipScheme
+	}
+	return `"` + tag + `"`
+}
+
+// matchETag - weak comparison of `If-None-Match` header value with the entity tag as RFC 9110 requires
+func matchETag(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+type bufferedWriter struct {
+	http.ResponseWriter
+
+	code int
+	body bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.code = code
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// Flush - does nothing: the response is written after the handler returns
+func (w *bufferedWriter) Flush() {}
+
+func (w *bufferedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *bufferedWriter) status() int {
+	if w.code == 0 {
+		return http.StatusOK
+	}
+	return w.code
+}
+
+func (w *bufferedWriter) writeTo(rw http.ResponseWriter) error {
+	if w.code == 0 && w.body.Len() == 0 {
+		return nil
+	}
+	rw.WriteHeader(w.status())
+	if w.body.Len() == 0 {
+		return nil
+	}
+	_, err := rw.Write(w.body.Bytes())
+	return err
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func newHeadersTestServer(c *Cache, gzip bool) *echo.Echo {
+	e := echo.New()
+	if gzip {
+		e.Use(middleware.Gzip())
+	}
+	e.Use(HeadersMiddleware(c, HeadersConfig{MaxAge: 15 * time.Second}, nil))
+	v1 := e.Group("v1")
+	v1.GET("/block/:height", func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, echo.Map{"height": ctx.Param("height")})
+	})
+	v1.GET("/constants", func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, echo.Map{"name": "value"})
+	})
+	v1.GET("/tx/:hash", func(ctx echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	})
+	return e
+}
+
+func TestHeadersMiddleware(t *testing.T) {
+	c := NewCache(Config{
+		Rules: map[string]Rule{
+			"/v1/block/:height": {Immutable: true},
+			"/v1/tx/:hash":      {Immutable: true},
+			"/v1/constants":     {TTL: time.Hour},
+		},
+	}, nil)
+	c.SetHead(101)
+	e := newHeadersTestServer(c, false)
+
+	request := func(path string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for key := range header {
+			req.Header.Set(key, header.Get(key))
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("immutable block", func(t *testing.T) {
+		rec := request("/v1/block/100", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, immutableCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+		etag := rec.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		require.JSONEq(t, `{"height":"100"}`, rec.Body.String())
+
+		rec = request("/v1/block/100", http.Header{"If-None-Match": []string{etag}})
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Empty(t, rec.Body.String())
+		require.Equal(t, etag, rec.Header().Get("ETag"))
+
+		rec = request("/v1/block/100", http.Header{"If-None-Match": []string{`"other", W/` + etag}})
+		require.Equal(t, http.StatusNotModified, rec.Code)
+
+		rec = request("/v1/block/100", http.Header{"If-None-Match": []string{`"other"`}})
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("head block", func(t *testing.T) {
+		rec := request("/v1/block/101", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "public, max-age=15", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("block above head", func(t *testing.T) {
+		rec := request("/v1/block/102", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "public, max-age=15", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("ttl route", func(t *testing.T) {
+		rec := request("/v1/constants", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "public, max-age=3600", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		rec := request("/v1/tx/unknown", nil)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Empty(t, rec.Header().Get("ETag"))
+		require.Empty(t, rec.Header().Get(echo.HeaderCacheControl))
+	})
+}
+
+func TestHeadersMiddleware_Gzip(t *testing.T) {
+	c := NewCache(Config{}, nil)
+	e := newHeadersTestServer(c, true)
+
+	request := func(header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/constants", nil)
+		for key := range header {
+			req.Header.Set(key, header.Get(key))
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	plain := request(nil)
+	require.Equal(t, http.StatusOK, plain.Code)
+
+	compressed := request(http.Header{echo.HeaderAcceptEncoding: []string{"gzip"}})
+	require.Equal(t, http.StatusOK, compressed.Code)
+	require.Equal(t, "gzip", compressed.Header().Get(echo.HeaderContentEncoding))
+	require.NotEqual(t, plain.Header().Get("ETag"), compressed.Header().Get("ETag"))
+
+	notModified := request(http.Header{
+		echo.HeaderAcceptEncoding: []string{"gzip"},
+		"If-None-Match":           []string{compressed.Header().Get("ETag")},
+	})
+	require.Equal(t, http.StatusNotModified, notModified.Code)
+	require.Empty(t, notModified.Header().Get(echo.HeaderContentEncoding))
+	require.Empty(t, notModified.Body.Bytes())
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "astria_api"
+	metricsSubsystem = "cache"
+)
+
+// metrics - response cache metrics. Collectors should be registered by the caller, see Cache.Collectors.
+type metrics struct {
+	hits      prometheus.Counter
+	misses    prometheus.Counter
+	evictions *prometheus.CounterVec
+	entries   prometheus.GaugeFunc
+	bytes     prometheus.GaugeFunc
+}
+
+func newMetrics(cache *Cache) *metrics {
+	return &metrics{
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "hits_total",
+			Help:      "Count of responses served from cache",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "misses_total",
+			Help:      "Count of cache lookups without valid entry",
+		}),
+		evictions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "evictions_total",
+			Help:      "Count of removed cache entries by reason",
+		}, []string{"reason"}),
+		entries: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "entries",
+			Help:      "Count of cached responses",
+		}, func() float64 {
+			count, _ := cache.stats()
+			return float64(count)
+		}),
+		bytes: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "bytes",
+			Help:      "Total size of cached responses in bytes",
+		}, func() float64 {
+			_, size := cache.stats()
+			return float64(size)
+		}),
+	}
+}
+
+func (m *metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.hits,
+		m.misses,
+		m.evictions,
+		m.entries,
+		m.bytes,
+	}
+}
@@ -19,11 +19,56 @@ type Config struct {
 }
 
 type ApiConfig struct {
-	Bind           string  `validate:"required,hostname_port" yaml:"bind"`
-	RateLimit      float64 `validate:"omitempty,min=0"        yaml:"rate_limit"`
-	Prometheus     bool    `validate:"omitempty"              yaml:"prometheus"`
-	RequestTimeout int     `validate:"omitempty,min=1"        yaml:"request_timeout"`
-	BlobReceiver   string  `validate:"required"               yaml:"blob_receiver"`
-	SentryDsn      string  `validate:"omitempty"              yaml:"sentry_dsn"`
-	Websocket      bool    `validate:"omitempty"              yaml:"websocket"`
+	Bind            string          `validate:"required,hostname_port" yaml:"bind"`
+	RateLimit       float64         `validate:"omitempty,min=0"        yaml:"rate_limit"`
+	Prometheus      bool            `validate:"omitempty"              yaml:"prometheus"`
+	RequestTimeout  int             `validate:"omitempty,min=1"        yaml:"request_timeout"`
+	BlobReceiver    string          `validate:"required"               yaml:"blob_receiver"`
+	SentryDsn       string          `validate:"omitempty"              yaml:"sentry_dsn"`
+	Websocket       bool            `validate:"omitempty"              yaml:"websocket"`
+	WebsocketLimits WebsocketLimits `validate:"omitempty"              yaml:"websocket_limits"`
+	Export          ExportLimits    `validate:"omitempty"              yaml:"export"`
+	ApiKey          string          `validate:"omitempty"              yaml:"api_key"`
+	ApiKeys         ApiKeys         `validate:"omitempty"              yaml:"api_keys"`
+	Cache           Cache           `validate:"omitempty"              yaml:"cache"`
+	GraphQL         GraphQL         `validate:"omitempty"              yaml:"graphql"`
+	Grpc            Grpc            `validate:"omitempty"              yaml:"grpc"`
+	RollupRegistry  string          `validate:"omitempty"              yaml:"rollup_registry"`
+}
+
+type GraphQL struct {
+	Enabled       bool `validate:"omitempty"       yaml:"enabled"`
+	MaxDepth      int  `validate:"omitempty,min=1" yaml:"max_depth"`
+	MaxComplexity int  `validate:"omitempty,min=1" yaml:"max_complexity"`
+}
+
+type Grpc struct {
+	Enabled bool   `validate:"omitempty"                                        yaml:"enabled"`
+	Bind    string `validate:"required_if=Enabled true,omitempty,hostname_port" yaml:"bind"`
+}
+
+type WebsocketLimits struct {
+	QueueSize           int `validate:"omitempty,min=1" yaml:"queue_size"`
+	MaxConnectionsPerIp int `validate:"omitempty,min=1" yaml:"max_connections_per_ip"`
+	WriteTimeout        int `validate:"omitempty,min=1" yaml:"write_timeout"`
+}
+
+type ExportLimits struct {
+	Timeout             int `validate:"omitempty,min=1" yaml:"timeout"`
+	MaxConnectionsPerIp int `validate:"omitempty,min=1" yaml:"max_connections_per_ip"`
+}
+
+type ApiKeys struct {
+	Enabled             bool    `validate:"omitempty"       yaml:"enabled"`
+	AnonymousRps        float64 `validate:"omitempty,min=0" yaml:"anonymous_rps"`
+	AnonymousDailyQuota int64   `validate:"omitempty,min=0" yaml:"anonymous_daily_quota"`
+	CacheTTL            int     `validate:"omitempty,min=1" yaml:"cache_ttl"`
+	FlushInterval       int     `validate:"omitempty,min=1" yaml:"flush_interval"`
+	MaxKeys             int     `validate:"omitempty,min=1" yaml:"max_keys"`
+	MaxAnonymous        int     `validate:"omitempty,min=1" yaml:"max_anonymous"`
+}
+
+type Cache struct {
+	MaxSize          int `validate:"omitempty,min=1" yaml:"max_size"`
+	MaxEntitiesCount int `validate:"omitempty,min=1" yaml:"max_entities_count"`
 }
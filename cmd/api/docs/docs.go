@@ -217,11 +217,17 @@ const docTemplate = `{
                         "description": "Comma-separated action types list",
                         "name": "action_types",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)",
                         "schema": {
                             "type": "array",
                             "items": {
@@ -244,6 +250,254 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/address/{hash}/balance": {
+            "get": {
+                "description": "Get address balances in every currency. If ` + "`" + `height` + "`" + ` or ` + "`" + `time` + "`" + ` is passed balances at the moment are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Get address balances",
+                "operationId": "address-balance",
+                "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Time in unix timestamp",
+                        "name": "time",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Balance"
+                            }
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/address/{hash}/balance/series/{timeframe}": {
+            "get": {
+                "description": "Get balance of address at the end of every time bucket and its change during the bucket.\nRange and merging of buckets are the same as in the network series.\nMinute timeframe isn't available: minute buckets of every address are as large as the balance updates table.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Get address balance series",
+                "operationId": "address-balance-series",
+                "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Timeframe",
+                        "name": "timeframe",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency. Default: nria",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum count of points. Requires from or to",
+                        "name": "points",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.BalanceSeriesItem"
+                            }
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/address/{hash}/export": {
+            "get": {
+                "description": "Stream all address actions matched the filters in CSV or NDJSON format",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Export address actions",
+                "operationId": "address-export",
+                "parameters": [
+                    {
+                        "maxLength": 40,
+                        "minLength": 40,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "sequence",
+                            "validator_update",
+                            "sudo_address_change",
+                            "mint",
+                            "ibc_relay",
+                            "ics20_withdrawal",
+                            "ibc_relayer_change",
+                            "fee_asset_change",
+                            "init_bridge_account",
+                            "bridge_lock"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated action types list",
+                        "name": "action_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/address/{hash}/rollups": {
             "get": {
                 "description": "Get rollups in which the address pushed something",
@@ -478,11 +732,17 @@ const docTemplate = `{
                         "description": "Need join stats for block",
                         "name": "stats",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)",
                         "schema": {
                             "type": "array",
                             "items": {
@@ -643,17 +903,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/{height}/rollup_actions": {
+        "/v1/block/{height}/raw": {
             "get": {
-                "description": "Get rollup actions in the block",
+                "description": "Get CometBFT block and block results as they were received from the node by the indexer. It can be used to verify indexed data against the source.\nBlocks indexed before raw blocks were stored have no raw data and return 204.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "block"
                 ],
-                "summary": "Get rollup actions in the block",
-                "operationId": "get-block-rollup-actions",
+                "summary": "Get raw block",
+                "operationId": "get-block-raw",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -662,31 +922,18 @@ const docTemplate = `{
                         "name": "height",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.RollupAction"
-                            }
+                            "$ref": "#/definitions/responses.RawBlock"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -702,17 +949,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/{height}/rollup_actions/count": {
+        "/v1/block/{height}/rollup_actions": {
             "get": {
-                "description": "Get count of rollup actions",
+                "description": "Get rollup actions in the block",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "block"
                 ],
-                "summary": "Get count of rollup actions",
-                "operationId": "get-block-rollup-actions-count",
+                "summary": "Get rollup actions in the block",
+                "operationId": "get-block-rollup-actions",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.RollupAction"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/block/{height}/rollup_actions/count": {
+            "get": {
+                "description": "Get count of rollup actions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "block"
+                ],
+                "summary": "Get count of rollup actions",
+                "operationId": "get-block-rollup-actions-count",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -892,6 +1198,113 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/events": {
+            "get": {
+                "description": "Stream of notifications of ` + "`" + `head` + "`" + ` and ` + "`" + `blocks` + "`" + ` channels in server-sent events format. Data of every event is the same notification as in websocket API. Block events have identity equal to block height. Pass the height in ` + "`" + `Last-Event-ID` + "`" + ` header (or ` + "`" + `last_event_id` + "`" + ` parameter) to resume the stream: missed blocks are sent before live ones.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "websocket"
+                ],
+                "summary": "Server-sent events stream",
+                "operationId": "events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of channels: head, blocks. All channels by default",
+                        "name": "channels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of action types. Blocks containing at least one action of the types are sent",
+                        "name": "action_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of proposer consensus addresses",
+                        "name": "proposers",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Height of the last received block",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Height of the last received block",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/graphql": {
+            "post": {
+                "description": "Executes GraphQL query. Queries are limited by depth and complexity. Complexity of list field is its requested limit multiplied by complexity of selected fields.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL endpoint",
+                "operationId": "graphql",
+                "parameters": [
+                    {
+                        "description": "GraphQL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/graphql.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/v1/head": {
             "get": {
                 "description": "Get current indexer head",
@@ -966,12 +1379,26 @@ const docTemplate = `{
                     {
                         "enum": [
                             "id",
-                            "size"
+                            "size",
+                            "actions_count",
+                            "name"
                         ],
                         "type": "string",
                         "description": "Field using for sorting. Default: id",
                         "name": "sort_by",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of virtual machine types",
+                        "name": "vm_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of rollup name",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1114,11 +1541,17 @@ const docTemplate = `{
                         "description": "Sort order",
                         "name": "sort",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)",
                         "schema": {
                             "type": "array",
                             "items": {
@@ -1141,6 +1574,78 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/rollup/{hash}/actions/export": {
+            "get": {
+                "description": "Stream all rollup actions matched the filters in CSV or NDJSON format",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "rollup"
+                ],
+                "summary": "Export rollup actions",
+                "operationId": "rollup-actions-export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base64Url encoded rollup id",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/rollup/{hash}/addresses": {
             "get": {
                 "description": "List addresses which pushed something in the rollup",
@@ -1202,33 +1707,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/search": {
-            "get": {
+        "/v1/rollup/{hash}/metadata": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create or replace human-readable information about rollup. Slug is derived from the name if it's empty. The metadata is replaced by the registry file with greater version.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "search"
+                    "rollup"
                 ],
-                "summary": "Search by hash or text",
-                "operationId": "search",
+                "summary": "Set rollup metadata",
+                "operationId": "set-rollup-metadata",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search string",
-                        "name": "query",
-                        "in": "query",
+                        "description": "Base64Url encoded rollup id",
+                        "name": "hash",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Metadata",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.rollupMetadataRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.SearchResult"
-                            }
+                            "$ref": "#/definitions/responses.RollupMetadata"
                         }
                     },
                     "400": {
@@ -1237,6 +1757,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1244,19 +1770,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/stats/rollup/series/{hash}/{name}/{timeframe}": {
-            "get": {
-                "description": "Get histogram with precomputed rollup by series name and timeframe",
-                "produces": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Delete human-readable information about rollup",
                 "tags": [
-                    "stats"
+                    "rollup"
                 ],
-                "summary": "Get histogram with precomputed rollup stats",
-                "operationId": "stats-rollup-series",
+                "summary": "Delete rollup metadata",
+                "operationId": "delete-rollup-metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -1264,11 +1790,208 @@ const docTemplate = `{
                         "name": "hash",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "enum": [
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/search": {
+            "get": {
+                "description": "Searches blocks by height or hash, transactions by hash, addresses by hex hash or bech32, rollups by hex or base64 identity and validators by name or address.\nHex hashes may be shortened to a prefix of at least 8 symbols. Results are sorted by rank: exact matches go first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search by hash or text",
+                "operationId": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search string",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.SearchResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/active_addresses": {
+            "get": {
+                "description": "Get daily count of addresses which signed transactions or received funds.\nIf ` + "`" + `from` + "`" + ` or ` + "`" + `to` + "`" + ` is passed, the whole range is returned. Otherwise the last 100 days are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get daily active addresses",
+                "operationId": "stats-active-addresses",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ActiveAddressesItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/holders": {
+            "get": {
+                "description": "Get top holders of the asset, their share of supply, Gini coefficient and count of holders by balance ranges.\nGini coefficient is estimated by the balance ranges, so it's a lower bound of the exact value.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get holders distribution",
+                "operationId": "stats-holders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency. Default: nria",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Count of top holders",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.HolderStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/rollup/series/{hash}/{name}/{timeframe}": {
+            "get": {
+                "description": "Get histogram with precomputed rollup by series name and timeframe. Range and merging of buckets are the same as in the network series.\nMinute timeframe isn't available for rollups: minute buckets of every rollup are as large as the rollup actions table.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get histogram with precomputed rollup stats",
+                "operationId": "stats-rollup-series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base64Url encoded rollup id",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
                             "hour",
                             "day",
+                            "week",
                             "month"
                         ],
                         "type": "string",
@@ -1302,6 +2025,14 @@ const docTemplate = `{
                         "description": "Time to in unix timestamp",
                         "name": "to",
                         "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum count of points. Requires from or to",
+                        "name": "points",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1329,62 +2060,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/stats/series/{name}/{timeframe}": {
+        "/v1/stats/rollups/top": {
             "get": {
-                "description": "Get histogram with precomputed stats by series name and timeframe",
+                "description": "Get rollups with the largest activity in the window ended now. Activity is compared with the previous window of the same length.\nSorting by fee returns 400: fees are paid by transactions and aren't attributed to rollups.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "stats"
                 ],
-                "summary": "Get histogram with precomputed stats",
-                "operationId": "stats-series",
+                "summary": "Get rollup leaderboard",
+                "operationId": "stats-rollups-top",
                 "parameters": [
                     {
                         "enum": [
-                            "hour",
-                            "day",
-                            "month"
+                            "24h",
+                            "7d",
+                            "30d"
                         ],
                         "type": "string",
-                        "description": "Timeframe",
-                        "name": "timeframe",
-                        "in": "path",
-                        "required": true
+                        "description": "Window. Default: 24h",
+                        "name": "window",
+                        "in": "query"
                     },
                     {
                         "enum": [
-                            "data_size",
-                            "tps",
-                            "bps",
-                            "rbps",
-                            "fee",
-                            "supply_change",
-                            "block_time",
-                            "tx_count",
-                            "bytes_in_block",
-                            "gas_price",
-                            "gas_efficiency",
-                            "gas_used",
-                            "gas_wanted"
+                            "size",
+                            "actions",
+                            "signers"
                         ],
                         "type": "string",
-                        "description": "Series name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
+                        "description": "Sorting metric. Default: size",
+                        "name": "by",
                         "in": "query"
                     },
                     {
+                        "maximum": 100,
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
+                        "description": "Count of requested entities",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -1394,7 +2109,837 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.SeriesItem"
+                                "$ref": "#/definitions/responses.TopRollup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/series/actions/{type}/{timeframe}": {
+            "get": {
+                "description": "Get count of actions of the type and transferred volume by timeframe. Volume is zero for action types without value.\nRange and merging of buckets are the same as in the network series. Count and volume of merged buckets are summed, min and max are their extremes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get histogram of actions by type",
+                "operationId": "stats-action-series",
+                "parameters": [
+                    {
+                        "enum": [
+                            "transfer",
+                            "sequence",
+                            "validator_update",
+                            "sudo_address_change",
+                            "mint",
+                            "ibc_relay",
+                            "ics20_withdrawal",
+                            "ibc_relayer_change",
+                            "fee_asset_change",
+                            "init_bridge_account",
+                            "bridge_lock"
+                        ],
+                        "type": "string",
+                        "description": "Action type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "minute",
+                            "hour",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Timeframe",
+                        "name": "timeframe",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum count of points. Requires from or to",
+                        "name": "points",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ActionSeriesItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/series/{name}/{timeframe}": {
+            "get": {
+                "description": "Get histogram with precomputed stats by series name and timeframe.\nIf ` + "`" + `from` + "`" + ` or ` + "`" + `to` + "`" + ` is passed, the whole range is returned: buckets are merged if the range contains more of them than ` + "`" + `points` + "`" + ` (1000 by default).\nOtherwise the last 100 buckets are returned. ` + "`" + `min` + "`" + ` and ` + "`" + `max` + "`" + ` are extremes of merged buckets or of per-block rates for ` + "`" + `tps` + "`" + `, ` + "`" + `bps` + "`" + ` and ` + "`" + `rbps` + "`" + `.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get histogram with precomputed stats",
+                "operationId": "stats-series",
+                "parameters": [
+                    {
+                        "enum": [
+                            "minute",
+                            "hour",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Timeframe",
+                        "name": "timeframe",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "data_size",
+                            "tps",
+                            "bps",
+                            "rbps",
+                            "fee",
+                            "supply_change",
+                            "block_time",
+                            "tx_count",
+                            "bytes_in_block",
+                            "gas_price",
+                            "gas_efficiency",
+                            "gas_used",
+                            "gas_wanted"
+                        ],
+                        "type": "string",
+                        "description": "Series name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum count of points. Requires from or to",
+                        "name": "points",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.SeriesItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/summary": {
+            "get": {
+                "description": "Get network summary",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get network summary",
+                "operationId": "stats-summary",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.NetworkSummary"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx": {
+            "get": {
+                "description": "List transactions info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transactions info",
+                "operationId": "list-transactions",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated status list",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "sequence",
+                            "validator_update",
+                            "sudo_address_change",
+                            "mint",
+                            "ibc_relay",
+                            "ics20_withdrawal",
+                            "ibc_relayer_change",
+                            "fee_asset_change",
+                            "init_bridge_account",
+                            "bridge_lock"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated action types list",
+                        "name": "action_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block number",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If true join actions",
+                        "name": "messages",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Tx"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/count": {
+            "get": {
+                "description": "Get count of transactions in network",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get count of transactions in network",
+                "operationId": "get-transactions-count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/export": {
+            "get": {
+                "description": "Stream all transactions matched the filters in CSV or NDJSON format",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Export transactions",
+                "operationId": "export-transactions",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated status list",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "sequence",
+                            "validator_update",
+                            "sudo_address_change",
+                            "mint",
+                            "ibc_relay",
+                            "ics20_withdrawal",
+                            "ibc_relayer_change",
+                            "fee_asset_change",
+                            "init_bridge_account",
+                            "bridge_lock"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated action types list",
+                        "name": "action_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block number",
+                        "name": "height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}": {
+            "get": {
+                "description": "Get transaction by hash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction by hash",
+                "operationId": "get-transaction",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Tx"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/actions": {
+            "get": {
+                "description": "Get transaction actions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction actions",
+                "operationId": "get-transaction-actions",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Action"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/decoded": {
+            "get": {
+                "description": "Get SignedTransaction decoded from raw bytes in canonical protobuf JSON",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get decoded transaction",
+                "operationId": "get-transaction-decoded",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/raw": {
+            "get": {
+                "description": "Get encoded SignedTransaction as it was included in the block",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get raw transaction",
+                "operationId": "get-transaction-raw",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/rollup_actions": {
+            "get": {
+                "description": "List transaction's rollup actions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transaction's rollup actions",
+                "operationId": "list-transactions-rollup-actions",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.RollupAction"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/rollup_actions/count": {
+            "get": {
+                "description": "Count of rollup actions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Count of rollup actions",
+                "operationId": "list-transactions-rollup-actions-count",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators": {
+            "get": {
+                "description": "List validators",
+                "produces": [
+                    "application/json",
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "List validators",
+                "operationId": "list-validator",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Validator"
                             }
                         }
                     },
@@ -1413,25 +2958,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/stats/summary": {
+        "/v1/validators/{id}": {
             "get": {
-                "description": "Get network summary",
+                "description": "Get validator info",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "validator"
+                ],
+                "summary": "Get validator info",
+                "operationId": "get-validator",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Internal validator id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get network summary",
-                "operationId": "stats-summary",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.NetworkSummary"
-                            }
+                            "$ref": "#/definitions/responses.Validator"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
                         }
                     },
                     "500": {
@@ -1443,18 +3003,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx": {
+        "/v1/validators/{id}/blocks": {
             "get": {
-                "description": "List transactions info",
+                "description": "List blocks which was proposed by validator",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "validator"
                 ],
-                "summary": "List transactions info",
-                "operationId": "list-transactions",
+                "summary": "List blocks which was proposed by validator",
+                "operationId": "get-validator-blocks",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Internal validator id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "maximum": 100,
                         "type": "integer",
@@ -1477,59 +3044,6 @@ const docTemplate = `{
                         "description": "Sort order",
                         "name": "sort",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "success",
-                            "failed"
-                        ],
-                        "type": "string",
-                        "description": "Comma-separated status list",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "transfer",
-                            "sequence",
-                            "validator_update",
-                            "sudo_address_change",
-                            "mint",
-                            "ibc_relay",
-                            "ics20_withdrawal",
-                            "ibc_relayer_change",
-                            "fee_asset_change",
-                            "init_bridge_account",
-                            "bridge_lock"
-                        ],
-                        "type": "string",
-                        "description": "Comma-separated action types list",
-                        "name": "action_types",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Block number",
-                        "name": "height",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "If true join actions",
-                        "name": "messages",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1538,10 +3052,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Tx"
+                                "$ref": "#/definitions/responses.Block"
                             }
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1557,60 +3074,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/count": {
-            "get": {
-                "description": "Get count of transactions in network",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "transactions"
-                ],
-                "summary": "Get count of transactions in network",
-                "operationId": "get-transactions-count",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/tx/{hash}": {
+        "/v1/validators/{id}/uptime": {
             "get": {
-                "description": "Get transaction by hash",
+                "description": "Get validator's uptime and history of signed block",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "validator"
                 ],
-                "summary": "Get transaction by hash",
-                "operationId": "get-transaction",
+                "summary": "Get validator's uptime and history of signed block",
+                "operationId": "get-validator-uptime",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 64,
-                        "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
+                        "type": "integer",
+                        "description": "Internal validator id",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested blocks",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Tx"
+                            "$ref": "#/definitions/responses.ValidatorUptime"
                         }
                     },
                     "204": {
@@ -1631,27 +3126,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/{hash}/actions": {
+        "/v1/watchlists": {
             "get": {
-                "description": "Get transaction actions",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List watchlist rules",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "watchlist"
                 ],
-                "summary": "Get transaction actions",
-                "operationId": "get-transaction-actions",
+                "summary": "List watchlist rules",
+                "operationId": "list-watchlist",
                 "parameters": [
-                    {
-                        "maxLength": 64,
-                        "minLength": 64,
-                        "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "maximum": 100,
                         "type": "integer",
@@ -1664,6 +3155,16 @@ const docTemplate = `{
                         "description": "Offset",
                         "name": "offset",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1672,7 +3173,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Action"
+                                "$ref": "#/definitions/responses.Watchlist"
                             }
                         }
                     },
@@ -1682,6 +3183,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1689,51 +3196,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/tx/{hash}/rollup_actions": {
-            "get": {
-                "description": "List transaction's rollup actions",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create rule which describes activity of address or rollup. Exactly one of address or rollup should be passed.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "watchlist"
                 ],
-                "summary": "List transaction's rollup actions",
-                "operationId": "list-transactions-rollup-actions",
+                "summary": "Create watchlist rule",
+                "operationId": "create-watchlist",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 64,
-                        "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.watchlistRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.RollupAction"
-                            }
+                            "$ref": "#/definitions/responses.Watchlist"
                         }
                     },
                     "400": {
@@ -1742,6 +3239,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1751,24 +3254,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/{hash}/rollup_actions/count": {
+        "/v1/watchlists/{id}": {
             "get": {
-                "description": "Count of rollup actions",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get watchlist rule",
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "transactions"
-                ],
-                "summary": "Count of rollup actions",
-                "operationId": "list-transactions-rollup-actions-count",
-                "parameters": [
-                    {
-                        "maxLength": 64,
-                        "minLength": 64,
-                        "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Get watchlist rule",
+                "operationId": "get-watchlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Internal identity",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -1777,15 +3283,24 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/responses.Watchlist"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1793,61 +3308,65 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/validators": {
-            "get": {
-                "description": "List validators",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace all fields of the watchlist rule",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "application/json",
                     "application/json"
                 ],
                 "tags": [
-                    "validator"
+                    "watchlist"
                 ],
-                "summary": "List validators",
-                "operationId": "list-validator",
+                "summary": "Update watchlist rule",
+                "operationId": "update-watchlist",
                 "parameters": [
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Internal identity",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "Rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.watchlistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.Validator"
-                            }
+                            "$ref": "#/definitions/responses.Watchlist"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1855,35 +3374,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/validators/{id}": {
-            "get": {
-                "description": "Get validator info",
-                "produces": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Delete watchlist rule with all its alerts",
                 "tags": [
-                    "validator"
+                    "watchlist"
                 ],
-                "summary": "Get validator info",
-                "operationId": "get-validator",
+                "summary": "Delete watchlist rule",
+                "operationId": "delete-watchlist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Internal validator id",
+                        "description": "Internal identity",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.Validator"
-                        }
-                    },
                     "204": {
                         "description": "No Content"
                     },
@@ -1893,6 +3406,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1902,21 +3421,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/validators/{id}/blocks": {
+        "/v1/watchlists/{id}/alerts": {
             "get": {
-                "description": "List blocks which was proposed by validator",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get fired alerts of watchlist rule",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "validator"
+                    "watchlist"
                 ],
-                "summary": "List blocks which was proposed by validator",
-                "operationId": "get-validator-blocks",
+                "summary": "Get fired alerts of watchlist rule",
+                "operationId": "watchlist-alerts",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Internal validator id",
+                        "description": "Internal identity",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1951,67 +3475,18 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Block"
+                                "$ref": "#/definitions/responses.WatchlistAlert"
                             }
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/validators/{id}/uptime": {
-            "get": {
-                "description": "Get validator's uptime and history of signed block",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "validator"
-                ],
-                "summary": "Get validator's uptime and history of signed block",
-                "operationId": "get-validator-uptime",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Internal validator id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested blocks",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ValidatorUptime"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -2027,7 +3502,7 @@ const docTemplate = `{
         },
         "/v1/ws": {
             "get": {
-                "description": "## Documentation for websocket API\n\n### Notification\n\nThe structure of notification is following in all channels:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"channel\": \"channel_name\",\n    \"body\": \"\u003cobject or array\u003e\"  // depends on channel\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Subscribe\n\nTo receive updates from websocket API send ` + "`" + `subscribe` + "`" + ` request to server.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n        \"filters\": {\n            // pass channel filters\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNow 2 channels are supported:\n\n* ` + "`" + `head` + "`" + ` - receive information about indexer state update. Channel does not have any filters. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"head\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.State` + "`" + ` type will be sent to the channel.\n\n* ` + "`" + `blocks` + "`" + ` - receive information about new blocks. Channel does not have any filters. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"blocks\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.Block` + "`" + ` type will be sent to the channel.\n\n\n### Unsubscribe\n\nTo unsubscribe send ` + "`" + `unsubscribe` + "`" + ` message containing one of channel name describing above.\n\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"unsubscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
+                "description": "## Documentation for websocket API\n\n### Notification\n\nThe structure of notification is following in all channels:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"channel\": \"channel_name\",\n    \"body\": \"\u003cobject or array\u003e\"  // depends on channel\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Subscribe\n\nTo receive updates from websocket API send ` + "`" + `subscribe` + "`" + ` request to server.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n        \"filters\": {\n            // pass channel filters\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNow 6 channels are supported:\n\n* ` + "`" + `head` + "`" + ` - receive information about indexer state update. Channel does not have any filters. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"head\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.State` + "`" + ` type will be sent to the channel.\n\n* ` + "`" + `blocks` + "`" + ` - receive information about new blocks. Channel has optional filters:\n  * ` + "`" + `action_type` + "`" + ` - array of action types. Block is sent if it contains at least one action of passed types;\n  * ` + "`" + `proposers` + "`" + ` - array of hexadecimal consensus addresses of block proposers.\n\nSubscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"blocks\",\n        \"filters\": {\n            \"action_type\": [\"sequence\"]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.Block` + "`" + ` type will be sent to the channel.\n\n* ` + "`" + `alerts` + "`" + ` - receive alerts fired by watchlist rules (see ` + "`" + `/v1/watchlists` + "`" + ` endpoints). Watchlists are owned by the administrative API key, so the channel is available only if the connection was opened with the key in ` + "`" + `X-API-Key` + "`" + ` header. Otherwise subscription is rejected. Channel has optional filter ` + "`" + `watchlists` + "`" + ` containing identities of rules. If the filter is empty alerts of all rules are sent. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"alerts\",\n        \"filters\": {\n            \"watchlists\": [1, 2]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.WatchlistAlert` + "`" + ` type will be sent to the channel.\n\n* ` + "`" + `txs` + "`" + ` - receive new transactions. Channel has optional filters:\n  * ` + "`" + `status` + "`" + ` - array of transaction statuses (` + "`" + `success` + "`" + ` or ` + "`" + `failed` + "`" + `);\n  * ` + "`" + `action_type` + "`" + ` - array of action types. Transaction is sent if it contains at least one action of passed types;\n  * ` + "`" + `addresses` + "`" + ` - array of hexadecimal address hashes. Transaction is sent if one of addresses is its signer or is mentioned in data of one of its actions;\n  * ` + "`" + `rollups` + "`" + ` - array of base64url encoded rollup ids. Transaction is sent if one of its actions refers to the rollup.\n\nDifferent filters are combined with ` + "`" + `AND` + "`" + `, values inside one filter are combined with ` + "`" + `OR` + "`" + `. If filters are empty all transactions are sent. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"txs\",\n        \"filters\": {\n            \"status\": [\"success\"],\n            \"action_type\": [\"transfer\", \"sequence\"],\n            \"addresses\": [\"115F94D8C98FFD73FE65182611140F0EDC7C3C94\"]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.Tx` + "`" + ` type with its actions will be sent to the channel.\n\n* ` + "`" + `actions` + "`" + ` - receive actions of new transactions. Channel has optional filters ` + "`" + `action_type` + "`" + `, ` + "`" + `addresses` + "`" + ` and ` + "`" + `rollups` + "`" + ` with the same format as in ` + "`" + `txs` + "`" + ` channel. Action is matched by address if the address is mentioned in action data (for example, receiver of transfer). Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"actions\",\n        \"filters\": {\n            \"action_type\": [\"sequence\"],\n            \"rollups\": [\"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=\"]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body of ` + "`" + `responses.Action` + "`" + ` type will be sent to the channel.\n\nTransactions and actions are sent only after the indexer reaches the head of the chain.\n\n* ` + "`" + `rollup` + "`" + ` - receive data pushed to rollups in block order. Channel has required filter ` + "`" + `rollups` + "`" + ` containing base64url encoded rollup ids and optional flag ` + "`" + `with_data` + "`" + `. If ` + "`" + `with_data` + "`" + ` is ` + "`" + `false` + "`" + ` the raw sequence payload (` + "`" + `data` + "`" + ` field of action) is omitted. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"rollup\",\n        \"filters\": {\n            \"rollups\": [\"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=\"],\n            \"with_data\": true\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNotification body has ` + "`" + `websocket.RollupMessage` + "`" + ` type. Its field ` + "`" + `type` + "`" + ` is ` + "`" + `action` + "`" + ` for rollup actions and ` + "`" + `end_of_block` + "`" + ` for the marker which is sent for every block after all actions of subscribed rollups in the block. The marker is sent even if the block does not contain actions of subscribed rollups.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"channel\": \"rollup\",\n    \"body\": {\n        \"type\": \"action\",\n        \"height\": 100,\n        \"time\": \"2024-01-01T00:00:00Z\",\n        \"rollup\": \"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=\",\n        \"size\": 4,\n        \"action\": {\n            // responses.Action\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"channel\": \"rollup\",\n    \"body\": {\n        \"type\": \"end_of_block\",\n        \"height\": 100,\n        \"time\": \"2024-01-01T00:00:00Z\",\n        \"hash\": \"0001020304...\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Replay\n\nChannels ` + "`" + `blocks` + "`" + `, ` + "`" + `txs` + "`" + `, ` + "`" + `actions` + "`" + ` and ` + "`" + `rollup` + "`" + ` support replaying of history. Pass ` + "`" + `from_height` + "`" + ` in ` + "`" + `subscribe` + "`" + ` request to receive historical notifications starting from the height before live ones. Replayed notifications are filtered with channel filters. The server switches the subscription to live mode after the head of the indexer is reached without gaps and duplicates. The height should be not older than 10000 blocks from the head.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"rollup\",\n        \"from_height\": 100,\n        \"filters\": {\n            \"rollups\": [\"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=\"]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nSubscribing again to the same channel or unsubscribing cancels running replay.\n\n### Limits\n\nEvery client has a bounded queue of outgoing notifications (1024 messages by default). If the client does not read notifications fast enough and the queue is overflowed, server closes the connection with code ` + "`" + `1008` + "`" + ` and reason ` + "`" + `slow consumer` + "`" + `. Count of simultaneous connections from one IP address is limited (10 by default); exceeding connections are rejected with ` + "`" + `429 Too Many Requests` + "`" + ` status.\n\n### Server-sent events\n\nIf websocket connection is unavailable (for example, proxy blocks upgrade requests) ` + "`" + `head` + "`" + ` and ` + "`" + `blocks` + "`" + ` channels can be received from ` + "`" + `GET /v1/events` + "`" + ` endpoint in server-sent events format. Channels are selected by ` + "`" + `channels` + "`" + ` query parameter and filters of ` + "`" + `blocks` + "`" + ` channel are passed as comma-separated query parameters ` + "`" + `action_types` + "`" + ` and ` + "`" + `proposers` + "`" + `. Event name is channel name and event data is the same notification as in websocket API. Block events have identity equal to block height, so the stream is resumed after reconnection from the block next to ` + "`" + `Last-Event-ID` + "`" + ` without gaps.\n\n` + "`" + `` + "`" + `` + "`" + `\nGET /v1/events?channels=head,blocks\u0026action_types=sequence\n\nid: 100\nevent: blocks\ndata: {\"channel\":\"blocks\",\"body\":{...}}\n\nevent: head\ndata: {\"channel\":\"head\",\"body\":{...}}\n` + "`" + `` + "`" + `` + "`" + `\n\nLimits of websocket connections are applied to the stream too.\n\n\n### Unsubscribe\n\nTo unsubscribe send ` + "`" + `unsubscribe` + "`" + ` message containing one of channel name describing above.\n\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"unsubscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
                 "produces": [
                     "application/json"
                 ],
@@ -2041,6 +3516,27 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "echo.HTTPError": {
+            "type": "object",
+            "properties": {
+                "message": {}
+            }
+        },
+        "graphql.Request": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
         "handler.Error": {
             "type": "object",
             "properties": {
@@ -2049,6 +3545,84 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.rollupMetadataRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 4096
+                },
+                "logo": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "vm_type": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.watchlistRequest": {
+            "type": "object",
+            "properties": {
+                "action_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "address": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "enum": [
+                        "any",
+                        "in",
+                        "out"
+                    ]
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "rollup": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
         "responses.Action": {
             "type": "object",
             "properties": {
@@ -2088,6 +3662,73 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.ActionSeriesItem": {
+            "description": "Count of actions of the type and transferred volume for the period. Volume is zero for actions without value.\nMin and max are extremes of merged buckets.",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 100
+                },
+                "max_count": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 20
+                },
+                "max_volume": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "500000"
+                },
+                "min_count": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 1
+                },
+                "min_volume": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "0"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:00:00+00:00"
+                },
+                "volume": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "1000000"
+                }
+            }
+        },
+        "responses.ActiveAddressesItem": {
+            "description": "Count of addresses which signed transactions or received funds during the day",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 100
+                },
+                "receivers": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 40
+                },
+                "signers": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 80
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T00:00:00+00:00"
+                }
+            }
+        },
         "responses.Address": {
             "description": "address information",
             "type": "object",
@@ -2138,6 +3779,32 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.BalanceSeriesItem": {
+            "description": "Balance of address at the end of time bucket",
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "-1000"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "updates_count": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 2
+                },
+                "value": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "10000000000"
+                }
+            }
+        },
         "responses.Block": {
             "type": "object",
             "properties": {
@@ -2274,6 +3941,84 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Holder": {
+            "description": "Holder of the asset",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "astria1kwz7dr368gkj2rrugqjfwftk66vtne6gyvjhkt"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "10000000000"
+                },
+                "share": {
+                    "type": "number",
+                    "example": 0.0123
+                }
+            }
+        },
+        "responses.HolderBucket": {
+            "description": "Holders with balance in range [from, to)",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "from": {
+                    "type": "string",
+                    "example": "1000"
+                },
+                "share": {
+                    "type": "number",
+                    "example": 0.0123
+                },
+                "to": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "1000000000"
+                }
+            }
+        },
+        "responses.HolderStats": {
+            "description": "Distribution of the asset between holders",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "nria"
+                },
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.HolderBucket"
+                    }
+                },
+                "gini": {
+                    "type": "number",
+                    "example": 0.8523
+                },
+                "holders_count": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "supply": {
+                    "type": "string",
+                    "example": "1000000000000"
+                },
+                "top": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.Holder"
+                    }
+                }
+            }
+        },
         "responses.NetworkSummary": {
             "type": "object",
             "properties": {
@@ -2330,6 +4075,18 @@ const docTemplate = `{
                 "type": "string"
             }
         },
+        "responses.RawBlock": {
+            "description": "Results of CometBFT ` + "`" + `block` + "`" + ` and ` + "`" + `block_results` + "`" + ` requests as they were received from the node",
+            "type": "object",
+            "properties": {
+                "block": {
+                    "type": "object"
+                },
+                "block_results": {
+                    "type": "object"
+                }
+            }
+        },
         "responses.Rollup": {
             "type": "object",
             "properties": {
@@ -2353,6 +4110,9 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 321
                 },
+                "metadata": {
+                    "$ref": "#/definitions/responses.RollupMetadata"
+                },
                 "size": {
                     "type": "integer",
                     "example": 100
@@ -2401,9 +4161,60 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.RollupMetadata": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "example": "team@example.com"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "EVM rollup"
+                },
+                "logo": {
+                    "type": "string",
+                    "example": "https://example.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Flame"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "flame"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "vm_type": {
+                    "type": "string",
+                    "example": "evm"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://example.com"
+                }
+            }
+        },
         "responses.RollupSeriesItem": {
             "type": "object",
             "properties": {
+                "max": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "0.17632"
+                },
+                "min": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "0.17632"
+                },
                 "time": {
                     "type": "string",
                     "format": "date-time",
@@ -2543,6 +4354,55 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.TopRollup": {
+            "description": "Rollup activity in the window compared with the previous window of the same length. Changes are relative and omitted if there was no activity in the previous window.",
+            "type": "object",
+            "properties": {
+                "actions_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "actions_count_change": {
+                    "type": "number",
+                    "example": -0.5
+                },
+                "prev_actions_count": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "prev_signers": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "prev_size": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "rollup": {
+                    "$ref": "#/definitions/responses.Rollup"
+                },
+                "signers": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "signers_change": {
+                    "type": "number",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "size_change": {
+                    "type": "number",
+                    "example": 0.1
+                },
+                "size_share": {
+                    "type": "number",
+                    "example": 0.25
+                }
+            }
+        },
         "responses.Tx": {
             "type": "object",
             "properties": {
@@ -2672,6 +4532,144 @@ const docTemplate = `{
                     "example": "0.97"
                 }
             }
+        },
+        "responses.Watchlist": {
+            "type": "object",
+            "properties": {
+                "action_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "string"
+                    },
+                    "example": [
+                        "transfer",
+                        "bridge_lock"
+                    ]
+                },
+                "address": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "115F94D8C98FFD73FE65182611140F0EDC7C3C94"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "currency": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "nria"
+                },
+                "direction": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "out"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "format": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "name": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "treasury"
+                },
+                "rollup": {
+                    "type": "string",
+                    "format": "base64",
+                    "example": "O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc="
+                },
+                "threshold": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "1000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "webhook_url": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "https://example.com/hook"
+                }
+            }
+        },
+        "responses.WatchlistAlert": {
+            "type": "object",
+            "properties": {
+                "action_type": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "transfer"
+                },
+                "amount": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "1000"
+                },
+                "currency": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "nria"
+                },
+                "delivered": {
+                    "type": "boolean",
+                    "format": "boolean",
+                    "example": true
+                },
+                "direction": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "out"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "position": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_hash": {
+                    "type": "string",
+                    "format": "binary",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "watchlist_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     }
 }`
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultExportTimeout             = 5 * time.Minute
+	defaultExportMaxConnectionsPerIp = 2
+)
+
+type exportLimiter struct {
+	timeout  time.Duration
+	maxPerIp int
+
+	active map[string]int
+	mx     *sync.Mutex
+}
+
+func newExportLimiter(cfg ExportLimits) *exportLimiter {
+	limiter := &exportLimiter{
+		timeout:  defaultExportTimeout,
+		maxPerIp: defaultExportMaxConnectionsPerIp,
+		active:   make(map[string]int),
+		mx:       new(sync.Mutex),
+	}
+	if cfg.Timeout > 0 {
+		limiter.timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	if cfg.MaxConnectionsPerIp > 0 {
+		limiter.maxPerIp = cfg.MaxConnectionsPerIp
+	}
+	return limiter
+}
+
+func (l *exportLimiter) acquire(ip string) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.active[ip] >= l.maxPerIp {
+		return false
+	}
+	l.active[ip]++
+	return true
+}
+
+func (l *exportLimiter) release(ip string) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.active[ip] <= 1 {
+		delete(l.active, ip)
+		return
+	}
+	l.active[ip]--
+}
+
+// ExportMiddleware - bounds duration of export requests and count of concurrent exports per IP,
+// so slow or stalled downloads can't hold database connections forever
+func ExportMiddleware(cfg ExportLimits) echo.MiddlewareFunc {
+	limiter := newExportLimiter(cfg)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !exportSkipper(c) {
+				return next(c)
+			}
+
+			ip := c.RealIP()
+			if !limiter.acquire(ip) {
+				return echo.NewHTTPError(http.StatusTooManyRequests, "too many concurrent exports")
+			}
+			defer limiter.release(ip)
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), limiter.timeout)
+			defer cancel()
+
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportMiddlewareConcurrencyLimit(t *testing.T) {
+	e := echo.New()
+	mw := ExportMiddleware(ExportLimits{MaxConnectionsPerIp: 1})
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	blocking := mw(func(c echo.Context) error {
+		close(started)
+		<-finish
+		return c.NoContent(http.StatusOK)
+	})
+	instant := mw(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	newContext := func(path string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "127.0.0.1:1234"
+		return e.NewContext(req, httptest.NewRecorder())
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- blocking(newContext("/v1/tx/export"))
+	}()
+	<-started
+
+	err := instant(newContext("/v1/tx/export"))
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+
+	require.NoError(t, instant(newContext("/v1/tx")), "non-export requests are not limited")
+
+	close(finish)
+	require.NoError(t, <-done)
+	require.NoError(t, instant(newContext("/v1/tx/export")), "slot is released after export")
+}
+
+func TestExportMiddlewareTimeout(t *testing.T) {
+	e := echo.New()
+	mw := ExportMiddleware(ExportLimits{Timeout: 1})
+
+	handler := mw(func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return c.Request().Context().Err()
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/address/export", nil)
+	err := handler(e.NewContext(req, httptest.NewRecorder()))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -9,22 +9,27 @@ import (
 	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type AddressHandler struct {
-	address     storage.IAddress
-	txs         storage.ITx
-	actions     storage.IAction
-	rollups     storage.IRollup
-	state       storage.IState
-	indexerName string
+	address        storage.IAddress
+	balanceUpdates storage.IBalanceUpdate
+	txs            storage.ITx
+	actions        storage.IAction
+	rollups        storage.IRollup
+	state          storage.IState
+	indexerName    string
 }
 
 func NewAddressHandler(
 	address storage.IAddress,
+	balanceUpdates storage.IBalanceUpdate,
 	txs storage.ITx,
 	actions storage.IAction,
 	rollups storage.IRollup,
@@ -32,12 +37,13 @@ func NewAddressHandler(
 	indexerName string,
 ) *AddressHandler {
 	return &AddressHandler{
-		address:     address,
-		txs:         txs,
-		actions:     actions,
-		rollups:     rollups,
-		state:       state,
-		indexerName: indexerName,
+		address:        address,
+		balanceUpdates: balanceUpdates,
+		txs:            txs,
+		actions:        actions,
+		rollups:        rollups,
+		state:          state,
+		indexerName:    indexerName,
 	}
 }
 
@@ -216,6 +222,7 @@ type getAddressMessages struct {
 	Offset      uint64      `query:"offset"       validate:"omitempty,min=0"`
 	Sort        string      `query:"sort"         validate:"omitempty,oneof=asc desc"`
 	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
+	Cursor      string      `query:"cursor"       validate:"omitempty"`
 }
 
 func (p *getAddressMessages) SetDefault() {
@@ -256,8 +263,9 @@ func (p *getAddressMessages) ToFilters() storage.AddressActionsFilter {
 //	@Param			offset			query	integer					false	"Offset"								minimum(1)
 //	@Param			sort			query	string					false	"Sort order"							Enums(asc, desc)
 //	@Param			action_types	query	storageTypes.ActionType	false	"Comma-separated action types list"
+//	@Param			cursor			query	string					false	"Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored"
 //	@Produce		json
-//	@Success		200	{array}		responses.Action
+//	@Success		200	{array}		responses.Action	"Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)"
 //	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/address/{hash}/actions [get]
@@ -280,6 +288,16 @@ func (handler *AddressHandler) Actions(c echo.Context) error {
 	}
 
 	filters := req.ToFilters()
+	cursorMode := isCursorRequest(c)
+	if cursorMode {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filters.Cursor = cursor
+		filters.Offset = 0
+	}
+
 	actions, err := handler.actions.ByAddress(c.Request().Context(), address.Id, filters)
 	if err != nil {
 		return handleError(c, err, handler.address)
@@ -290,9 +308,92 @@ func (handler *AddressHandler) Actions(c echo.Context) error {
 		response[i] = responses.NewAddressAction(actions[i])
 	}
 
+	if cursorMode {
+		return returnPage(c, response, filters.Limit, func(action responses.Action) (time.Time, uint64) {
+			return action.Time, action.Id
+		})
+	}
 	return returnArray(c, response)
 }
 
+type addressExportRequest struct {
+	Hash        string      `param:"hash"         validate:"required,address"`
+	Format      string      `query:"format"       validate:"omitempty,oneof=csv ndjson"`
+	Sort        string      `query:"sort"         validate:"omitempty,oneof=asc desc"`
+	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (p *addressExportRequest) SetDefault() {
+	if p.Format == "" {
+		p.Format = exportFormatCsv
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
+// Export godoc
+//
+//	@Summary		Export address actions
+//	@Description	Stream all address actions matched the filters in CSV or NDJSON format
+//	@Tags			address
+//	@ID				address-export
+//	@Param			hash			path	string					true	"Hash"								minlength(40)	maxlength(40)
+//	@Param			format			query	string					false	"Export format"						Enums(csv, ndjson)
+//	@Param			sort			query	string					false	"Sort order"						Enums(asc, desc)
+//	@Param			action_types	query	storageTypes.ActionType	false	"Comma-separated action types list"
+//	@Param			from			query	integer					false	"Time from in unix timestamp"		mininum(1)
+//	@Param			to				query	integer					false	"Time to in unix timestamp"			mininum(1)
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Success		200
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/export [get]
+func (handler *AddressHandler) Export(c echo.Context) error {
+	req, err := bindAndValidate[addressExportRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	filters := storage.AddressActionsFilter{
+		Sort:        pgSort(req.Sort),
+		ActionTypes: storageTypes.NewActionTypeMask(),
+	}
+	for i := range req.ActionTypes {
+		filters.ActionTypes.SetType(storageTypes.ActionType(req.ActionTypes[i]))
+	}
+	if req.From > 0 {
+		filters.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		filters.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+
+	e, err := newExporter(c, req.Format, req.Hash, actionExportHeader, actionExportRow)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	err = handler.actions.StreamByAddress(c.Request().Context(), address.Id, filters, func(action storage.AddressAction) error {
+		return e.Write(responses.NewAddressAction(action))
+	})
+	return e.Close(err)
+}
+
 // Count godoc
 //
 //	@Summary		Get count of addresses in network
@@ -372,3 +473,138 @@ func (handler *AddressHandler) Rollups(c echo.Context) error {
 
 	return returnArray(c, response)
 }
+
+type addressBalanceRequest struct {
+	Hash   string  `param:"hash"   validate:"required,address"`
+	Height *uint64 `query:"height" validate:"omitempty,min=0"`
+	Time   *int64  `query:"time"   validate:"omitempty,min=1"`
+}
+
+// Balance godoc
+//
+//	@Summary		Get address balances
+//	@Description	Get address balances in every currency. If `height` or `time` is passed balances at the moment are returned.
+//	@Tags			address
+//	@ID				address-balance
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			height	query	integer	false	"Block height"					minimum(0)
+//	@Param			time	query	integer	false	"Time in unix timestamp"		minimum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Balance
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/balance [get]
+func (handler *AddressHandler) Balance(c echo.Context) error {
+	req, err := bindAndValidate[addressBalanceRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if req.Height != nil && req.Time != nil {
+		return badRequestError(c, errors.New("height and time can't be passed together"))
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	var balances []storage.Balance
+	switch {
+	case req.Height != nil:
+		balances, err = handler.balanceUpdates.BalancesAtHeight(c.Request().Context(), address.Id, types.Level(*req.Height))
+	case req.Time != nil:
+		balances, err = handler.balanceUpdates.BalancesAtTime(c.Request().Context(), address.Id, time.Unix(*req.Time, 0).UTC())
+	default:
+		balances, err = handler.balanceUpdates.BalancesAtTime(c.Request().Context(), address.Id, time.Now().UTC())
+	}
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.Balance, len(balances))
+	for i := range balances {
+		response[i] = responses.NewBalance(balances[i])
+	}
+	return returnArray(c, response)
+}
+
+type addressBalanceSeriesRequest struct {
+	Hash      string `example:"115F94D8C98FFD73FE65182611140F0EDC7C3C94" param:"hash"      swaggertype:"string"  validate:"required,address"`
+	Timeframe string `example:"hour"                                     param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
+	Currency  string `example:"nria"                                     query:"currency"  swaggertype:"string"  validate:"omitempty"`
+	From      int64  `example:"1692892095"                               query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095"                               query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+	Points    int    `example:"100"                                      query:"points"    swaggertype:"integer" validate:"omitempty,min=1,max=1000"`
+}
+
+func (p *addressBalanceSeriesRequest) SetDefault() {
+	if p.Currency == "" {
+		p.Currency = currency.DefaultCurrency
+	}
+}
+
+// BalanceSeries godoc
+//
+//	@Summary		Get address balance series
+//	@Description	Get balance of address at the end of every time bucket and its change during the bucket.
+//	@Description	Range and merging of buckets are the same as in the network series.
+//	@Description	Minute timeframe isn't available: minute buckets of every address are as large as the balance updates table.
+//	@Tags			address
+//	@ID				address-balance-series
+//	@Param			hash		path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
+//	@Param			currency	query	string	false	"Currency. Default: nria"
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	minimum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		minimum(1)
+//	@Param			points		query	integer	false	"Maximum count of points. Requires from or to"	minimum(1)	maximum(1000)
+//	@Produce		json
+//	@Success		200	{array}		responses.BalanceSeriesItem
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/balance/series/{timeframe} [get]
+func (handler *AddressHandler) BalanceSeries(c echo.Context) error {
+	req, err := bindAndValidate[addressBalanceSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	seriesReq, err := newSeriesRequest(req.From, req.To, req.Points)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	series, err := handler.balanceUpdates.Series(
+		c.Request().Context(),
+		address.Id,
+		req.Currency,
+		storage.Timeframe(req.Timeframe),
+		seriesReq,
+	)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	response := make([]responses.BalanceSeriesItem, len(series))
+	for i := range series {
+		response[i] = responses.NewBalanceSeriesItem(series[i])
+	}
+	return returnArray(c, response)
+}
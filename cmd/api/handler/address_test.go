@@ -6,18 +6,23 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -25,14 +30,15 @@ import (
 // AddressTestSuite -
 type AddressTestSuite struct {
 	suite.Suite
-	address *mock.MockIAddress
-	txs     *mock.MockITx
-	actions *mock.MockIAction
-	rollups *mock.MockIRollup
-	state   *mock.MockIState
-	echo    *echo.Echo
-	handler *AddressHandler
-	ctrl    *gomock.Controller
+	address        *mock.MockIAddress
+	balanceUpdates *mock.MockIBalanceUpdate
+	txs            *mock.MockITx
+	actions        *mock.MockIAction
+	rollups        *mock.MockIRollup
+	state          *mock.MockIState
+	echo           *echo.Echo
+	handler        *AddressHandler
+	ctrl           *gomock.Controller
 }
 
 // SetupSuite -
@@ -41,11 +47,12 @@ func (s *AddressTestSuite) SetupSuite() {
 	s.echo.Validator = NewApiValidator()
 	s.ctrl = gomock.NewController(s.T())
 	s.address = mock.NewMockIAddress(s.ctrl)
+	s.balanceUpdates = mock.NewMockIBalanceUpdate(s.ctrl)
 	s.txs = mock.NewMockITx(s.ctrl)
 	s.actions = mock.NewMockIAction(s.ctrl)
 	s.rollups = mock.NewMockIRollup(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewAddressHandler(s.address, s.txs, s.actions, s.rollups, s.state, testIndexerName)
+	s.handler = NewAddressHandler(s.address, s.balanceUpdates, s.txs, s.actions, s.rollups, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -286,6 +293,80 @@ func (s *AddressTestSuite) TestActions() {
 	s.Require().EqualValues(types.ActionTypeSequence, action.Type)
 }
 
+func (s *AddressTestSuite) TestExport() {
+	q := make(url.Values)
+	q.Set("action_types", "transfer")
+	q.Set("from", "1692892095")
+	q.Set("to", "1692892096")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/export")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddress.Hash).
+		Return(testAddress, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		StreamByAddress(gomock.Any(), uint64(1), storage.AddressActionsFilter{
+			Sort:        sdk.SortOrderAsc,
+			ActionTypes: types.NewActionTypeMask(types.ActionTypeTransfer.String()),
+			TimeFrom:    time.Unix(1692892095, 0).UTC(),
+			TimeTo:      time.Unix(1692892096, 0).UTC(),
+		}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, _ storage.AddressActionsFilter, fn func(storage.AddressAction) error) error {
+			for i := uint64(1); i <= 2; i++ {
+				if err := fn(storage.AddressAction{
+					ActionId:   i,
+					ActionType: types.ActionTypeTransfer,
+					Height:     100,
+					Time:       testTime,
+					Action: &storage.Action{
+						Data:     map[string]any{"amount": "1"},
+						Position: int64(i),
+					},
+					Tx: &testTx,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Export(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 3)
+	s.Require().Equal(actionExportHeader, records[0])
+	s.Require().Equal("1", records[1][0])
+	s.Require().Equal("transfer", records[1][4])
+	s.Require().Equal(`{"amount":"1"}`, records[1][6])
+	s.Require().Equal("2", records[2][0])
+}
+
+func (s *AddressTestSuite) TestExportInvalidFormat() {
+	q := make(url.Values)
+	q.Set("format", "xml")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/export")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.Require().NoError(s.handler.Export(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *AddressTestSuite) TestCount() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -350,3 +431,149 @@ func (s *AddressTestSuite) TestRollups() {
 	s.Require().EqualValues(10, rollup.Size)
 	s.Require().Equal(testRollup.AstriaId, rollup.AstriaId)
 }
+
+func (s *AddressTestSuite) TestBalanceAtHeight() {
+	q := make(url.Values)
+	q.Set("height", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddress.Hash).
+		Return(testAddress, nil).
+		Times(1)
+
+	s.balanceUpdates.EXPECT().
+		BalancesAtHeight(gomock.Any(), testAddress.Id, pkgTypes.Level(0)).
+		Return([]storage.Balance{
+			{
+				Id:       testAddress.Id,
+				Currency: currency.DefaultCurrency,
+				Total:    decimal.RequireFromString("1000"),
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Balance(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balances []responses.Balance
+	err := json.NewDecoder(rec.Body).Decode(&balances)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal(currency.DefaultCurrency, balances[0].Currency)
+	s.Require().Equal("1000", balances[0].Value)
+}
+
+func (s *AddressTestSuite) TestBalanceAtTime() {
+	q := make(url.Values)
+	q.Set("time", "1692892095")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddress.Hash).
+		Return(testAddress, nil).
+		Times(1)
+
+	s.balanceUpdates.EXPECT().
+		BalancesAtTime(gomock.Any(), testAddress.Id, time.Unix(1692892095, 0).UTC()).
+		Return([]storage.Balance{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Balance(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balances []responses.Balance
+	err := json.NewDecoder(rec.Body).Decode(&balances)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 0)
+}
+
+func (s *AddressTestSuite) TestBalanceHeightAndTime() {
+	q := make(url.Values)
+	q.Set("height", "100")
+	q.Set("time", "1692892095")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.Require().NoError(s.handler.Balance(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *AddressTestSuite) TestBalanceSeries() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		q := make(url.Values)
+		q.Set("from", "1692892095")
+		q.Set("to", "1692892095")
+
+		req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/address/:hash/balance/series/:timeframe")
+		c.SetParamNames("hash", "timeframe")
+		c.SetParamValues(testAddressHash, string(tf))
+
+		s.address.EXPECT().
+			ByHash(gomock.Any(), testAddress.Hash).
+			Return(testAddress, nil).
+			Times(1)
+
+		s.balanceUpdates.EXPECT().
+			Series(gomock.Any(), testAddress.Id, currency.DefaultCurrency, tf, storage.NewSeriesRequest(1692892095, 1692892095)).
+			Return([]storage.BalanceSeriesItem{
+				{
+					Time:    testTime,
+					Change:  decimal.RequireFromString("-10"),
+					Balance: decimal.RequireFromString("990"),
+					Count:   2,
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.BalanceSeries(c))
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var series []responses.BalanceSeriesItem
+		err := json.NewDecoder(rec.Body).Decode(&series)
+		s.Require().NoError(err)
+		s.Require().Len(series, 1)
+
+		item := series[0]
+		s.Require().Equal(testTime, item.Time)
+		s.Require().Equal("990", item.Value)
+		s.Require().Equal("-10", item.Change)
+		s.Require().EqualValues(2, item.Count)
+	}
+}
+
+func (s *AddressTestSuite) TestBalanceSeriesInvalidTimeframe() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance/series/:timeframe")
+	c.SetParamNames("hash", "timeframe")
+	c.SetParamValues(testAddressHash, "minute")
+
+	s.Require().NoError(s.handler.BalanceSeries(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
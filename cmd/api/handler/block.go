@@ -5,6 +5,7 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/pkg/types"
 
@@ -20,6 +21,7 @@ type BlockHandler struct {
 	actions     storage.IAction
 	rollups     storage.IRollup
 	state       storage.IState
+	raw         storage.IRawBlock
 	indexerName string
 }
 
@@ -30,6 +32,7 @@ func NewBlockHandler(
 	actions storage.IAction,
 	rollups storage.IRollup,
 	state storage.IState,
+	raw storage.IRawBlock,
 	indexerName string,
 ) *BlockHandler {
 	return &BlockHandler{
@@ -39,6 +42,7 @@ func NewBlockHandler(
 		actions:     actions,
 		rollups:     rollups,
 		state:       state,
+		raw:         raw,
 		indexerName: indexerName,
 	}
 }
@@ -81,11 +85,44 @@ func (handler *BlockHandler) Get(c echo.Context) error {
 	return c.JSON(http.StatusOK, responses.NewBlock(block))
 }
 
+// Raw godoc
+//
+//	@Summary		Get raw block
+//	@Description	Get CometBFT block and block results as they were received from the node by the indexer. It can be used to verify indexed data against the source.
+//	@Description	Blocks indexed before raw blocks were stored have no raw data and return 204.
+//	@Tags			block
+//	@ID				get-block-raw
+//	@Param			height	path	integer	true	"Block height"	minimum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.RawBlock
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/block/{height}/raw [get]
+func (handler *BlockHandler) Raw(c echo.Context) error {
+	req, err := bindAndValidate[getBlockByHeightRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	raw, err := handler.raw.ByHeight(c.Request().Context(), req.Height)
+	if err != nil {
+		return handleError(c, err, handler.raw)
+	}
+
+	block, results, err := raw.Decompressed()
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	return c.JSON(http.StatusOK, responses.NewRawBlock(block, results))
+}
+
 type blockListRequest struct {
 	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
 	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
 	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
 	Stats  bool   `query:"stats"  validate:"omitempty"`
+	Cursor string `query:"cursor" validate:"omitempty"`
 }
 
 func (p *blockListRequest) SetDefault() {
@@ -107,8 +144,9 @@ func (p *blockListRequest) SetDefault() {
 //	@Param			offset	query	integer	false	"Offset"						mininum(1)
 //	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
 //	@Param			stats	query	boolean	false	"Need join stats for block"
+//	@Param			cursor	query	string	false	"Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored"
 //	@Produce		json
-//	@Success		200	{array}		responses.Block
+//	@Success		200	{array}		responses.Block	"Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)"
 //	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/block [get]
@@ -119,13 +157,23 @@ func (handler *BlockHandler) List(c echo.Context) error {
 	}
 	req.SetDefault()
 
-	var blocks []*storage.Block
-	if req.Stats {
-		blocks, err = handler.block.ListWithStats(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
-	} else {
-		blocks, err = handler.block.List(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
+	fltrs := storage.BlockListFilter{
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+		Sort:      pgSort(req.Sort),
+		WithStats: req.Stats,
+	}
+	cursorMode := isCursorRequest(c)
+	if cursorMode {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		fltrs.Cursor = cursor
+		fltrs.Offset = 0
 	}
 
+	blocks, err := handler.block.Filter(c.Request().Context(), fltrs)
 	if err != nil {
 		return handleError(c, err, handler.block)
 	}
@@ -135,6 +183,11 @@ func (handler *BlockHandler) List(c echo.Context) error {
 		response[i] = responses.NewBlock(*blocks[i])
 	}
 
+	if cursorMode {
+		return returnPage(c, response, fltrs.Limit, func(b responses.Block) (time.Time, uint64) {
+			return b.Time, b.Id
+		})
+	}
 	return returnArray(c, response)
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -134,6 +135,7 @@ type BlockTestSuite struct {
 	actions    *mock.MockIAction
 	rollups    *mock.MockIRollup
 	state      *mock.MockIState
+	raw        *mock.MockIRawBlock
 	echo       *echo.Echo
 	handler    *BlockHandler
 	ctrl       *gomock.Controller
@@ -150,7 +152,8 @@ func (s *BlockTestSuite) SetupSuite() {
 	s.rollups = mock.NewMockIRollup(s.ctrl)
 	s.actions = mock.NewMockIAction(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.txs, s.actions, s.rollups, s.state, testIndexerName)
+	s.raw = mock.NewMockIRawBlock(s.ctrl)
+	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.txs, s.actions, s.rollups, s.state, s.raw, testIndexerName)
 }
 
 // TearDownSuite -
@@ -210,6 +213,61 @@ func (s *BlockTestSuite) TestGetNoContent() {
 	s.Require().Equal(http.StatusNoContent, rec.Code)
 }
 
+func (s *BlockTestSuite) TestRaw() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/raw")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	blockData, err := types.CompressionGzip.Compress([]byte(`{"block_id":{"hash":"0001"},"block":{"header":{"height":"100"}}}`))
+	s.Require().NoError(err)
+	resultsData, err := types.CompressionGzip.Compress([]byte(`{"height":"100","txs_results":null}`))
+	s.Require().NoError(err)
+
+	s.raw.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.RawBlock{
+			Height:       100,
+			Compression:  types.CompressionGzip,
+			Block:        blockData,
+			BlockResults: resultsData,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Raw(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var block responses.RawBlock
+	err = json.NewDecoder(rec.Body).Decode(&block)
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"block_id":{"hash":"0001"},"block":{"header":{"height":"100"}}}`, string(block.Block))
+	s.Require().JSONEq(`{"height":"100","txs_results":null}`, string(block.BlockResults))
+}
+
+func (s *BlockTestSuite) TestRawNoContent() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height/raw")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	s.raw.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return(storage.RawBlock{}, sql.ErrNoRows).
+		Times(1)
+
+	s.raw.EXPECT().
+		IsNoRows(gomock.Any()).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Raw(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
 func (s *BlockTestSuite) TestGetWithoutStats() {
 	q := make(url.Values)
 	q.Set("stats", "false")
@@ -295,7 +353,10 @@ func (s *BlockTestSuite) TestList() {
 	c.SetPath("/block")
 
 	s.blocks.EXPECT().
-		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit: 10,
+			Sort:  sdk.SortOrderAsc,
+		}).
 		Return([]*storage.Block{
 			&testBlock,
 		}, nil).
@@ -326,7 +387,11 @@ func (s *BlockTestSuite) TestListWithStats() {
 	c.SetPath("/block")
 
 	s.blocks.EXPECT().
-		ListWithStats(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit:     10,
+			Sort:      sdk.SortOrderAsc,
+			WithStats: true,
+		}).
 		Return([]*storage.Block{
 			&testBlockWithStats,
 		}, nil).
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const cursorParam = "cursor"
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// isCursorRequest - returns true if client requested keyset pagination. Empty cursor means the first page.
+func isCursorRequest(c echo.Context) bool {
+	return c.QueryParams().Has(cursorParam)
+}
+
+// encodeCursor - returns opaque token pointing to the entity with passed time and id
+func encodeCursor(t time.Time, id uint64) string {
+	raw := strconv.FormatInt(t.UnixNano(), 10) + ":" + strconv.FormatUint(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor - parses token received from client. Empty token returns empty cursor.
+func decodeCursor(token string) (storage.Cursor, error) {
+	if token == "" {
+		return storage.Cursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return storage.Cursor{}, errors.Wrap(errInvalidCursor, err.Error())
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return storage.Cursor{}, errInvalidCursor
+	}
+	ts, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return storage.Cursor{}, errors.Wrap(errInvalidCursor, err.Error())
+	}
+	entityId, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return storage.Cursor{}, errors.Wrap(errInvalidCursor, err.Error())
+	}
+	return storage.NewCursor(time.Unix(0, ts).UTC(), entityId), nil
+}
+
+// returnPage - returns page of entities with token of the next page. Token is empty if the page is the last one.
+func returnPage[T any](c echo.Context, arr []T, limit int, key func(T) (time.Time, uint64)) error {
+	page := responses.Page[T]{
+		Items: arr,
+	}
+	if page.Items == nil {
+		page.Items = make([]T, 0)
+	}
+	if limit > 0 && len(arr) == limit {
+		page.NextCursor = encodeCursor(key(arr[len(arr)-1]))
+	}
+	return c.JSON(http.StatusOK, page)
+}
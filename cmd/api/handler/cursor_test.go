@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 10, 11, 12, 123456789, time.UTC)
+
+	t.Run("round trip", func(t *testing.T) {
+		cursor, err := decodeCursor(encodeCursor(ts, 100))
+		require.NoError(t, err)
+		require.Equal(t, storage.NewCursor(ts, 100), cursor)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		cursor, err := decodeCursor("")
+		require.NoError(t, err)
+		require.True(t, cursor.IsEmpty())
+	})
+
+	for _, token := range []string{
+		"!!!",
+		base64.RawURLEncoding.EncodeToString([]byte("100")),
+		base64.RawURLEncoding.EncodeToString([]byte("abc:100")),
+		base64.RawURLEncoding.EncodeToString([]byte("100:-1")),
+	} {
+		t.Run("invalid "+token, func(t *testing.T) {
+			_, err := decodeCursor(token)
+			require.ErrorIs(t, err, errInvalidCursor)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	exportFormatCsv    = "csv"
+	exportFormatNdjson = "ndjson"
+
+	exportFlushEvery = 100
+)
+
+// exporter - writes entities to the response one by one in requested format.
+// Response is flushed periodically, so nothing is buffered on the server side.
+type exporter[T any] struct {
+	c       echo.Context
+	format  string
+	csv     *csv.Writer
+	encoder *json.Encoder
+	row     func(T) []string
+	count   int
+}
+
+func newExporter[T any](c echo.Context, format, filename string, header []string, row func(T) []string) (*exporter[T], error) {
+	e := &exporter[T]{
+		c:      c,
+		format: format,
+		row:    row,
+	}
+
+	response := c.Response()
+	switch format {
+	case exportFormatNdjson:
+		response.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		e.encoder = json.NewEncoder(response)
+	default:
+		e.format = exportFormatCsv
+		response.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		e.csv = csv.NewWriter(response)
+	}
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename+"."+e.format))
+	response.WriteHeader(http.StatusOK)
+
+	if e.csv != nil {
+		if err := e.csv.Write(header); err != nil {
+			return nil, errors.Wrap(err, "write csv header")
+		}
+	}
+	return e, nil
+}
+
+// Write - writes single entity
+func (e *exporter[T]) Write(item T) error {
+	switch {
+	case e.csv != nil:
+		if err := e.csv.Write(e.row(item)); err != nil {
+			return errors.Wrap(err, "write csv row")
+		}
+	default:
+		if err := e.encoder.Encode(item); err != nil {
+			return errors.Wrap(err, "write json line")
+		}
+	}
+
+	e.count++
+	if e.count%exportFlushEvery == 0 {
+		return e.flush()
+	}
+	return nil
+}
+
+// Close - flushes the rest of data. Error is reported to sentry because status code is already sent.
+func (e *exporter[T]) Close(err error) error {
+	if flushErr := e.flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		if hub := sentryecho.GetHubFromContext(e.c); hub != nil {
+			hub.CaptureMessage(err.Error())
+		}
+	}
+	return err
+}
+
+func (e *exporter[T]) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return errors.Wrap(err, "flush csv")
+		}
+	}
+	e.c.Response().Flush()
+	return nil
+}
+
+var txExportHeader = []string{
+	"id", "height", "time", "position", "hash", "signer", "nonce", "status", "error", "codespace",
+	"gas_wanted", "gas_used", "actions_count", "action_types",
+}
+
+func txExportRow(tx responses.Tx) []string {
+	return []string{
+		strconv.FormatUint(tx.Id, 10),
+		strconv.FormatInt(int64(tx.Height), 10),
+		tx.Time.UTC().Format(time.RFC3339),
+		strconv.FormatInt(tx.Position, 10),
+		tx.Hash,
+		tx.Signer,
+		strconv.FormatUint(uint64(tx.Nonce), 10),
+		string(tx.Status),
+		tx.Error,
+		tx.Codespace,
+		strconv.FormatInt(tx.GasWanted, 10),
+		strconv.FormatInt(tx.GasUsed, 10),
+		strconv.FormatInt(tx.ActionsCount, 10),
+		strings.Join(tx.ActionTypes, ","),
+	}
+}
+
+var actionExportHeader = []string{
+	"id", "height", "time", "position", "type", "tx_hash", "data",
+}
+
+func actionExportRow(action responses.Action) []string {
+	data, err := json.Marshal(action.Data)
+	if err != nil {
+		data = nil
+	}
+	return []string{
+		strconv.FormatUint(action.Id, 10),
+		strconv.FormatInt(int64(action.Height), 10),
+		action.Time.UTC().Format(time.RFC3339),
+		strconv.FormatInt(action.Position, 10),
+		string(action.Type),
+		action.TxHash,
+		string(data),
+	}
+}
+
+func rollupActionExportRow(action responses.RollupAction) []string {
+	return actionExportRow(action.Action)
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type actionResolver struct {
+	r      *Resolver
+	action storage.Action
+}
+
+func (a *actionResolver) Id() Long           { return Long(a.action.Id) }
+func (a *actionResolver) Height() Long       { return Long(a.action.Height) }
+func (a *actionResolver) Time() graphql.Time { return graphql.Time{Time: a.action.Time} }
+func (a *actionResolver) Position() Long     { return Long(a.action.Position) }
+func (a *actionResolver) Type() string       { return string(a.action.Type) }
+
+func (a *actionResolver) Data() *JSON {
+	if a.action.Data == nil {
+		return nil
+	}
+	return &JSON{Value: a.action.Data}
+}
+
+func (a *actionResolver) Tx(ctx context.Context) (*txResolver, error) {
+	if a.action.TxId == 0 {
+		return nil, nil
+	}
+	tx, err := load[storage.Tx](ctx, loadersFrom(ctx).txs, a.action.TxId)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &txResolver{a.r, *tx}, nil
+}
+
+func newActions[T any](r *Resolver, items []T, action func(T) *storage.Action) []*actionResolver {
+	result := make([]*actionResolver, 0, len(items))
+	for i := range items {
+		if a := action(items[i]); a != nil {
+			result = append(result, &actionResolver{r, *a})
+		}
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+type addressResolver struct {
+	r       *Resolver
+	address storage.Address
+}
+
+func (a *addressResolver) Id() Long            { return Long(a.address.Id) }
+func (a *addressResolver) Hash() string        { return hex.EncodeToString(a.address.Hash) }
+func (a *addressResolver) FirstHeight() Long   { return Long(a.address.Height) }
+func (a *addressResolver) Nonce() Long         { return Long(a.address.Nonce) }
+func (a *addressResolver) ActionsCount() Long  { return Long(a.address.ActionsCount) }
+func (a *addressResolver) SignedTxCount() Long { return Long(a.address.SignedTxCount) }
+
+func (a *addressResolver) Balance() *balanceResolver {
+	if a.address.Balance == nil {
+		return nil
+	}
+	return &balanceResolver{*a.address.Balance}
+}
+
+func (a *addressResolver) Txs(ctx context.Context, args sortedListArgs) ([]*txResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := a.r.txs.ByAddress(ctx, a.address.Id, storage.TxFilter{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newTxResolvers(a.r, txs), nil
+}
+
+func (a *addressResolver) Actions(ctx context.Context, args struct {
+	Limit       int32
+	Offset      int32
+	Sort        string
+	ActionTypes *[]string
+}) ([]*actionResolver, error) {
+	limit, offset, sort, err := sortedListArgs{args.Limit, args.Offset, args.Sort}.validate()
+	if err != nil {
+		return nil, err
+	}
+	mask, err := actionTypesMask(args.ActionTypes)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := a.r.actions.ByAddress(ctx, a.address.Id, storage.AddressActionsFilter{
+		Limit:       limit,
+		Offset:      offset,
+		Sort:        sort,
+		ActionTypes: mask,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newActions(a.r, actions, func(action storage.AddressAction) *storage.Action {
+		return action.Action
+	}), nil
+}
+
+func (a *addressResolver) Rollups(ctx context.Context, args sortedListArgs) ([]*rollupResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	rollups, err := a.r.rollups.ListRollupsByAddress(ctx, a.address.Id, limit, offset, sort)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*rollupResolver, 0, len(rollups))
+	for i := range rollups {
+		if rollups[i].Rollup != nil {
+			result = append(result, &rollupResolver{a.r, *rollups[i].Rollup})
+		}
+	}
+	return result, nil
+}
+
+type balanceResolver struct {
+	balance storage.Balance
+}
+
+func (b *balanceResolver) Currency() string { return b.balance.Currency }
+func (b *balanceResolver) Value() string    { return b.balance.Total.String() }
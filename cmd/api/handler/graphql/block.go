@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type blockResolver struct {
+	r     *Resolver
+	block storage.Block
+}
+
+func (b *blockResolver) Id() Long                { return Long(b.block.Id) }
+func (b *blockResolver) Height() Long            { return Long(b.block.Height) }
+func (b *blockResolver) Time() graphql.Time      { return graphql.Time{Time: b.block.Time} }
+func (b *blockResolver) VersionBlock() Long      { return Long(b.block.VersionBlock) }
+func (b *blockResolver) VersionApp() Long        { return Long(b.block.VersionApp) }
+func (b *blockResolver) Hash() string            { return b.block.Hash.String() }
+func (b *blockResolver) ParentHash() string      { return b.block.ParentHash.String() }
+func (b *blockResolver) LastCommitHash() string  { return b.block.LastCommitHash.String() }
+func (b *blockResolver) DataHash() string        { return b.block.DataHash.String() }
+func (b *blockResolver) ValidatorsHash() string  { return b.block.ValidatorsHash.String() }
+func (b *blockResolver) ConsensusHash() string   { return b.block.ConsensusHash.String() }
+func (b *blockResolver) AppHash() string         { return b.block.AppHash.String() }
+func (b *blockResolver) LastResultsHash() string { return b.block.LastResultsHash.String() }
+func (b *blockResolver) EvidenceHash() string    { return b.block.EvidenceHash.String() }
+func (b *blockResolver) NextValidatorsHash() string {
+	return b.block.NextValidatorsHash.String()
+}
+
+func (b *blockResolver) ActionTypes() []string {
+	return storageTypes.NewActionTypeMaskBits(b.block.ActionTypes).Strings()
+}
+
+func (b *blockResolver) Proposer(ctx context.Context) (*validatorResolver, error) {
+	if b.block.ProposerId == 0 {
+		return nil, nil
+	}
+	validator, err := load[storage.Validator](ctx, loadersFrom(ctx).validators, b.block.ProposerId)
+	if err != nil || validator == nil {
+		return nil, err
+	}
+	return &validatorResolver{b.r, *validator}, nil
+}
+
+func (b *blockResolver) Stats(ctx context.Context) (*blockStatsResolver, error) {
+	if b.block.Stats != nil {
+		return &blockStatsResolver{*b.block.Stats}, nil
+	}
+	stats, err := load[storage.BlockStats](ctx, loadersFrom(ctx).blockStats, uint64(b.block.Height))
+	if err != nil || stats == nil {
+		return nil, err
+	}
+	return &blockStatsResolver{*stats}, nil
+}
+
+func (b *blockResolver) Txs(ctx context.Context, args listArgs) ([]*txResolver, error) {
+	limit, offset, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := loadPage[storage.Tx](ctx, loadersFrom(ctx).blockTxs, b.block.Height, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return newTxResolvers(b.r, txs), nil
+}
+
+func (b *blockResolver) Actions(ctx context.Context, args listArgs) ([]*actionResolver, error) {
+	limit, offset, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	actions, err := b.r.actions.ByBlock(ctx, b.block.Height, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*actionResolver, len(actions))
+	for i := range actions {
+		result[i] = &actionResolver{b.r, actions[i].Action}
+	}
+	return result, nil
+}
+
+type blockStatsResolver struct {
+	stats storage.BlockStats
+}
+
+func (s *blockStatsResolver) TxCount() Long        { return Long(s.stats.TxCount) }
+func (s *blockStatsResolver) BlockTime() Long      { return Long(s.stats.BlockTime) }
+func (s *blockStatsResolver) GasWanted() Long      { return Long(s.stats.GasWanted) }
+func (s *blockStatsResolver) GasUsed() Long        { return Long(s.stats.GasUsed) }
+func (s *blockStatsResolver) SupplyChange() string { return s.stats.SupplyChange.String() }
+func (s *blockStatsResolver) Fee() string          { return s.stats.Fee.String() }
+func (s *blockStatsResolver) BytesInBlock() Long   { return Long(s.stats.BytesInBlock) }
+func (s *blockStatsResolver) DataSize() Long       { return Long(s.stats.DataSize) }
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const (
+	defaultMaxDepth       = 8
+	defaultMaxComplexity  = 5000
+	defaultMaxParallelism = 10
+)
+
+// Config -
+type Config struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Handler - GraphQL endpoint over storage interfaces
+type Handler struct {
+	resolver *Resolver
+	schema   *graphql.Schema
+	limits   limits
+}
+
+// NewHandler -
+func NewHandler(resolver *Resolver, cfg Config) (*Handler, error) {
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = defaultMaxDepth
+	}
+	if cfg.MaxComplexity <= 0 {
+		cfg.MaxComplexity = defaultMaxComplexity
+	}
+
+	s, err := graphql.ParseSchema(schema, resolver,
+		graphql.UseStringDescriptions(),
+		graphql.MaxParallelism(defaultMaxParallelism),
+		graphql.MaxDepth(cfg.MaxDepth),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse graphql schema")
+	}
+	l, err := newLimits(schema, cfg.MaxComplexity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		resolver: resolver,
+		schema:   s,
+		limits:   l,
+	}, nil
+}
+
+// Request - GraphQL request body
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handle godoc
+//
+//	@Summary		GraphQL endpoint
+//	@Description	Executes GraphQL query. Queries are limited by depth and complexity. Complexity of list field is its requested limit multiplied by complexity of selected fields.
+//	@Tags			graphql
+//	@ID				graphql
+//	@Accept			json
+//	@Param			request	body	Request	true	"GraphQL request"
+//	@Produce		json
+//	@Success		200	{object}	map[string]any
+//	@Failure		400	{object}	map[string]any
+//	@Router			/v1/graphql [post]
+func (h *Handler) Handle(c echo.Context) error {
+	var req Request
+	switch c.Request().Method {
+	case http.MethodGet:
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if vars := c.QueryParam("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				return errorResponse(c, errors.Wrap(err, "invalid variables"))
+			}
+		}
+	default:
+		if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+			return errorResponse(c, errors.Wrap(err, "invalid request body"))
+		}
+	}
+
+	if req.Query == "" {
+		return errorResponse(c, errors.New("query is required"))
+	}
+	if errs := h.schema.ValidateWithVariables(req.Query, req.Variables); len(errs) > 0 {
+		return c.JSON(http.StatusBadRequest, graphql.Response{Errors: errs})
+	}
+	if err := h.limits.Check(req.Query, req.OperationName, req.Variables); err != nil {
+		return errorResponse(c, err)
+	}
+
+	ctx := withLoaders(c.Request().Context(), newLoaders(h.resolver))
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	return c.JSON(http.StatusOK, response)
+}
+
+func errorResponse(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, graphql.Response{
+		Errors: []*gqlErrors.QueryError{
+			gqlErrors.Errorf("%s", err.Error()),
+		},
+	})
+}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type testResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GraphQLTestSuite -
+type GraphQLTestSuite struct {
+	suite.Suite
+	blocks     *mock.MockIBlock
+	blockStats *mock.MockIBlockStats
+	txs        *mock.MockITx
+	actions    *mock.MockIAction
+	address    *mock.MockIAddress
+	rollups    *mock.MockIRollup
+	validators *mock.MockIValidator
+	stats      *mock.MockIStats
+	state      *mock.MockIState
+	echo       *echo.Echo
+	handler    *Handler
+	ctrl       *gomock.Controller
+}
+
+// SetupTest -
+func (s *GraphQLTestSuite) SetupTest() {
+	s.echo = echo.New()
+	s.ctrl = gomock.NewController(s.T())
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.blockStats = mock.NewMockIBlockStats(s.ctrl)
+	s.txs = mock.NewMockITx(s.ctrl)
+	s.actions = mock.NewMockIAction(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.validators = mock.NewMockIValidator(s.ctrl)
+	s.stats = mock.NewMockIStats(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+
+	resolver := NewResolver(s.blocks, s.blockStats, s.txs, s.actions, s.address, s.rollups, s.validators, s.stats, s.state, "test")
+	handler, err := NewHandler(resolver, Config{MaxDepth: 5, MaxComplexity: 1000})
+	s.Require().NoError(err)
+	s.handler = handler
+}
+
+// TearDownTest -
+func (s *GraphQLTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteGraphQL_Run(t *testing.T) {
+	suite.Run(t, new(GraphQLTestSuite))
+}
+
+func (s *GraphQLTestSuite) exec(query string, vars map[string]any) (int, testResponse) {
+	body, err := json.Marshal(Request{Query: query, Variables: vars})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/graphql")
+
+	s.Require().NoError(s.handler.Handle(c))
+
+	var response testResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	return rec.Code, response
+}
+
+func (s *GraphQLTestSuite) TestBlocksWithBatchedProposers() {
+	s.blocks.EXPECT().
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit:     2,
+			Sort:      sdk.SortOrderDesc,
+			WithStats: true,
+		}).
+		Return([]*storage.Block{
+			{Id: 2, Height: 101, Time: time.Now(), ProposerId: 1, Stats: &storage.BlockStats{TxCount: 3}},
+			{Id: 1, Height: 100, Time: time.Now(), ProposerId: 2, Stats: &storage.BlockStats{TxCount: 1}},
+		}, nil).
+		Times(1)
+
+	s.validators.EXPECT().
+		ByIds(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids ...uint64) ([]storage.Validator, error) {
+			s.Require().ElementsMatch([]uint64{1, 2}, ids)
+			return []storage.Validator{
+				{Id: 1, Name: "first", Power: decimal.NewFromInt(10)},
+				{Id: 2, Name: "second", Power: decimal.NewFromInt(20)},
+			}, nil
+		}).
+		Times(1)
+
+	code, response := s.exec(`query($limit: Int) { blocks(limit: $limit) { height stats { txCount } proposer { name } } }`, map[string]any{
+		"limit": 2,
+	})
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Empty(response.Errors)
+
+	var blocks []struct {
+		Height int64 `json:"height"`
+		Stats  struct {
+			TxCount int64 `json:"txCount"`
+		} `json:"stats"`
+		Proposer struct {
+			Name string `json:"name"`
+		} `json:"proposer"`
+	}
+	s.Require().NoError(json.Unmarshal(response.Data["blocks"], &blocks))
+	s.Require().Len(blocks, 2)
+	s.Require().EqualValues(101, blocks[0].Height)
+	s.Require().EqualValues(3, blocks[0].Stats.TxCount)
+	s.Require().Equal("first", blocks[0].Proposer.Name)
+	s.Require().Equal("second", blocks[1].Proposer.Name)
+}
+
+func (s *GraphQLTestSuite) TestBlocksWithBatchedStatsAndTxs() {
+	s.validators.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Validator{Id: 1, Name: "first", Power: decimal.NewFromInt(10)}, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByProposer(gomock.Any(), uint64(1), 2, 0, sdk.SortOrderDesc).
+		Return([]storage.Block{
+			{Id: 2, Height: 101, Time: time.Now(), ProposerId: 1},
+			{Id: 1, Height: 100, Time: time.Now(), ProposerId: 1},
+		}, nil).
+		Times(1)
+
+	s.blockStats.EXPECT().
+		ByHeights(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, heights ...pkgTypes.Level) ([]storage.BlockStats, error) {
+			s.Require().ElementsMatch([]pkgTypes.Level{100, 101}, heights)
+			return []storage.BlockStats{
+				{Height: 100, TxCount: 2},
+			}, nil
+		}).
+		Times(1)
+
+	s.txs.EXPECT().
+		ByHeights(gomock.Any(), gomock.Any(), 1, 0).
+		DoAndReturn(func(_ context.Context, heights []pkgTypes.Level, _, _ int) ([]storage.Tx, error) {
+			s.Require().ElementsMatch([]pkgTypes.Level{100, 101}, heights)
+			return []storage.Tx{
+				{Id: 1, Height: 100, Hash: []byte{0x01}},
+				{Id: 3, Height: 101, Hash: []byte{0x03}},
+			}, nil
+		}).
+		Times(1)
+
+	code, response := s.exec(`{ validator(id: 1) { blocks(limit: 2) { height stats { txCount } txs(limit: 1) { hash } } } }`, nil)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Empty(response.Errors)
+
+	var validator struct {
+		Blocks []struct {
+			Height int64 `json:"height"`
+			Stats  *struct {
+				TxCount int64 `json:"txCount"`
+			} `json:"stats"`
+			Txs []struct {
+				Hash string `json:"hash"`
+			} `json:"txs"`
+		} `json:"blocks"`
+	}
+	s.Require().NoError(json.Unmarshal(response.Data["validator"], &validator))
+	s.Require().Len(validator.Blocks, 2)
+	s.Require().Nil(validator.Blocks[0].Stats)
+	s.Require().Len(validator.Blocks[0].Txs, 1)
+	s.Require().Equal("03", validator.Blocks[0].Txs[0].Hash)
+	s.Require().NotNil(validator.Blocks[1].Stats)
+	s.Require().EqualValues(2, validator.Blocks[1].Stats.TxCount)
+	s.Require().Equal("01", validator.Blocks[1].Txs[0].Hash)
+}
+
+func (s *GraphQLTestSuite) TestAddressNotFound() {
+	s.address.EXPECT().
+		ByHash(gomock.Any(), gomock.Any()).
+		Return(storage.Address{}, sql.ErrNoRows).
+		Times(1)
+
+	s.address.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	code, response := s.exec(`{ address(hash: "115f94d8c98ffd73fe65182611140f0edc7c3c94") { id } }`, nil)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Empty(response.Errors)
+	s.Require().Equal("null", string(response.Data["address"]))
+}
+
+func (s *GraphQLTestSuite) TestInvalidLimit() {
+	code, response := s.exec(`{ blocks(limit: 0) { height } }`, nil)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Len(response.Errors, 1)
+	s.Require().Contains(response.Errors[0].Message, "limit should be in range")
+}
+
+func (s *GraphQLTestSuite) TestMaxDepth() {
+	code, response := s.exec(`{ blocks(limit: 1) { txs(limit: 1) { actions(limit: 1) { tx { signer { txs(limit: 1) { hash } } } } } } }`, nil)
+	s.Require().Equal(http.StatusBadRequest, code)
+	s.Require().Len(response.Errors, 1)
+	s.Require().Contains(response.Errors[0].Message, "depth")
+}
+
+func (s *GraphQLTestSuite) TestMaxComplexity() {
+	code, response := s.exec(`{ blocks(limit: 100) { txs(limit: 100) { hash } } }`, nil)
+	s.Require().Equal(http.StatusBadRequest, code)
+	s.Require().Len(response.Errors, 1)
+	s.Require().Contains(response.Errors[0].Message, "complexity")
+}
+
+func (s *GraphQLTestSuite) TestMaxDepthFragment() {
+	code, response := s.exec(`query { blocks(limit: 1) { ...txs } } fragment txs on Block { txs(limit: 1) { actions(limit: 1) { tx { signer { txs(limit: 1) { hash } } } } } }`, nil)
+	s.Require().Equal(http.StatusBadRequest, code)
+	s.Require().Len(response.Errors, 1)
+	s.Require().Contains(response.Errors[0].Message, "depth")
+}
+
+func (s *GraphQLTestSuite) TestInvalidQuery() {
+	for _, query := range []string{
+		`{ blocks(limit: 100) { txs(limit: 100) { hash }`,
+		`{ blocks(limit: 100) { unknown } }`,
+	} {
+		code, response := s.exec(query, nil)
+		s.Require().Equal(http.StatusBadRequest, code, query)
+		s.Require().NotEmpty(response.Errors, query)
+	}
+}
+
+func (s *GraphQLTestSuite) TestIntrospection() {
+	code, response := s.exec(`{ __schema { types { name fields { name args { name } } } } }`, nil)
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Empty(response.Errors)
+}
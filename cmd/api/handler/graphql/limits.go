@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const defaultListSize = 10
+
+var errMaxComplexity = errors.New("query complexity exceeds the limit")
+
+// limits - static analysis of the query complexity before execution. Each field costs 1,
+// cost of list field children is multiplied by requested limit. Introspection fields are not counted.
+// Query depth is limited by the executor itself.
+type limits struct {
+	schema        *ast.Schema
+	maxComplexity int
+}
+
+func newLimits(schema string, maxComplexity int) (limits, error) {
+	s, err := gqlparser.LoadSchema(&ast.Source{Input: schema})
+	if err != nil {
+		return limits{}, errors.Wrap(err, "load schema")
+	}
+	return limits{
+		schema:        s,
+		maxComplexity: maxComplexity,
+	}, nil
+}
+
+// Check - returns error if query exceeds complexity limit or can't be analyzed
+func (l limits) Check(query, operationName string, vars map[string]any) error {
+	doc, errs := gqlparser.LoadQuery(l.schema, query)
+	if len(errs) > 0 {
+		return errors.Wrap(errs, "invalid query")
+	}
+	op := doc.Operations.ForName(operationName)
+	if op == nil {
+		return errors.Errorf("unknown operation: %s", operationName)
+	}
+
+	complexity := l.selectionSet(op.SelectionSet, vars)
+	if l.maxComplexity > 0 && complexity > l.maxComplexity {
+		return errors.Wrapf(errMaxComplexity, "%d > %d", complexity, l.maxComplexity)
+	}
+	return nil
+}
+
+func (l limits) selectionSet(set ast.SelectionSet, vars map[string]any) (complexity int) {
+	for _, selection := range set {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			complexity += 1 + l.selectionSet(s.SelectionSet, vars)*listSize(s, vars)
+		case *ast.InlineFragment:
+			complexity += l.selectionSet(s.SelectionSet, vars)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				complexity += l.selectionSet(s.Definition.SelectionSet, vars)
+			}
+		}
+	}
+	return
+}
+
+func listSize(field *ast.Field, vars map[string]any) int {
+	if field.Definition == nil || field.Definition.Type.Elem == nil {
+		return 1
+	}
+	if field.Definition.Arguments.ForName("limit") == nil {
+		return defaultListSize
+	}
+
+	switch limit := field.ArgumentMap(vars)["limit"].(type) {
+	case int64:
+		return max(int(limit), 1)
+	case float64:
+		return max(int(limit), 1)
+	case int:
+		return max(limit, 1)
+	default:
+		return defaultListSize
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/graph-gophers/dataloader"
+)
+
+const loaderWait = 2 * time.Millisecond
+
+type loadersKey struct{}
+
+// loaders - per-request batch loaders. Entities requested by sibling resolvers are fetched by a single query.
+type loaders struct {
+	addresses  *dataloader.Loader
+	validators *dataloader.Loader
+	txs        *dataloader.Loader
+	blockStats *dataloader.Loader
+	blockTxs   *dataloader.Loader
+}
+
+func newLoaders(r *Resolver) *loaders {
+	return &loaders{
+		addresses: newLoader(r.address.ByIds, func(a storage.Address) uint64 {
+			return a.Id
+		}),
+		validators: newLoader(r.validators.ByIds, func(v storage.Validator) uint64 {
+			return v.Id
+		}),
+		txs: newLoader(r.txs.ByIds, func(tx storage.Tx) uint64 {
+			return tx.Id
+		}),
+		blockStats: newLoader(func(ctx context.Context, heights ...uint64) ([]storage.BlockStats, error) {
+			levels := make([]pkgTypes.Level, len(heights))
+			for i := range heights {
+				levels[i] = pkgTypes.Level(heights[i])
+			}
+			return r.blockStats.ByHeights(ctx, levels...)
+		}, func(stats storage.BlockStats) uint64 {
+			return uint64(stats.Height)
+		}),
+		blockTxs: newPageLoader(r.txs.ByHeights, func(tx storage.Tx) pkgTypes.Level {
+			return tx.Height
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
+
+// idKey - dataloader key for internal identities
+type idKey uint64
+
+// String -
+func (k idKey) String() string {
+	return strconv.FormatUint(uint64(k), 10)
+}
+
+// Raw -
+func (k idKey) Raw() any {
+	return uint64(k)
+}
+
+func newLoader[T any](fetch func(ctx context.Context, ids ...uint64) ([]T, error), id func(T) uint64) *dataloader.Loader {
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ids := make([]uint64, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].Raw().(uint64)
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		items, err := fetch(ctx, ids...)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result{Error: err}
+			}
+			return results
+		}
+
+		byId := make(map[uint64]T, len(items))
+		for i := range items {
+			byId[id(items[i])] = items[i]
+		}
+		for i := range ids {
+			results[i] = new(dataloader.Result)
+			if item, ok := byId[ids[i]]; ok {
+				results[i].Data = item
+			}
+		}
+		return results
+	}, dataloader.WithWait(loaderWait))
+}
+
+// load - returns entity by id using batch loader. Returns nil if entity is not found.
+func load[T any](ctx context.Context, loader *dataloader.Loader, id uint64) (*T, error) {
+	data, err := loader.Load(ctx, idKey(id))()
+	if err != nil {
+		return nil, err
+	}
+	item, ok := data.(T)
+	if !ok {
+		return nil, nil
+	}
+	return &item, nil
+}
+
+// pageKey - dataloader key for a page of block's entities
+type pageKey struct {
+	height pkgTypes.Level
+	limit  int
+	offset int
+}
+
+// String -
+func (k pageKey) String() string {
+	return fmt.Sprintf("%d:%d:%d", k.height, k.limit, k.offset)
+}
+
+// Raw -
+func (k pageKey) Raw() any {
+	return k
+}
+
+type page struct {
+	limit  int
+	offset int
+}
+
+// newPageLoader - returns loader of block's entities pages. Keys with the same limit and offset are fetched by a single query.
+func newPageLoader[T any](fetch func(ctx context.Context, heights []pkgTypes.Level, limit, offset int) ([]T, error), height func(T) pkgTypes.Level) *dataloader.Loader {
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		groups := make(map[page][]int)
+		for i := range keys {
+			key := keys[i].Raw().(pageKey)
+			p := page{key.limit, key.offset}
+			groups[p] = append(groups[p], i)
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for p, indices := range groups {
+			heights := make([]pkgTypes.Level, len(indices))
+			for i, idx := range indices {
+				heights[i] = keys[idx].Raw().(pageKey).height
+			}
+
+			items, err := fetch(ctx, heights, p.limit, p.offset)
+			if err != nil {
+				for _, idx := range indices {
+					results[idx] = &dataloader.Result{Error: err}
+				}
+				continue
+			}
+
+			byHeight := make(map[pkgTypes.Level][]T, len(heights))
+			for i := range items {
+				h := height(items[i])
+				byHeight[h] = append(byHeight[h], items[i])
+			}
+			for i, idx := range indices {
+				results[idx] = &dataloader.Result{Data: byHeight[heights[i]]}
+			}
+		}
+		return results
+	}, dataloader.WithWait(loaderWait))
+}
+
+// loadPage - returns page of block's entities using batch loader
+func loadPage[T any](ctx context.Context, loader *dataloader.Loader, height pkgTypes.Level, limit, offset int) ([]T, error) {
+	data, err := loader.Load(ctx, pageKey{height, limit, offset})()
+	if err != nil {
+		return nil, err
+	}
+	items, _ := data.([]T)
+	return items, nil
+}
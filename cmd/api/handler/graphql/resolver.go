@@ -0,0 +1,367 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+)
+
+const maxListSize = 100
+
+// Resolver - root resolver of the query type
+type Resolver struct {
+	blocks      storage.IBlock
+	blockStats  storage.IBlockStats
+	txs         storage.ITx
+	actions     storage.IAction
+	address     storage.IAddress
+	rollups     storage.IRollup
+	validators  storage.IValidator
+	stats       storage.IStats
+	state       storage.IState
+	indexerName string
+}
+
+// NewResolver -
+func NewResolver(
+	blocks storage.IBlock,
+	blockStats storage.IBlockStats,
+	txs storage.ITx,
+	actions storage.IAction,
+	address storage.IAddress,
+	rollups storage.IRollup,
+	validators storage.IValidator,
+	stats storage.IStats,
+	state storage.IState,
+	indexerName string,
+) *Resolver {
+	return &Resolver{
+		blocks:      blocks,
+		blockStats:  blockStats,
+		txs:         txs,
+		actions:     actions,
+		address:     address,
+		rollups:     rollups,
+		validators:  validators,
+		stats:       stats,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+// listArgs - pagination arguments. Arguments have default values in the schema, so they are always set.
+type listArgs struct {
+	Limit  int32
+	Offset int32
+}
+
+func (args listArgs) validate() (limit, offset int, err error) {
+	if args.Limit < 1 || args.Limit > maxListSize {
+		return 0, 0, errors.Errorf("limit should be in range [1, %d]", maxListSize)
+	}
+	if args.Offset < 0 {
+		return 0, 0, errors.New("offset should be non-negative")
+	}
+	return int(args.Limit), int(args.Offset), nil
+}
+
+type sortedListArgs struct {
+	Limit  int32
+	Offset int32
+	Sort   string
+}
+
+func (args sortedListArgs) validate() (limit, offset int, sort sdk.SortOrder, err error) {
+	limit, offset, err = listArgs{Limit: args.Limit, Offset: args.Offset}.validate()
+	return limit, offset, sortOrder(args.Sort), err
+}
+
+func sortOrder(sort string) sdk.SortOrder {
+	if sort == "ASC" {
+		return sdk.SortOrderAsc
+	}
+	return sdk.SortOrderDesc
+}
+
+func actionTypesMask(actionTypes *[]string) (storageTypes.ActionTypeMask, error) {
+	mask := storageTypes.NewActionTypeMask()
+	if actionTypes == nil {
+		return mask, nil
+	}
+	for _, typ := range *actionTypes {
+		actionType, err := storageTypes.ParseActionType(typ)
+		if err != nil {
+			return mask, err
+		}
+		mask.SetType(actionType)
+	}
+	return mask, nil
+}
+
+// Head -
+func (r *Resolver) Head(ctx context.Context) (*stateResolver, error) {
+	state, err := r.state.ByName(ctx, r.indexerName)
+	if err != nil {
+		if r.state.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &stateResolver{state}, nil
+}
+
+// Summary -
+func (r *Resolver) Summary(ctx context.Context) (*summaryResolver, error) {
+	summary, err := r.stats.Summary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &summaryResolver{summary}, nil
+}
+
+// Series -
+func (r *Resolver) Series(ctx context.Context, args struct {
+	Name      string
+	Timeframe string
+	From      *graphql.Time
+	To        *graphql.Time
+}) ([]*seriesItemResolver, error) {
+	var req storage.SeriesRequest
+	if args.From != nil {
+		req.From = args.From.UTC()
+	}
+	if args.To != nil {
+		req.To = args.To.UTC()
+	}
+
+	items, err := r.stats.Series(ctx, storage.Timeframe(strings.ToLower(args.Timeframe)), strings.ToLower(args.Name), req)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*seriesItemResolver, len(items))
+	for i := range items {
+		result[i] = &seriesItemResolver{items[i]}
+	}
+	return result, nil
+}
+
+// Block -
+func (r *Resolver) Block(ctx context.Context, args struct{ Height Long }) (*blockResolver, error) {
+	block, err := r.blocks.ByHeight(ctx, types.Level(args.Height), true)
+	if err != nil {
+		if r.blocks.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &blockResolver{r, block}, nil
+}
+
+// Blocks -
+func (r *Resolver) Blocks(ctx context.Context, args sortedListArgs) ([]*blockResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := r.blocks.Filter(ctx, storage.BlockListFilter{
+		Limit:     limit,
+		Offset:    offset,
+		Sort:      sort,
+		WithStats: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*blockResolver, len(blocks))
+	for i := range blocks {
+		result[i] = &blockResolver{r, *blocks[i]}
+	}
+	return result, nil
+}
+
+// Tx -
+func (r *Resolver) Tx(ctx context.Context, args struct{ Hash string }) (*txResolver, error) {
+	hash, err := hex.DecodeString(args.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transaction hash")
+	}
+	tx, err := r.txs.ByHash(ctx, hash)
+	if err != nil {
+		if r.txs.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &txResolver{r, tx}, nil
+}
+
+// Txs -
+func (r *Resolver) Txs(ctx context.Context, args struct {
+	Limit       int32
+	Offset      int32
+	Sort        string
+	Status      *[]string
+	ActionTypes *[]string
+	Height      *Long
+	From        *graphql.Time
+	To          *graphql.Time
+}) ([]*txResolver, error) {
+	limit, offset, sort, err := sortedListArgs{args.Limit, args.Offset, args.Sort}.validate()
+	if err != nil {
+		return nil, err
+	}
+	fltrs := storage.TxFilter{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+	if args.Status != nil {
+		for _, status := range *args.Status {
+			if _, err := storageTypes.ParseStatus(status); err != nil {
+				return nil, err
+			}
+		}
+		fltrs.Status = *args.Status
+	}
+	if fltrs.ActionTypes, err = actionTypesMask(args.ActionTypes); err != nil {
+		return nil, err
+	}
+	if args.Height != nil {
+		fltrs.Height = uint64(*args.Height)
+	}
+	if args.From != nil {
+		fltrs.TimeFrom = args.From.UTC()
+	}
+	if args.To != nil {
+		fltrs.TimeTo = args.To.UTC()
+	}
+
+	txs, err := r.txs.Filter(ctx, fltrs)
+	if err != nil {
+		return nil, err
+	}
+	return newTxResolvers(r, txs), nil
+}
+
+// Address -
+func (r *Resolver) Address(ctx context.Context, args struct{ Hash string }) (*addressResolver, error) {
+	hash, err := hex.DecodeString(args.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address")
+	}
+	address, err := r.address.ByHash(ctx, hash)
+	if err != nil {
+		if r.address.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &addressResolver{r, address}, nil
+}
+
+// Addresses -
+func (r *Resolver) Addresses(ctx context.Context, args sortedListArgs) ([]*addressResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := r.address.ListWithBalance(ctx, storage.AddressListFilter{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*addressResolver, len(addresses))
+	for i := range addresses {
+		result[i] = &addressResolver{r, addresses[i]}
+	}
+	return result, nil
+}
+
+// Rollup -
+func (r *Resolver) Rollup(ctx context.Context, args struct{ Hash string }) (*rollupResolver, error) {
+	hash, err := base64.URLEncoding.DecodeString(args.Hash)
+	if err != nil {
+		if hash, err = base64.StdEncoding.DecodeString(args.Hash); err != nil {
+			return nil, errors.Wrap(err, "invalid rollup hash")
+		}
+	}
+	rollup, err := r.rollups.ByHash(ctx, hash)
+	if err != nil {
+		if r.rollups.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rollupResolver{r, rollup}, nil
+}
+
+// Rollups -
+func (r *Resolver) Rollups(ctx context.Context, args struct {
+	Limit  int32
+	Offset int32
+	Sort   string
+	SortBy string
+}) ([]*rollupResolver, error) {
+	limit, offset, sort, err := sortedListArgs{args.Limit, args.Offset, args.Sort}.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := r.rollups.ListExt(ctx, storage.RollupListFilter{
+		Limit:     limit,
+		Offset:    offset,
+		SortOrder: sort,
+		SortField: strings.ToLower(args.SortBy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*rollupResolver, len(rollups))
+	for i := range rollups {
+		result[i] = &rollupResolver{r, rollups[i]}
+	}
+	return result, nil
+}
+
+// Validator -
+func (r *Resolver) Validator(ctx context.Context, args struct{ Id Long }) (*validatorResolver, error) {
+	validator, err := r.validators.GetByID(ctx, uint64(args.Id))
+	if err != nil {
+		if r.validators.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &validatorResolver{r, *validator}, nil
+}
+
+// Validators -
+func (r *Resolver) Validators(ctx context.Context, args sortedListArgs) ([]*validatorResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := r.validators.List(ctx, uint64(limit), uint64(offset), sort)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*validatorResolver, len(validators))
+	for i := range validators {
+		result[i] = &validatorResolver{r, *validators[i]}
+	}
+	return result, nil
+}
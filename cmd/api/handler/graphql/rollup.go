@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+type rollupResolver struct {
+	r      *Resolver
+	rollup storage.Rollup
+}
+
+func (r *rollupResolver) Id() Long           { return Long(r.rollup.Id) }
+func (r *rollupResolver) Hash() string       { return base64.URLEncoding.EncodeToString(r.rollup.AstriaId) }
+func (r *rollupResolver) FirstHeight() Long  { return Long(r.rollup.FirstHeight) }
+func (r *rollupResolver) ActionsCount() Long { return Long(r.rollup.ActionsCount) }
+func (r *rollupResolver) Size() Long         { return Long(r.rollup.Size) }
+
+func (r *rollupResolver) BridgeAddress(ctx context.Context) (*addressResolver, error) {
+	if r.rollup.BridgeAddressId == 0 {
+		return nil, nil
+	}
+	address, err := load[storage.Address](ctx, loadersFrom(ctx).addresses, r.rollup.BridgeAddressId)
+	if err != nil || address == nil {
+		return nil, err
+	}
+	return &addressResolver{r.r, *address}, nil
+}
+
+func (r *rollupResolver) Actions(ctx context.Context, args sortedListArgs) ([]*actionResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	actions, err := r.r.actions.ByRollup(ctx, r.rollup.Id, storage.RollupActionsFilter{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newActions(r.r, actions, func(action storage.RollupAction) *storage.Action {
+		return action.Action
+	}), nil
+}
+
+func (r *rollupResolver) Addresses(ctx context.Context, args sortedListArgs) ([]*addressResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := r.r.rollups.Addresses(ctx, r.rollup.Id, limit, offset, sort)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*addressResolver, 0, len(addresses))
+	for i := range addresses {
+		if addresses[i].Address != nil {
+			result = append(result, &addressResolver{r.r, *addresses[i].Address})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Long - 64-bit integer scalar. Built-in Int scalar is 32-bit only.
+type Long int64
+
+// ImplementsGraphQLType -
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+// UnmarshalGraphQL -
+func (l *Long) UnmarshalGraphQL(input any) error {
+	switch value := input.(type) {
+	case int32:
+		*l = Long(value)
+	case int64:
+		*l = Long(value)
+	case int:
+		*l = Long(value)
+	case float64:
+		*l = Long(value)
+	case string:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid Long value")
+		}
+		*l = Long(i)
+	default:
+		return errors.Errorf("wrong type for Long: %T", input)
+	}
+	return nil
+}
+
+// MarshalJSON -
+func (l Long) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(l), 10), nil
+}
+
+// JSON - scalar for arbitrary JSON values such as action data
+type JSON struct {
+	Value any
+}
+
+// ImplementsGraphQLType -
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+// UnmarshalGraphQL -
+func (j *JSON) UnmarshalGraphQL(input any) error {
+	j.Value = input
+	return nil
+}
+
+// MarshalJSON -
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Value)
+}
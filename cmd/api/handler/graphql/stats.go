@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"encoding/hex"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type stateResolver struct {
+	state storage.State
+}
+
+func (s *stateResolver) Name() string           { return s.state.Name }
+func (s *stateResolver) Height() Long           { return Long(s.state.LastHeight) }
+func (s *stateResolver) Hash() string           { return hex.EncodeToString(s.state.LastHash) }
+func (s *stateResolver) Time() graphql.Time     { return graphql.Time{Time: s.state.LastTime} }
+func (s *stateResolver) ChainId() string        { return s.state.ChainId }
+func (s *stateResolver) TotalTx() Long          { return Long(s.state.TotalTx) }
+func (s *stateResolver) TotalAccounts() Long    { return Long(s.state.TotalAccounts) }
+func (s *stateResolver) TotalRollups() Long     { return Long(s.state.TotalRollups) }
+func (s *stateResolver) TotalValidators() int32 { return int32(s.state.TotalValidators) }
+func (s *stateResolver) TotalSupply() string    { return s.state.TotalSupply.String() }
+func (s *stateResolver) TotalFee() string       { return s.state.TotalFee.String() }
+
+type summaryResolver struct {
+	summary storage.NetworkSummary
+}
+
+func (s *summaryResolver) DataSize() Long     { return Long(s.summary.DataSize) }
+func (s *summaryResolver) Tps() float64       { return s.summary.TPS }
+func (s *summaryResolver) Bps() float64       { return s.summary.BPS }
+func (s *summaryResolver) Rbps() float64      { return s.summary.RBPS }
+func (s *summaryResolver) Fee() string        { return s.summary.Fee.String() }
+func (s *summaryResolver) Supply() string     { return s.summary.Supply.String() }
+func (s *summaryResolver) BlockTime() float64 { return s.summary.BlockTime }
+func (s *summaryResolver) TxCount() Long      { return Long(s.summary.TxCount) }
+func (s *summaryResolver) BytesInBlock() Long { return Long(s.summary.BytesInBlock) }
+
+type seriesItemResolver struct {
+	item storage.SeriesItem
+}
+
+func (s *seriesItemResolver) Time() graphql.Time { return graphql.Time{Time: s.item.Time} }
+func (s *seriesItemResolver) Value() string      { return s.item.Value }
+func (s *seriesItemResolver) Max() *string       { return optional(s.item.Max) }
+func (s *seriesItemResolver) Min() *string       { return optional(s.item.Min) }
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type txResolver struct {
+	r  *Resolver
+	tx storage.Tx
+}
+
+func newTxResolvers(r *Resolver, txs []storage.Tx) []*txResolver {
+	result := make([]*txResolver, len(txs))
+	for i := range txs {
+		result[i] = &txResolver{r, txs[i]}
+	}
+	return result
+}
+
+func (t *txResolver) Id() Long           { return Long(t.tx.Id) }
+func (t *txResolver) Height() Long       { return Long(t.tx.Height) }
+func (t *txResolver) Time() graphql.Time { return graphql.Time{Time: t.tx.Time} }
+func (t *txResolver) Position() Long     { return Long(t.tx.Position) }
+func (t *txResolver) GasWanted() Long    { return Long(t.tx.GasWanted) }
+func (t *txResolver) GasUsed() Long      { return Long(t.tx.GasUsed) }
+func (t *txResolver) ActionsCount() Long { return Long(t.tx.ActionsCount) }
+func (t *txResolver) Nonce() Long        { return Long(t.tx.Nonce) }
+func (t *txResolver) Hash() string       { return hex.EncodeToString(t.tx.Hash) }
+func (t *txResolver) Signature() string  { return hex.EncodeToString(t.tx.Signature) }
+func (t *txResolver) Status() string     { return t.tx.Status.String() }
+func (t *txResolver) Error() *string     { return optional(t.tx.Error) }
+func (t *txResolver) Codespace() *string { return optional(t.tx.Codespace) }
+func (t *txResolver) ActionTypes() []string {
+	return storageTypes.NewActionTypeMaskBits(t.tx.ActionTypes).Strings()
+}
+
+func (t *txResolver) Signer(ctx context.Context) (*addressResolver, error) {
+	if t.tx.Signer != nil && len(t.tx.Signer.Hash) > 0 {
+		return &addressResolver{t.r, *t.tx.Signer}, nil
+	}
+	if t.tx.SignerId == 0 {
+		return nil, nil
+	}
+	address, err := load[storage.Address](ctx, loadersFrom(ctx).addresses, t.tx.SignerId)
+	if err != nil || address == nil {
+		return nil, err
+	}
+	return &addressResolver{t.r, *address}, nil
+}
+
+func (t *txResolver) Actions(ctx context.Context, args listArgs) ([]*actionResolver, error) {
+	limit, offset, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	actions, err := t.r.actions.ByTxId(ctx, t.tx.Id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*actionResolver, len(actions))
+	for i := range actions {
+		result[i] = &actionResolver{t.r, actions[i]}
+	}
+	return result, nil
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
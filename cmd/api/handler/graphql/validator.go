@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+type validatorResolver struct {
+	r         *Resolver
+	validator storage.Validator
+}
+
+func (v *validatorResolver) Id() Long           { return Long(v.validator.Id) }
+func (v *validatorResolver) Address() string    { return v.validator.Address }
+func (v *validatorResolver) Name() string       { return v.validator.Name }
+func (v *validatorResolver) PubkeyType() string { return v.validator.PubkeyType }
+func (v *validatorResolver) Pubkey() string     { return hex.EncodeToString(v.validator.PubKey) }
+func (v *validatorResolver) Power() string      { return v.validator.Power.String() }
+func (v *validatorResolver) Height() Long       { return Long(v.validator.Height) }
+
+func (v *validatorResolver) Blocks(ctx context.Context, args sortedListArgs) ([]*blockResolver, error) {
+	limit, offset, sort, err := args.validate()
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := v.r.blocks.ByProposer(ctx, v.validator.Id, limit, offset, sort)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*blockResolver, len(blocks))
+	for i := range blocks {
+		result[i] = &blockResolver{v.r, blocks[i]}
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+)
+
+type AddressServer struct {
+	apiv1.UnimplementedAddressServiceServer
+
+	address     storage.IAddress
+	txs         storage.ITx
+	actions     storage.IAction
+	rollups     storage.IRollup
+	state       storage.IState
+	indexerName string
+}
+
+var _ apiv1.AddressServiceServer = (*AddressServer)(nil)
+
+func NewAddressServer(
+	address storage.IAddress,
+	txs storage.ITx,
+	actions storage.IAction,
+	rollups storage.IRollup,
+	state storage.IState,
+	indexerName string,
+) *AddressServer {
+	return &AddressServer{
+		address:     address,
+		txs:         txs,
+		actions:     actions,
+		rollups:     rollups,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+func (s *AddressServer) byHash(ctx context.Context, hash string) (storage.Address, error) {
+	h, err := decodeHash(hash)
+	if err != nil {
+		return storage.Address{}, err
+	}
+	address, err := s.address.ByHash(ctx, h)
+	if err != nil {
+		return address, handleError(err, s.address)
+	}
+	return address, nil
+}
+
+func (s *AddressServer) GetAddress(ctx context.Context, req *apiv1.GetAddressRequest) (*apiv1.Address, error) {
+	address, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := s.rollups.ByBridgeAddress(ctx, address.Id)
+	if err != nil && !s.rollups.IsNoRows(err) {
+		return nil, handleError(err, s.rollups)
+	}
+
+	return newAddress(responses.NewAddress(address, &rollup)), nil
+}
+
+func (s *AddressServer) ListAddresses(ctx context.Context, req *apiv1.ListAddressesRequest) (*apiv1.ListAddressesResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.address.ListWithBalance(ctx, storage.AddressListFilter{
+		Limit:  p.Limit,
+		Offset: p.Offset,
+		Sort:   p.Sort,
+	})
+	if err != nil {
+		return nil, handleError(err, s.address)
+	}
+
+	response := &apiv1.ListAddressesResponse{
+		Addresses: make([]*apiv1.Address, len(addresses)),
+	}
+	for i := range addresses {
+		response.Addresses[i] = newAddress(responses.NewAddress(addresses[i], nil))
+	}
+	return response, nil
+}
+
+func (s *AddressServer) CountAddresses(ctx context.Context, _ *apiv1.CountAddressesRequest) (*apiv1.CountResponse, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		return nil, handleError(err, s.state)
+	}
+	return &apiv1.CountResponse{Count: state.TotalAccounts}, nil
+}
+
+func (s *AddressServer) GetAddressTxs(ctx context.Context, req *apiv1.GetAddressTxsRequest) (*apiv1.ListTxsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStatus(req.GetStatus()); err != nil {
+		return nil, err
+	}
+	mask, err := newActionTypeMask(req.GetActionTypes())
+	if err != nil {
+		return nil, err
+	}
+	address, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.txs.ByAddress(ctx, address.Id, storage.TxFilter{
+		Limit:       p.Limit,
+		Offset:      p.Offset,
+		Sort:        p.Sort,
+		Status:      req.GetStatus(),
+		Height:      req.GetHeight(),
+		ActionTypes: mask,
+	})
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+
+	response := make([]responses.Tx, len(txs))
+	for i := range txs {
+		response[i] = responses.NewTx(txs[i])
+	}
+	result, err := newTxs(response)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+	return &apiv1.ListTxsResponse{Txs: result}, nil
+}
+
+func (s *AddressServer) GetAddressActions(ctx context.Context, req *apiv1.GetAddressActionsRequest) (*apiv1.ListActionsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	mask, err := newActionTypeMask(req.GetActionTypes())
+	if err != nil {
+		return nil, err
+	}
+	address, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.actions.ByAddress(ctx, address.Id, storage.AddressActionsFilter{
+		Limit:       p.Limit,
+		Offset:      p.Offset,
+		Sort:        p.Sort,
+		ActionTypes: mask,
+	})
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+
+	response := make([]responses.Action, len(actions))
+	for i := range actions {
+		response[i] = responses.NewAddressAction(actions[i])
+	}
+	result, err := newActions(response)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+	return &apiv1.ListActionsResponse{Actions: result}, nil
+}
+
+func (s *AddressServer) GetAddressRollups(ctx context.Context, req *apiv1.GetAddressListRequest) (*apiv1.ListRollupsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	address, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := s.rollups.ListRollupsByAddress(ctx, address.Id, p.Limit, p.Offset, p.Sort)
+	if err != nil {
+		return nil, handleError(err, s.rollups)
+	}
+
+	response := &apiv1.ListRollupsResponse{
+		Rollups: make([]*apiv1.Rollup, 0, len(rollups)),
+	}
+	for i := range rollups {
+		if rollups[i].Rollup != nil {
+			response.Rollups = append(response.Rollups, newRollup(responses.NewRollup(rollups[i].Rollup)))
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+type BlockServer struct {
+	apiv1.UnimplementedBlockServiceServer
+
+	blocks      storage.IBlock
+	blockStats  storage.IBlockStats
+	txs         storage.ITx
+	actions     storage.IAction
+	state       storage.IState
+	indexerName string
+}
+
+var _ apiv1.BlockServiceServer = (*BlockServer)(nil)
+
+func NewBlockServer(
+	blocks storage.IBlock,
+	blockStats storage.IBlockStats,
+	txs storage.ITx,
+	actions storage.IAction,
+	state storage.IState,
+	indexerName string,
+) *BlockServer {
+	return &BlockServer{
+		blocks:      blocks,
+		blockStats:  blockStats,
+		txs:         txs,
+		actions:     actions,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+func (s *BlockServer) GetBlock(ctx context.Context, req *apiv1.GetBlockRequest) (*apiv1.Block, error) {
+	block, err := s.blocks.ByHeight(ctx, types.Level(req.GetHeight()), req.GetStats())
+	if err != nil {
+		return nil, handleError(err, s.blocks)
+	}
+	return newBlock(responses.NewBlock(block)), nil
+}
+
+func (s *BlockServer) ListBlocks(ctx context.Context, req *apiv1.ListBlocksRequest) (*apiv1.ListBlocksResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.blocks.Filter(ctx, storage.BlockListFilter{
+		Limit:     p.Limit,
+		Offset:    p.Offset,
+		Sort:      p.Sort,
+		WithStats: req.GetStats(),
+	})
+	if err != nil {
+		return nil, handleError(err, s.blocks)
+	}
+
+	response := &apiv1.ListBlocksResponse{
+		Blocks: make([]*apiv1.Block, len(blocks)),
+	}
+	for i := range blocks {
+		response.Blocks[i] = newBlock(responses.NewBlock(*blocks[i]))
+	}
+	return response, nil
+}
+
+func (s *BlockServer) CountBlocks(ctx context.Context, _ *apiv1.CountBlocksRequest) (*apiv1.CountResponse, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		return nil, handleError(err, s.state)
+	}
+	return &apiv1.CountResponse{
+		Count: int64(state.LastHeight) + 1, // + genesis block
+	}, nil
+}
+
+func (s *BlockServer) GetBlockTxs(ctx context.Context, req *apiv1.GetBlockListRequest) (*apiv1.ListTxsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.txs.ByHeight(ctx, types.Level(req.GetHeight()), p.Limit, p.Offset)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+
+	response := make([]responses.Tx, len(txs))
+	for i := range txs {
+		response[i] = responses.NewTx(txs[i])
+	}
+	result, err := newTxs(response)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+	return &apiv1.ListTxsResponse{Txs: result}, nil
+}
+
+func (s *BlockServer) GetBlockActions(ctx context.Context, req *apiv1.GetBlockListRequest) (*apiv1.ListActionsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.actions.ByBlock(ctx, types.Level(req.GetHeight()), p.Limit, p.Offset)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+
+	response := make([]responses.Action, len(actions))
+	for i := range actions {
+		response[i] = responses.NewActionWithTx(actions[i])
+	}
+	result, err := newActions(response)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+	return &apiv1.ListActionsResponse{Actions: result}, nil
+}
+
+func (s *BlockServer) GetBlockStats(ctx context.Context, req *apiv1.GetBlockStatsRequest) (*apiv1.BlockStats, error) {
+	stats, err := s.blockStats.ByHeight(ctx, types.Level(req.GetHeight()))
+	if err != nil {
+		return nil, handleError(err, s.blocks)
+	}
+	return newBlockStats(*responses.NewBlockStats(&stats)), nil
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"encoding/json"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Messages are built from REST responses, so both APIs return the same values
+
+func newBlock(block responses.Block) *apiv1.Block {
+	result := &apiv1.Block{
+		Id:                 block.Id,
+		Height:             block.Height,
+		Time:               timestamppb.New(block.Time),
+		VersionBlock:       block.VersionBlock,
+		VersionApp:         block.VersionApp,
+		Hash:               block.Hash.String(),
+		ParentHash:         block.ParentHash.String(),
+		LastCommitHash:     block.LastCommitHash.String(),
+		DataHash:           block.DataHash.String(),
+		ValidatorsHash:     block.ValidatorsHash.String(),
+		NextValidatorsHash: block.NextValidatorsHash.String(),
+		ConsensusHash:      block.ConsensusHash.String(),
+		AppHash:            block.AppHash.String(),
+		LastResultsHash:    block.LastResultsHash.String(),
+		EvidenceHash:       block.EvidenceHash.String(),
+		ActionTypes:        block.ActionTypes,
+	}
+	if block.Proposer != nil {
+		result.Proposer = &apiv1.ShortValidator{
+			Id:          block.Proposer.Id,
+			ConsAddress: block.Proposer.ConsAddress,
+			Name:        block.Proposer.Name,
+		}
+	}
+	if block.Stats != nil {
+		result.Stats = newBlockStats(*block.Stats)
+	}
+	return result
+}
+
+func newBlockStats(stats responses.BlockStats) *apiv1.BlockStats {
+	return &apiv1.BlockStats{
+		TxCount:      stats.TxCount,
+		Fee:          stats.Fee,
+		SupplyChange: stats.SupplyChange,
+		BlockTime:    stats.BlockTime,
+		GasWanted:    stats.GasWanted,
+		GasUsed:      stats.GasUsed,
+		BytesInBlock: stats.BytesInBlock,
+	}
+}
+
+func newTx(tx responses.Tx) (*apiv1.Tx, error) {
+	result := &apiv1.Tx{
+		Id:           tx.Id,
+		Height:       uint64(tx.Height),
+		Position:     tx.Position,
+		GasWanted:    tx.GasWanted,
+		GasUsed:      tx.GasUsed,
+		ActionsCount: tx.ActionsCount,
+		Nonce:        tx.Nonce,
+		Hash:         tx.Hash,
+		Error:        tx.Error,
+		Codespace:    tx.Codespace,
+		Signature:    tx.Signature,
+		Signer:       tx.Signer,
+		Time:         timestamppb.New(tx.Time),
+		Status:       tx.Status.String(),
+		ActionTypes:  tx.ActionTypes,
+	}
+	if len(tx.Actions) > 0 {
+		actions, err := newActions(tx.Actions)
+		if err != nil {
+			return nil, err
+		}
+		result.Actions = actions
+	}
+	return result, nil
+}
+
+func newTxs(txs []responses.Tx) ([]*apiv1.Tx, error) {
+	result := make([]*apiv1.Tx, len(txs))
+	for i := range txs {
+		tx, err := newTx(txs[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = tx
+	}
+	return result, nil
+}
+
+func newAction(action responses.Action) (*apiv1.Action, error) {
+	result := &apiv1.Action{
+		Id:       action.Id,
+		Height:   uint64(action.Height),
+		Time:     timestamppb.New(action.Time),
+		Position: action.Position,
+		Type:     action.Type.String(),
+		TxHash:   action.TxHash,
+	}
+	if action.Data != nil {
+		data, err := newStruct(action.Data)
+		if err != nil {
+			return nil, err
+		}
+		result.Data = data
+	}
+	return result, nil
+}
+
+func newActions(actions []responses.Action) ([]*apiv1.Action, error) {
+	result := make([]*apiv1.Action, len(actions))
+	for i := range actions {
+		action, err := newAction(actions[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = action
+	}
+	return result, nil
+}
+
+// newStruct - converts action data through JSON to keep encoding of binary fields same as in REST
+func newStruct(data map[string]any) (*structpb.Struct, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	result := new(structpb.Struct)
+	if err := protojson.Unmarshal(raw, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func newAddress(address responses.Address) *apiv1.Address {
+	result := &apiv1.Address{
+		Id:            address.Id,
+		Height:        uint64(address.Height),
+		ActionsCount:  address.ActionsCount,
+		SignedTxCount: address.SignedTxCount,
+		Nonce:         address.Nonce,
+		Hash:          address.Hash,
+		BridgedRollup: address.BridgedRollup,
+	}
+	if address.Balance != nil {
+		result.Balance = &apiv1.Balance{
+			Currency: address.Balance.Currency,
+			Value:    address.Balance.Value,
+		}
+	}
+	return result
+}
+
+func newRollup(rollup responses.Rollup) *apiv1.Rollup {
+	return &apiv1.Rollup{
+		Id:            rollup.Id,
+		FirstHeight:   uint64(rollup.FirstHeight),
+		AstriaId:      rollup.AstriaId,
+		ActionsCount:  rollup.ActionsCount,
+		Size:          rollup.Size,
+		BridgeAddress: rollup.BridgeAddress,
+	}
+}
+
+func newValidator(validator responses.Validator) *apiv1.Validator {
+	return &apiv1.Validator{
+		Id:          validator.Id,
+		ConsAddress: validator.ConsAddress,
+		Name:        validator.Name,
+		PubkeyType:  validator.PubkeyType,
+		Pubkey:      validator.Pubkey,
+		Power:       validator.Power,
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NoRows interface {
+	IsNoRows(err error) bool
+}
+
+// handleError - converts storage error to gRPC status. Missing entities are reported as NotFound.
+func handleError(err error, noRows NoRows) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if noRows.IsNoRows(err) {
+		return status.Error(codes.NotFound, "not found")
+	}
+	sentry.CaptureException(err)
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var testBlock = storage.Block{
+	Id:           1,
+	Hash:         []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31},
+	Height:       100,
+	VersionBlock: 11,
+	VersionApp:   1,
+	Time:         time.Date(2023, 8, 1, 1, 1, 0, 0, time.UTC),
+}
+
+// GrpcTestSuite -
+type GrpcTestSuite struct {
+	suite.Suite
+
+	blocks     *mock.MockIBlock
+	blockStats *mock.MockIBlockStats
+	txs        *mock.MockITx
+	actions    *mock.MockIAction
+	state      *mock.MockIState
+	ctrl       *gomock.Controller
+
+	server *grpc.Server
+	conn   *grpc.ClientConn
+}
+
+// SetupSuite -
+func (s *GrpcTestSuite) SetupSuite() {
+	s.ctrl = gomock.NewController(s.T())
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.blockStats = mock.NewMockIBlockStats(s.ctrl)
+	s.txs = mock.NewMockITx(s.ctrl)
+	s.actions = mock.NewMockIAction(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+
+	listener := bufconn.Listen(1024 * 1024)
+	s.server = grpc.NewServer()
+	apiv1.RegisterBlockServiceServer(s.server, NewBlockServer(s.blocks, s.blockStats, s.txs, s.actions, s.state, "test"))
+	apiv1.RegisterTxServiceServer(s.server, NewTxServer(s.txs, s.actions, s.state, "test"))
+	healthpb.RegisterHealthServer(s.server, health.NewServer())
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+}
+
+// TearDownSuite -
+func (s *GrpcTestSuite) TearDownSuite() {
+	s.Require().NoError(s.conn.Close())
+	s.server.Stop()
+	s.ctrl.Finish()
+}
+
+func TestSuiteGrpc_Run(t *testing.T) {
+	suite.Run(t, new(GrpcTestSuite))
+}
+
+func (s *GrpcTestSuite) TestGetBlock() {
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(testBlock, nil).
+		Times(1)
+
+	block, err := apiv1.NewBlockServiceClient(s.conn).GetBlock(context.Background(), &apiv1.GetBlockRequest{Height: 100})
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, block.GetId())
+	s.Require().EqualValues(100, block.GetHeight())
+	s.Require().Equal("000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F", block.GetHash())
+}
+
+func (s *GrpcTestSuite) TestGetBlockNotFound() {
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(200), false).
+		Return(storage.Block{}, sql.ErrNoRows).
+		Times(1)
+
+	s.blocks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	_, err := apiv1.NewBlockServiceClient(s.conn).GetBlock(context.Background(), &apiv1.GetBlockRequest{Height: 200})
+	s.Require().Error(err)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *GrpcTestSuite) TestListBlocks() {
+	s.blocks.EXPECT().
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit:  2,
+			Offset: 0,
+			Sort:   sdk.SortOrderDesc,
+		}).
+		Return([]*storage.Block{&testBlock}, nil).
+		Times(1)
+
+	response, err := apiv1.NewBlockServiceClient(s.conn).ListBlocks(context.Background(), &apiv1.ListBlocksRequest{
+		Pagination: &apiv1.Pagination{
+			Limit: 2,
+			Sort:  apiv1.SortOrder_SORT_ORDER_DESC,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.GetBlocks(), 1)
+}
+
+func (s *GrpcTestSuite) TestListBlocksInvalidLimit() {
+	_, err := apiv1.NewBlockServiceClient(s.conn).ListBlocks(context.Background(), &apiv1.ListBlocksRequest{
+		Pagination: &apiv1.Pagination{
+			Limit: 101,
+		},
+	})
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *GrpcTestSuite) TestCountBlocks() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), "test").
+		Return(storage.State{LastHeight: 100}, nil).
+		Times(1)
+
+	response, err := apiv1.NewBlockServiceClient(s.conn).CountBlocks(context.Background(), &apiv1.CountBlocksRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(101, response.GetCount())
+}
+
+func (s *GrpcTestSuite) TestGetTxInvalidHash() {
+	_, err := apiv1.NewTxServiceClient(s.conn).GetTx(context.Background(), &apiv1.GetTxRequest{Hash: "invalid"})
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *GrpcTestSuite) TestListTxsInvalidActionType() {
+	_, err := apiv1.NewTxServiceClient(s.conn).ListTxs(context.Background(), &apiv1.ListTxsRequest{
+		ActionTypes: []string{"unknown"},
+	})
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *GrpcTestSuite) TestHealth() {
+	response, err := healthpb.NewHealthClient(s.conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_SERVING, response.GetStatus())
+}
+
+func TestActionFilters(t *testing.T) {
+	rollupId := []byte{1, 2, 3, 4}
+	sequence := storage.Action{
+		Type: types.ActionTypeSequence,
+		Data: map[string]any{
+			"rollup_id": "AQIDBA==",
+			"data":      "AQ==",
+		},
+	}
+	transfer := storage.Action{
+		Type: types.ActionTypeTransfer,
+		Data: map[string]any{
+			"to":     "115f94d8c98ffd73fe65182611140f0edc7c3c94",
+			"amount": "100",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		req      *apiv1.SubscribeActionsRequest
+		sequence bool
+		transfer bool
+	}{
+		{
+			name:     "empty",
+			req:      &apiv1.SubscribeActionsRequest{},
+			sequence: true,
+			transfer: true,
+		}, {
+			name:     "action type",
+			req:      &apiv1.SubscribeActionsRequest{ActionTypes: []string{"transfer"}},
+			transfer: true,
+		}, {
+			name:     "address",
+			req:      &apiv1.SubscribeActionsRequest{Addresses: []string{"115F94D8C98FFD73FE65182611140F0EDC7C3C94"}},
+			transfer: true,
+		}, {
+			name:     "rollup",
+			req:      &apiv1.SubscribeActionsRequest{Rollups: []string{"AQIDBA=="}},
+			sequence: true,
+		}, {
+			name: "rollup and action type",
+			req: &apiv1.SubscribeActionsRequest{
+				Rollups:     []string{"AQIDBA=="},
+				ActionTypes: []string{"transfer"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := newActionFilters(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := filters.match(sequence); got != tt.sequence {
+				t.Errorf("sequence: got %v, want %v", got, tt.sequence)
+			}
+			if got := filters.match(transfer); got != tt.transfer {
+				t.Errorf("transfer: got %v, want %v", got, tt.transfer)
+			}
+		})
+	}
+
+	id, ok := actionRollupId(map[string]any{"rollup_id": rollupId})
+	if !ok || string(id) != string(rollupId) {
+		t.Errorf("unexpected rollup id: %v", id)
+	}
+}
+
+func TestSubscriberOverflow(t *testing.T) {
+	sub := newSubscriber[int]()
+	for i := 0; i <= subscriberQueueSize; i++ {
+		sub.notify(i)
+	}
+
+	err := sub.serve(context.Background(), nil, func(int) error {
+		return nil
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerCloseWithSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := NewServer(postgres.Storage{}, bus.NewObserver(storage.ChannelBlock), "test")
+	listener := bufconn.Listen(1024 * 1024)
+	server.serve(ctx, listener)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := apiv1.NewSubscriptionServiceClient(conn).SubscribeBlocks(context.Background(), &apiv1.SubscribeBlocksRequest{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		server.subscriptions.mx.RLock()
+		defer server.subscriptions.mx.RUnlock()
+		return len(server.subscriptions.blocks) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- server.Close()
+	}()
+
+	select {
+	case err := <-closed:
+		require.NoError(t, err)
+	case <-time.After(gracefulStopTimeout / 2):
+		t.Fatal("server is not closed with open subscription")
+	}
+
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestNotifyActionsWithoutLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	actions := mock.NewMockIAction(ctrl)
+	server := NewSubscriptionServer(actions, nil)
+
+	sub := &actionSubscriber{
+		subscriber: newSubscriber[*apiv1.Action](),
+	}
+	server.subscribers[1] = sub
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	actions.EXPECT().
+		ByBlock(gomock.Any(), testBlock.Height, blockActionsPage, 0).
+		DoAndReturn(func(_ context.Context, _ pkgTypes.Level, _, _ int) ([]storage.ActionWithTx, error) {
+			close(started)
+			<-release
+			return []storage.ActionWithTx{
+				{
+					Action: storage.Action{Id: 1, Height: testBlock.Height, Type: types.ActionTypeTransfer},
+					Tx:     &storage.Tx{Hash: testBlock.Hash},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- server.notifyActions(context.Background(), testBlock)
+	}()
+	<-started
+
+	// subscribers can join and leave while actions are being loaded
+	locked := make(chan struct{})
+	go func() {
+		server.mx.Lock()
+		delete(server.subscribers, 1)
+		server.mx.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("subscribers are locked during actions loading")
+	}
+
+	close(release)
+	require.NoError(t, <-result)
+	require.Len(t, sub.queue, 1)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+type page struct {
+	Limit  int
+	Offset int
+	Sort   sdk.SortOrder
+}
+
+// newPage - validates pagination with the same defaults as REST API: 10 entities in ascending order
+func newPage(p *apiv1.Pagination) (page, error) {
+	result := page{
+		Limit: defaultLimit,
+		Sort:  sdk.SortOrderAsc,
+	}
+	if p == nil {
+		return result, nil
+	}
+	if p.GetLimit() > maxLimit {
+		return result, status.Errorf(codes.InvalidArgument, "limit should be in range [1, %d]", maxLimit)
+	}
+	if p.GetLimit() > 0 {
+		result.Limit = int(p.GetLimit())
+	}
+	result.Offset = int(p.GetOffset())
+	if p.GetSort() == apiv1.SortOrder_SORT_ORDER_DESC {
+		result.Sort = sdk.SortOrderDesc
+	}
+	return result, nil
+}
+
+func decodeHash(hash string) ([]byte, error) {
+	result, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %s", err)
+	}
+	return result, nil
+}
+
+func decodeRollupId(hash string) ([]byte, error) {
+	result, err := base64.URLEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rollup id: %s", err)
+	}
+	return result, nil
+}
+
+func newActionTypeMask(actionTypes []string) (types.ActionTypeMask, error) {
+	mask := types.NewActionTypeMask()
+	for i := range actionTypes {
+		actionType, err := types.ParseActionType(actionTypes[i])
+		if err != nil {
+			return mask, status.Error(codes.InvalidArgument, err.Error())
+		}
+		mask.SetType(actionType)
+	}
+	return mask, nil
+}
+
+func validateStatus(statuses []string) error {
+	for i := range statuses {
+		if _, err := types.ParseStatus(statuses[i]); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return nil
+}
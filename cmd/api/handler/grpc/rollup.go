@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RollupServer struct {
+	apiv1.UnimplementedRollupServiceServer
+
+	rollups     storage.IRollup
+	actions     storage.IAction
+	state       storage.IState
+	indexerName string
+}
+
+var _ apiv1.RollupServiceServer = (*RollupServer)(nil)
+
+func NewRollupServer(
+	rollups storage.IRollup,
+	actions storage.IAction,
+	state storage.IState,
+	indexerName string,
+) *RollupServer {
+	return &RollupServer{
+		rollups:     rollups,
+		actions:     actions,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+func (s *RollupServer) byHash(ctx context.Context, hash string) (storage.Rollup, error) {
+	id, err := decodeRollupId(hash)
+	if err != nil {
+		return storage.Rollup{}, err
+	}
+	rollup, err := s.rollups.ByHash(ctx, id)
+	if err != nil {
+		return rollup, handleError(err, s.rollups)
+	}
+	return rollup, nil
+}
+
+func (s *RollupServer) GetRollup(ctx context.Context, req *apiv1.GetRollupRequest) (*apiv1.Rollup, error) {
+	rollup, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	return newRollup(responses.NewRollup(&rollup)), nil
+}
+
+func (s *RollupServer) ListRollups(ctx context.Context, req *apiv1.ListRollupsRequest) (*apiv1.ListRollupsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	switch req.GetSortBy() {
+	case "", "id", "size":
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort field: %s", req.GetSortBy())
+	}
+
+	rollups, err := s.rollups.ListExt(ctx, storage.RollupListFilter{
+		Limit:     p.Limit,
+		Offset:    p.Offset,
+		SortOrder: p.Sort,
+		SortField: req.GetSortBy(),
+	})
+	if err != nil {
+		return nil, handleError(err, s.rollups)
+	}
+
+	response := &apiv1.ListRollupsResponse{
+		Rollups: make([]*apiv1.Rollup, len(rollups)),
+	}
+	for i := range rollups {
+		response.Rollups[i] = newRollup(responses.NewRollup(&rollups[i]))
+	}
+	return response, nil
+}
+
+func (s *RollupServer) CountRollups(ctx context.Context, _ *apiv1.CountRollupsRequest) (*apiv1.CountResponse, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		return nil, handleError(err, s.state)
+	}
+	return &apiv1.CountResponse{Count: state.TotalRollups}, nil
+}
+
+func (s *RollupServer) GetRollupActions(ctx context.Context, req *apiv1.GetRollupListRequest) (*apiv1.ListActionsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.actions.ByRollup(ctx, rollup.Id, storage.RollupActionsFilter{
+		Limit:  p.Limit,
+		Offset: p.Offset,
+		Sort:   p.Sort,
+	})
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+
+	response := make([]responses.Action, 0, len(actions))
+	for i := range actions {
+		if actions[i].Action != nil {
+			response = append(response, responses.NewRollupAction(actions[i]).Action)
+		}
+	}
+	result, err := newActions(response)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+	return &apiv1.ListActionsResponse{Actions: result}, nil
+}
+
+func (s *RollupServer) GetRollupAddresses(ctx context.Context, req *apiv1.GetRollupListRequest) (*apiv1.ListAddressesResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := s.byHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.rollups.Addresses(ctx, rollup.Id, p.Limit, p.Offset, p.Sort)
+	if err != nil {
+		return nil, handleError(err, s.rollups)
+	}
+
+	response := &apiv1.ListAddressesResponse{
+		Addresses: make([]*apiv1.Address, 0, len(addresses)),
+	}
+	for i := range addresses {
+		if addresses[i].Address != nil {
+			response.Addresses = append(response.Addresses, newAddress(responses.NewAddress(*addresses[i].Address, nil)))
+		}
+	}
+	return response, nil
+}
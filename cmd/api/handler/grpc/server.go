@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const gracefulStopTimeout = 10 * time.Second
+
+// Server - gRPC server which mirrors read endpoints of REST API and provides streaming subscriptions
+type Server struct {
+	server        *grpc.Server
+	health        *health.Server
+	subscriptions *SubscriptionServer
+}
+
+func NewServer(db postgres.Storage, observer *bus.Observer, indexerName string) *Server {
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	subscriptions := NewSubscriptionServer(db.Action, observer)
+
+	apiv1.RegisterBlockServiceServer(server, NewBlockServer(db.Blocks, db.BlockStats, db.Tx, db.Action, db.State, indexerName))
+	apiv1.RegisterTxServiceServer(server, NewTxServer(db.Tx, db.Action, db.State, indexerName))
+	apiv1.RegisterAddressServiceServer(server, NewAddressServer(db.Address, db.Tx, db.Action, db.Rollup, db.State, indexerName))
+	apiv1.RegisterRollupServiceServer(server, NewRollupServer(db.Rollup, db.Action, db.State, indexerName))
+	apiv1.RegisterValidatorServiceServer(server, NewValidatorServer(db.Validator, db.Blocks))
+	apiv1.RegisterSubscriptionServiceServer(server, subscriptions)
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+
+	for name := range server.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	return &Server{
+		server:        server,
+		health:        healthServer,
+		subscriptions: subscriptions,
+	}
+}
+
+// Start - listens the address and serves requests in the background
+func (s *Server) Start(ctx context.Context, bind string) error {
+	listener, err := net.Listen("tcp", bind)
+	if err != nil {
+		return errors.Wrap(err, "grpc listen")
+	}
+	s.serve(ctx, listener)
+	return nil
+}
+
+func (s *Server) serve(ctx context.Context, listener net.Listener) {
+	s.subscriptions.Start(ctx)
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			log.Err(err).Msg("grpc server")
+		}
+	}()
+}
+
+// Close - marks services as not serving, finishes subscriptions and waits for active calls.
+// Connections are closed forcibly if calls are not finished in time.
+func (s *Server) Close() error {
+	s.health.Shutdown()
+	s.subscriptions.Stop()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(gracefulStopTimeout):
+		log.Warn().Msg("grpc graceful stop timeout: closing connections")
+		s.server.Stop()
+		<-stopped
+	}
+	return s.subscriptions.Close()
+}
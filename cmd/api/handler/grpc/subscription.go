@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+	"github.com/dipdup-io/workerpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subscriberQueueSize = 256
+	blockActionsPage    = 100
+)
+
+// SubscriptionServer - streams new blocks and actions received from dispatcher.
+// Slow subscribers which overflow their queue are disconnected with ResourceExhausted status.
+type SubscriptionServer struct {
+	apiv1.UnimplementedSubscriptionServiceServer
+
+	actions  storage.IAction
+	observer *bus.Observer
+
+	id          *atomic.Uint64
+	mx          *sync.RWMutex
+	blocks      map[uint64]*subscriber[*apiv1.Block]
+	subscribers map[uint64]*actionSubscriber
+
+	done     chan struct{}
+	stopOnce *sync.Once
+
+	g workerpool.Group
+}
+
+var _ apiv1.SubscriptionServiceServer = (*SubscriptionServer)(nil)
+
+func NewSubscriptionServer(actions storage.IAction, observer *bus.Observer) *SubscriptionServer {
+	return &SubscriptionServer{
+		actions:     actions,
+		observer:    observer,
+		id:          new(atomic.Uint64),
+		mx:          new(sync.RWMutex),
+		blocks:      make(map[uint64]*subscriber[*apiv1.Block]),
+		subscribers: make(map[uint64]*actionSubscriber),
+		done:        make(chan struct{}),
+		stopOnce:    new(sync.Once),
+		g:           workerpool.NewGroup(),
+	}
+}
+
+func (s *SubscriptionServer) Start(ctx context.Context) {
+	s.g.GoCtx(ctx, s.listen)
+}
+
+// Stop - finishes all active subscription streams with Unavailable status. Streams don't end by themselves,
+// so it must be called before graceful stop of gRPC server.
+func (s *SubscriptionServer) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+func (s *SubscriptionServer) Close() error {
+	s.Stop()
+	s.g.Wait()
+	return nil
+}
+
+func (s *SubscriptionServer) listen(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case block, ok := <-s.observer.Blocks():
+			if !ok {
+				return
+			}
+			if block == nil {
+				continue
+			}
+			s.notifyBlock(*block)
+			if err := s.notifyActions(ctx, *block); err != nil {
+				log.Err(err).Uint64("height", uint64(block.Height)).Msg("sending actions to gRPC subscribers")
+			}
+		}
+	}
+}
+
+func (s *SubscriptionServer) notifyBlock(block storage.Block) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if len(s.blocks) == 0 {
+		return
+	}
+	msg := newBlock(responses.NewBlock(block))
+	for _, sub := range s.blocks {
+		sub.notify(msg)
+	}
+}
+
+// actionSubscribers - returns snapshot of action subscribers. Subscribers which leave after that aren't read anymore,
+// so notifying them doesn't block.
+func (s *SubscriptionServer) actionSubscribers() []*actionSubscriber {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	subscribers := make([]*actionSubscriber, 0, len(s.subscribers))
+	for _, sub := range s.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	return subscribers
+}
+
+func (s *SubscriptionServer) notifyActions(ctx context.Context, block storage.Block) error {
+	subscribers := s.actionSubscribers()
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	for offset := 0; ; offset += blockActionsPage {
+		actions, err := s.actions.ByBlock(ctx, block.Height, blockActionsPage, offset)
+		if err != nil {
+			return err
+		}
+
+		for i := range actions {
+			var msg *apiv1.Action
+			for _, sub := range subscribers {
+				if !sub.filters.match(actions[i].Action) {
+					continue
+				}
+				if msg == nil {
+					msg, err = newAction(responses.NewActionWithTx(actions[i]))
+					if err != nil {
+						return err
+					}
+				}
+				sub.notify(msg)
+			}
+		}
+
+		if len(actions) < blockActionsPage {
+			return nil
+		}
+	}
+}
+
+func (s *SubscriptionServer) SubscribeBlocks(_ *apiv1.SubscribeBlocksRequest, stream apiv1.SubscriptionService_SubscribeBlocksServer) error {
+	sub := newSubscriber[*apiv1.Block]()
+	id := s.id.Add(1)
+
+	s.mx.Lock()
+	s.blocks[id] = sub
+	s.mx.Unlock()
+
+	defer func() {
+		s.mx.Lock()
+		delete(s.blocks, id)
+		s.mx.Unlock()
+	}()
+
+	return sub.serve(stream.Context(), s.done, stream.Send)
+}
+
+func (s *SubscriptionServer) SubscribeActions(req *apiv1.SubscribeActionsRequest, stream apiv1.SubscriptionService_SubscribeActionsServer) error {
+	filters, err := newActionFilters(req)
+	if err != nil {
+		return err
+	}
+
+	sub := &actionSubscriber{
+		subscriber: newSubscriber[*apiv1.Action](),
+		filters:    filters,
+	}
+	id := s.id.Add(1)
+
+	s.mx.Lock()
+	s.subscribers[id] = sub
+	s.mx.Unlock()
+
+	defer func() {
+		s.mx.Lock()
+		delete(s.subscribers, id)
+		s.mx.Unlock()
+	}()
+
+	return sub.serve(stream.Context(), s.done, stream.Send)
+}
+
+type subscriber[T any] struct {
+	queue    chan T
+	overflow chan struct{}
+	once     *sync.Once
+}
+
+func newSubscriber[T any]() *subscriber[T] {
+	return &subscriber[T]{
+		queue:    make(chan T, subscriberQueueSize),
+		overflow: make(chan struct{}),
+		once:     new(sync.Once),
+	}
+}
+
+func (sub *subscriber[T]) notify(msg T) {
+	select {
+	case sub.queue <- msg:
+	default:
+		sub.once.Do(func() {
+			close(sub.overflow)
+		})
+	}
+}
+
+func (sub *subscriber[T]) serve(ctx context.Context, done <-chan struct{}, send func(T) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-done:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-sub.overflow:
+			return status.Error(codes.ResourceExhausted, "subscriber is too slow")
+		case msg := <-sub.queue:
+			if err := send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type actionSubscriber struct {
+	*subscriber[*apiv1.Action]
+
+	filters actionFilters
+}
+
+type actionFilters struct {
+	mask      types.ActionTypeMask
+	addresses map[string]struct{}
+	rollups   map[string]struct{}
+}
+
+func newActionFilters(req *apiv1.SubscribeActionsRequest) (actionFilters, error) {
+	mask, err := newActionTypeMask(req.GetActionTypes())
+	if err != nil {
+		return actionFilters{}, err
+	}
+
+	filters := actionFilters{
+		mask:      mask,
+		addresses: make(map[string]struct{}),
+		rollups:   make(map[string]struct{}),
+	}
+	for _, address := range req.GetAddresses() {
+		hash, err := decodeHash(address)
+		if err != nil {
+			return filters, err
+		}
+		if len(hash) == 0 {
+			return filters, status.Error(codes.InvalidArgument, "empty address")
+		}
+		filters.addresses[strings.ToLower(address)] = struct{}{}
+	}
+	for _, rollup := range req.GetRollups() {
+		id, err := decodeRollupId(rollup)
+		if err != nil {
+			return filters, err
+		}
+		filters.rollups[string(id)] = struct{}{}
+	}
+	return filters, nil
+}
+
+// match - returns true if action satisfies all passed filters.
+// Addresses are searched among string values of action data.
+func (f actionFilters) match(action storage.Action) bool {
+	if !f.mask.Empty() && f.mask.Bits&types.NewActionTypeMask(action.Type.String()).Bits == 0 {
+		return false
+	}
+
+	if len(f.rollups) > 0 {
+		rollupId, ok := actionRollupId(action.Data)
+		if !ok {
+			return false
+		}
+		if _, ok := f.rollups[string(rollupId)]; !ok {
+			return false
+		}
+	}
+
+	if len(f.addresses) > 0 {
+		for _, value := range action.Data {
+			str, ok := value.(string)
+			if !ok {
+				continue
+			}
+			if _, ok := f.addresses[strings.ToLower(str)]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// actionRollupId - rollup id is stored as bytes which become base64 string after reading from database
+func actionRollupId(data map[string]any) ([]byte, bool) {
+	switch value := data["rollup_id"].(type) {
+	case []byte:
+		return value, true
+	case string:
+		id, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, false
+		}
+		return id, true
+	default:
+		return nil, false
+	}
+}
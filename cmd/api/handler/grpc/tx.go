@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+)
+
+type TxServer struct {
+	apiv1.UnimplementedTxServiceServer
+
+	txs         storage.ITx
+	actions     storage.IAction
+	state       storage.IState
+	indexerName string
+}
+
+var _ apiv1.TxServiceServer = (*TxServer)(nil)
+
+func NewTxServer(
+	txs storage.ITx,
+	actions storage.IAction,
+	state storage.IState,
+	indexerName string,
+) *TxServer {
+	return &TxServer{
+		txs:         txs,
+		actions:     actions,
+		state:       state,
+		indexerName: indexerName,
+	}
+}
+
+func (s *TxServer) GetTx(ctx context.Context, req *apiv1.GetTxRequest) (*apiv1.Tx, error) {
+	hash, err := decodeHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.txs.ByHash(ctx, hash)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+
+	result, err := newTx(responses.NewTx(tx))
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+	return result, nil
+}
+
+func (s *TxServer) ListTxs(ctx context.Context, req *apiv1.ListTxsRequest) (*apiv1.ListTxsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStatus(req.GetStatus()); err != nil {
+		return nil, err
+	}
+	mask, err := newActionTypeMask(req.GetActionTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	fltrs := storage.TxFilter{
+		Limit:       p.Limit,
+		Offset:      p.Offset,
+		Sort:        p.Sort,
+		Status:      req.GetStatus(),
+		Height:      req.GetHeight(),
+		ActionTypes: mask,
+		WithActions: req.GetWithActions(),
+	}
+	if req.GetFrom() != nil {
+		fltrs.TimeFrom = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		fltrs.TimeTo = req.GetTo().AsTime()
+	}
+
+	txs, err := s.txs.Filter(ctx, fltrs)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+
+	response := make([]responses.Tx, len(txs))
+	for i := range txs {
+		response[i] = responses.NewTx(txs[i])
+	}
+	result, err := newTxs(response)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+	return &apiv1.ListTxsResponse{Txs: result}, nil
+}
+
+func (s *TxServer) CountTxs(ctx context.Context, _ *apiv1.CountTxsRequest) (*apiv1.CountResponse, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		return nil, handleError(err, s.state)
+	}
+	return &apiv1.CountResponse{Count: state.TotalTx}, nil
+}
+
+func (s *TxServer) GetTxActions(ctx context.Context, req *apiv1.GetTxActionsRequest) (*apiv1.ListActionsResponse, error) {
+	hash, err := decodeHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.txs.ByHash(ctx, hash)
+	if err != nil {
+		return nil, handleError(err, s.txs)
+	}
+
+	actions, err := s.actions.ByTxId(ctx, tx.Id, p.Limit, p.Offset)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+
+	response := make([]responses.Action, len(actions))
+	for i := range actions {
+		response[i] = responses.NewAction(actions[i])
+		response[i].TxHash = req.GetHash()
+	}
+	result, err := newActions(response)
+	if err != nil {
+		return nil, handleError(err, s.actions)
+	}
+	return &apiv1.ListActionsResponse{Actions: result}, nil
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	apiv1 "github.com/celenium-io/astria-indexer/pkg/api/v1"
+)
+
+type ValidatorServer struct {
+	apiv1.UnimplementedValidatorServiceServer
+
+	validators storage.IValidator
+	blocks     storage.IBlock
+}
+
+var _ apiv1.ValidatorServiceServer = (*ValidatorServer)(nil)
+
+func NewValidatorServer(validators storage.IValidator, blocks storage.IBlock) *ValidatorServer {
+	return &ValidatorServer{
+		validators: validators,
+		blocks:     blocks,
+	}
+}
+
+func (s *ValidatorServer) GetValidator(ctx context.Context, req *apiv1.GetValidatorRequest) (*apiv1.Validator, error) {
+	validator, err := s.validators.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, handleError(err, s.validators)
+	}
+	return newValidator(*responses.NewValidator(validator)), nil
+}
+
+func (s *ValidatorServer) ListValidators(ctx context.Context, req *apiv1.ListValidatorsRequest) (*apiv1.ListValidatorsResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := s.validators.List(ctx, uint64(p.Limit), uint64(p.Offset), p.Sort)
+	if err != nil {
+		return nil, handleError(err, s.validators)
+	}
+
+	response := &apiv1.ListValidatorsResponse{
+		Validators: make([]*apiv1.Validator, len(validators)),
+	}
+	for i := range validators {
+		response.Validators[i] = newValidator(*responses.NewValidator(validators[i]))
+	}
+	return response, nil
+}
+
+func (s *ValidatorServer) GetValidatorBlocks(ctx context.Context, req *apiv1.GetValidatorBlocksRequest) (*apiv1.ListBlocksResponse, error) {
+	p, err := newPage(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.blocks.ByProposer(ctx, req.GetId(), p.Limit, p.Offset, p.Sort)
+	if err != nil {
+		return nil, handleError(err, s.blocks)
+	}
+
+	response := &apiv1.ListBlocksResponse{
+		Blocks: make([]*apiv1.Block, len(blocks)),
+	}
+	for i := range blocks {
+		response.Blocks[i] = newBlock(responses.NewBlock(blocks[i]))
+	}
+	return response, nil
+}
@@ -4,6 +4,8 @@
 package responses
 
 import (
+	"time"
+
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 )
@@ -52,3 +54,29 @@ type Balance struct {
 	Currency string `example:"nria"        json:"currency" swaggertype:"string"`
 	Value    string `example:"10000000000" json:"value"    swaggertype:"string"`
 }
+
+func NewBalance(balance storage.Balance) Balance {
+	return Balance{
+		Currency: balance.Currency,
+		Value:    balance.Total.String(),
+	}
+}
+
+// BalanceSeriesItem -
+//
+//	@Description	Balance of address at the end of time bucket
+type BalanceSeriesItem struct {
+	Time   time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"          swaggertype:"string"`
+	Value  string    `example:"10000000000"               format:"string"    json:"value"         swaggertype:"string"`
+	Change string    `example:"-1000"                     format:"string"    json:"change"        swaggertype:"string"`
+	Count  int64     `example:"2"                         format:"integer"   json:"updates_count" swaggertype:"integer"`
+}
+
+func NewBalanceSeriesItem(item storage.BalanceSeriesItem) BalanceSeriesItem {
+	return BalanceSeriesItem{
+		Time:   item.Time,
+		Value:  item.Balance.String(),
+		Change: item.Change.String(),
+		Count:  item.Count,
+	}
+}
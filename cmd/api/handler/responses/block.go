@@ -4,6 +4,7 @@
 package responses
 
 import (
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -82,3 +83,18 @@ func NewBlockStats(stats *storage.BlockStats) *BlockStats {
 		BytesInBlock: stats.BytesInBlock,
 	}
 }
+
+// RawBlock -
+//
+//	@Description	Results of CometBFT `block` and `block_results` requests as they were received from the node
+type RawBlock struct {
+	Block        json.RawMessage `json:"block"         swaggertype:"object"`
+	BlockResults json.RawMessage `json:"block_results" swaggertype:"object"`
+}
+
+func NewRawBlock(block, results []byte) RawBlock {
+	return RawBlock{
+		Block:        block,
+		BlockResults: results,
+	}
+}
@@ -4,6 +4,8 @@
 package responses
 
 import (
+	"time"
+
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 )
@@ -15,6 +17,8 @@ type Rollup struct {
 	ActionsCount  int64       `example:"101"                                          json:"actions_count"            swaggertype:"integer"`
 	Size          int64       `example:"100"                                          json:"size"                     swaggertype:"integer"`
 	BridgeAddress string      `example:"115F94D8C98FFD73FE65182611140F0EDC7C3C94"     json:"bridge_address,omitempty" swaggertype:"string"`
+
+	Metadata *RollupMetadata `json:"metadata,omitempty"`
 }
 
 func NewRollup(rollup *storage.Rollup) Rollup {
@@ -29,6 +33,36 @@ func NewRollup(rollup *storage.Rollup) Rollup {
 	if rollup.BridgeAddress != nil {
 		r.BridgeAddress = rollup.BridgeAddress.String()
 	}
+	if rollup.Metadata != nil {
+		metadata := NewRollupMetadata(*rollup.Metadata)
+		r.Metadata = &metadata
+	}
 
 	return r
 }
+
+type RollupMetadata struct {
+	Name        string    `example:"Flame"                        json:"name"                  swaggertype:"string"`
+	Slug        string    `example:"flame"                        json:"slug"                  swaggertype:"string"`
+	Website     string    `example:"https://example.com"          json:"website,omitempty"     swaggertype:"string"`
+	Logo        string    `example:"https://example.com/logo.png" json:"logo,omitempty"        swaggertype:"string"`
+	Description string    `example:"EVM rollup"                   json:"description,omitempty" swaggertype:"string"`
+	VmType      string    `example:"evm"                          json:"vm_type,omitempty"     swaggertype:"string"`
+	Contact     string    `example:"team@example.com"             json:"contact,omitempty"     swaggertype:"string"`
+	Version     uint64    `example:"1"                            json:"version"               swaggertype:"integer"`
+	UpdatedAt   time.Time `example:"2023-07-04T03:10:57+00:00"    json:"updated_at"            swaggertype:"string"`
+}
+
+func NewRollupMetadata(metadata storage.RollupMetadata) RollupMetadata {
+	return RollupMetadata{
+		Name:        metadata.Name,
+		Slug:        metadata.Slug,
+		Website:     metadata.Website,
+		Logo:        metadata.Logo,
+		Description: metadata.Description,
+		VmType:      metadata.VmType,
+		Contact:     metadata.Contact,
+		Version:     metadata.Version,
+		UpdatedAt:   metadata.UpdatedAt,
+	}
+}
@@ -7,13 +7,14 @@ import (
 	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/shopspring/decimal"
 )
 
 type SeriesItem struct {
-	Time  time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"          swaggertype:"string"`
-	Value string    `example:"0.17632"                   format:"string"    json:"value"         swaggertype:"string"`
-	Max   string    `example:"0.17632"                   format:"string"    json:"max,omitempty" swaggertype:"string"`
-	Min   string    `example:"0.17632"                   format:"string"    json:"min,omitempty" swaggertype:"string"`
+	Time  time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"  swaggertype:"string"`
+	Value string    `example:"0.17632"                   format:"string"    json:"value" swaggertype:"string"`
+	Max   string    `example:"0.17632"                   format:"string"    json:"max"   swaggertype:"string"`
+	Min   string    `example:"0.17632"                   format:"string"    json:"min"   swaggertype:"string"`
 }
 
 func NewSeriesItem(item storage.SeriesItem) SeriesItem {
@@ -28,12 +29,16 @@ func NewSeriesItem(item storage.SeriesItem) SeriesItem {
 type RollupSeriesItem struct {
 	Time  time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"  swaggertype:"string"`
 	Value string    `example:"0.17632"                   format:"string"    json:"value" swaggertype:"string"`
+	Max   string    `example:"0.17632"                   format:"string"    json:"max"   swaggertype:"string"`
+	Min   string    `example:"0.17632"                   format:"string"    json:"min"   swaggertype:"string"`
 }
 
 func NewRollupSeriesItem(item storage.SeriesItem) RollupSeriesItem {
 	return RollupSeriesItem{
 		Time:  item.Time,
 		Value: item.Value,
+		Max:   item.Max,
+		Min:   item.Min,
 	}
 }
 
@@ -62,3 +67,167 @@ func NewNetworkSummary(summary storage.NetworkSummary) NetworkSummary {
 		Supply:       summary.Supply.String(),
 	}
 }
+
+// HolderStats -
+//
+//	@Description	Distribution of the asset between holders
+type HolderStats struct {
+	Currency     string         `example:"nria"          json:"currency"      swaggertype:"string"`
+	HoldersCount int64          `example:"1000"          json:"holders_count" swaggertype:"integer"`
+	Supply       string         `example:"1000000000000" json:"supply"        swaggertype:"string"`
+	Gini         float64        `example:"0.8523"        json:"gini"          swaggertype:"number"`
+	Top          []Holder       `json:"top"`
+	Distribution []HolderBucket `json:"distribution"`
+}
+
+func NewHolderStats(stats storage.HolderStats) HolderStats {
+	result := HolderStats{
+		Currency:     stats.Currency,
+		HoldersCount: stats.HoldersCount,
+		Supply:       stats.Supply.String(),
+		Gini:         stats.Gini,
+		Top:          make([]Holder, 0, len(stats.Top)),
+		Distribution: make([]HolderBucket, len(stats.Buckets)),
+	}
+
+	for i := range stats.Top {
+		holder := Holder{
+			Address: stats.Top[i].String(),
+			Balance: "0",
+		}
+		if stats.Top[i].Balance != nil {
+			holder.Balance = stats.Top[i].Balance.Total.String()
+			holder.Share = shareOf(stats.Top[i].Balance.Total, stats.Supply)
+		}
+		result.Top = append(result.Top, holder)
+	}
+
+	for i := range stats.Buckets {
+		from, to := storage.HolderBucketRange(stats.Buckets[i].Bucket)
+		result.Distribution[i] = HolderBucket{
+			From:  from.String(),
+			To:    to.String(),
+			Count: stats.Buckets[i].Count,
+			Total: stats.Buckets[i].Total.String(),
+			Share: shareOf(stats.Buckets[i].Total, stats.Supply),
+		}
+	}
+	return result
+}
+
+// Holder -
+//
+//	@Description	Holder of the asset
+type Holder struct {
+	Address string  `example:"astria1kwz7dr368gkj2rrugqjfwftk66vtne6gyvjhkt" json:"address" swaggertype:"string"`
+	Balance string  `example:"10000000000"                                   json:"balance" swaggertype:"string"`
+	Share   float64 `example:"0.0123"                                        json:"share"   swaggertype:"number"`
+}
+
+// HolderBucket -
+//
+//	@Description	Holders with balance in range [from, to)
+type HolderBucket struct {
+	From  string  `example:"1000"       json:"from"  swaggertype:"string"`
+	To    string  `example:"10000"      json:"to"    swaggertype:"string"`
+	Count int64   `example:"100"        json:"count" swaggertype:"integer"`
+	Total string  `example:"1000000000" json:"total" swaggertype:"string"`
+	Share float64 `example:"0.0123"     json:"share" swaggertype:"number"`
+}
+
+func shareOf(value, supply decimal.Decimal) float64 {
+	if !supply.IsPositive() {
+		return 0
+	}
+	return value.Div(supply).InexactFloat64()
+}
+
+// ActiveAddressesItem -
+//
+//	@Description	Count of addresses which signed transactions or received funds during the day
+type ActiveAddressesItem struct {
+	Time      time.Time `example:"2023-07-04T00:00:00+00:00" format:"date-time" json:"time"      swaggertype:"string"`
+	Active    int64     `example:"100"                       format:"integer"   json:"active"    swaggertype:"integer"`
+	Signers   int64     `example:"80"                        format:"integer"   json:"signers"   swaggertype:"integer"`
+	Receivers int64     `example:"40"                        format:"integer"   json:"receivers" swaggertype:"integer"`
+}
+
+func NewActiveAddressesItem(item storage.ActiveAddressesItem) ActiveAddressesItem {
+	return ActiveAddressesItem{
+		Time:      item.Time,
+		Active:    item.Active,
+		Signers:   item.Signers,
+		Receivers: item.Receivers,
+	}
+}
+
+// ActionSeriesItem -
+//
+//	@Description	Count of actions of the type and transferred volume for the period. Volume is zero for actions without value.
+//	@Description	Min and max are extremes of merged buckets.
+type ActionSeriesItem struct {
+	Time      time.Time `example:"2023-07-04T03:00:00+00:00" format:"date-time" json:"time"       swaggertype:"string"`
+	Count     int64     `example:"100"                       format:"integer"   json:"count"      swaggertype:"integer"`
+	Volume    string    `example:"1000000"                   format:"string"    json:"volume"     swaggertype:"string"`
+	MaxCount  int64     `example:"20"                        format:"integer"   json:"max_count"  swaggertype:"integer"`
+	MinCount  int64     `example:"1"                         format:"integer"   json:"min_count"  swaggertype:"integer"`
+	MaxVolume string    `example:"500000"                    format:"string"    json:"max_volume" swaggertype:"string"`
+	MinVolume string    `example:"0"                         format:"string"    json:"min_volume" swaggertype:"string"`
+}
+
+func NewActionSeriesItem(item storage.ActionSeriesItem) ActionSeriesItem {
+	return ActionSeriesItem{
+		Time:      item.Time,
+		Count:     item.Count,
+		Volume:    item.Volume.String(),
+		MaxCount:  item.MaxCount,
+		MinCount:  item.MinCount,
+		MaxVolume: item.MaxVolume.String(),
+		MinVolume: item.MinVolume.String(),
+	}
+}
+
+// TopRollup -
+//
+//	@Description	Rollup activity in the window compared with the previous window of the same length. Changes are relative and omitted if there was no activity in the previous window.
+type TopRollup struct {
+	Rollup             *Rollup  `json:"rollup,omitempty"`
+	Size               int64    `example:"1000" json:"size"                           swaggertype:"integer"`
+	SizeShare          float64  `example:"0.25" json:"size_share"                     swaggertype:"number"`
+	SizeChange         *float64 `example:"0.1"  json:"size_change,omitempty"          swaggertype:"number"`
+	ActionsCount       int64    `example:"10"   json:"actions_count"                  swaggertype:"integer"`
+	ActionsCountChange *float64 `example:"-0.5" json:"actions_count_change,omitempty" swaggertype:"number"`
+	Signers            int64    `example:"2"    json:"signers"                        swaggertype:"integer"`
+	SignersChange      *float64 `example:"1"    json:"signers_change,omitempty"       swaggertype:"number"`
+	PrevSize           int64    `example:"900"  json:"prev_size"                      swaggertype:"integer"`
+	PrevActionsCount   int64    `example:"20"   json:"prev_actions_count"             swaggertype:"integer"`
+	PrevSigners        int64    `example:"1"    json:"prev_signers"                   swaggertype:"integer"`
+}
+
+func NewTopRollup(item storage.TopRollupItem) TopRollup {
+	result := TopRollup{
+		Size:               item.Size,
+		SizeShare:          item.SizeShare,
+		SizeChange:         relativeChange(item.Size, item.PrevSize),
+		ActionsCount:       item.ActionsCount,
+		ActionsCountChange: relativeChange(item.ActionsCount, item.PrevActionsCount),
+		Signers:            item.Signers,
+		SignersChange:      relativeChange(item.Signers, item.PrevSigners),
+		PrevSize:           item.PrevSize,
+		PrevActionsCount:   item.PrevActionsCount,
+		PrevSigners:        item.PrevSigners,
+	}
+	if item.Rollup != nil {
+		rollup := NewRollup(item.Rollup)
+		result.Rollup = &rollup
+	}
+	return result
+}
+
+func relativeChange(value, prev int64) *float64 {
+	if prev == 0 {
+		return nil
+	}
+	change := float64(value-prev) / float64(prev)
+	return &change
+}
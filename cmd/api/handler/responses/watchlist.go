@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+type Watchlist struct {
+	Id          uint64    `example:"321"                                          format:"int64"     json:"id"                     swaggertype:"integer"`
+	Name        string    `example:"treasury"                                     format:"string"    json:"name"                   swaggertype:"string"`
+	Address     string    `example:"115F94D8C98FFD73FE65182611140F0EDC7C3C94"     format:"string"    json:"address,omitempty"      swaggertype:"string"`
+	RollupId    []byte    `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" format:"base64"    json:"rollup,omitempty"       swaggertype:"string"`
+	ActionTypes []string  `example:"transfer,bridge_lock"                         format:"string"    json:"action_types,omitempty" swaggertype:"array,string"`
+	Threshold   string    `example:"1000"                                         format:"string"    json:"threshold"              swaggertype:"string"`
+	Currency    string    `example:"nria"                                         format:"string"    json:"currency,omitempty"     swaggertype:"string"`
+	Direction   string    `example:"out"                                          format:"string"    json:"direction"              swaggertype:"string"`
+	WebhookUrl  string    `example:"https://example.com/hook"                     format:"string"    json:"webhook_url,omitempty"  swaggertype:"string"`
+	Enabled     bool      `example:"true"                                         format:"boolean"   json:"enabled"                swaggertype:"boolean"`
+	CreatedAt   time.Time `example:"2023-07-04T03:10:57+00:00"                    format:"date-time" json:"created_at"             swaggertype:"string"`
+	UpdatedAt   time.Time `example:"2023-07-04T03:10:57+00:00"                    format:"date-time" json:"updated_at"             swaggertype:"string"`
+}
+
+func NewWatchlist(w storage.Watchlist) Watchlist {
+	result := Watchlist{
+		Id:          w.Id,
+		Name:        w.Name,
+		RollupId:    w.RollupId,
+		ActionTypes: types.NewActionTypeMaskBits(w.ActionTypes).Strings(),
+		Threshold:   w.Threshold.String(),
+		Currency:    w.Currency,
+		Direction:   w.Direction.String(),
+		WebhookUrl:  w.WebhookUrl,
+		Enabled:     w.Enabled,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+	if len(w.Address) > 0 {
+		result.Address = w.AddressString()
+	}
+	return result
+}
+
+type WatchlistAlert struct {
+	Id          uint64         `example:"321"                                                              format:"int64"     json:"id"                 swaggertype:"integer"`
+	WatchlistId uint64         `example:"1"                                                                format:"int64"     json:"watchlist_id"       swaggertype:"integer"`
+	Height      pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"             swaggertype:"integer"`
+	Time        time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"               swaggertype:"string"`
+	TxHash      pkgTypes.Hex   `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"tx_hash"            swaggertype:"string"`
+	Position    int64          `example:"1"                                                                format:"int64"     json:"position"           swaggertype:"integer"`
+	ActionType  string         `example:"transfer"                                                         format:"string"    json:"action_type"        swaggertype:"string"`
+	Amount      string         `example:"1000"                                                             format:"string"    json:"amount"             swaggertype:"string"`
+	Currency    string         `example:"nria"                                                             format:"string"    json:"currency,omitempty" swaggertype:"string"`
+	Direction   string         `example:"out"                                                              format:"string"    json:"direction"          swaggertype:"string"`
+	Delivered   bool           `example:"true"                                                             format:"boolean"   json:"delivered"          swaggertype:"boolean"`
+}
+
+func NewWatchlistAlert(alert storage.WatchlistAlert) WatchlistAlert {
+	return WatchlistAlert{
+		Id:          alert.Id,
+		WatchlistId: alert.WatchlistId,
+		Height:      alert.Height,
+		Time:        alert.Time,
+		TxHash:      alert.TxHash,
+		Position:    alert.Position,
+		ActionType:  alert.ActionType.String(),
+		Amount:      alert.Amount.String(),
+		Currency:    alert.Currency,
+		Direction:   alert.Direction.String(),
+		Delivered:   alert.Delivered,
+	}
+}
@@ -6,14 +6,18 @@ package handler
 import (
 	"encoding/base64"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/registry"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/labstack/echo/v4"
 )
 
 type RollupHandler struct {
 	rollups     storage.IRollup
+	metadata    storage.IRollupMetadata
 	actions     storage.IAction
 	state       storage.IState
 	indexerName string
@@ -21,12 +25,14 @@ type RollupHandler struct {
 
 func NewRollupHandler(
 	rollups storage.IRollup,
+	metadata storage.IRollupMetadata,
 	actions storage.IAction,
 	state storage.IState,
 	indexerName string,
 ) *RollupHandler {
 	return &RollupHandler{
 		rollups:     rollups,
+		metadata:    metadata,
 		actions:     actions,
 		state:       state,
 		indexerName: indexerName,
@@ -70,10 +76,12 @@ func (handler *RollupHandler) Get(c echo.Context) error {
 }
 
 type listRollupsRequest struct {
-	Limit     int    `query:"limit"   validate:"omitempty,min=1,max=100"`
-	Offset    int    `query:"offset"  validate:"omitempty,min=0"`
-	Sort      string `query:"sort"    validate:"omitempty,oneof=asc desc"`
-	SortField string `query:"sort_by" validate:"omitempty,oneof=size id"`
+	Limit     int         `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset    int         `query:"offset"  validate:"omitempty,min=0"`
+	Sort      string      `query:"sort"    validate:"omitempty,oneof=asc desc"`
+	SortField string      `query:"sort_by" validate:"omitempty,oneof=size id actions_count name"`
+	VmType    StringArray `query:"vm_type" validate:"omitempty,dive,max=64"`
+	Name      string      `query:"name"    validate:"omitempty,max=256"`
 }
 
 func (p *listRollupsRequest) SetDefault() {
@@ -94,7 +102,9 @@ func (p *listRollupsRequest) SetDefault() {
 //	@Param			limit		query	integer	false	"Count of requested entities"			mininum(1)	maximum(100)
 //	@Param			offset		query	integer	false	"Offset"								mininum(1)
 //	@Param			sort		query	string	false	"Sort order"							Enums(asc, desc)
-//	@Param			sort_by		query	string	false	"Field using for sorting. Default: id"	Enums(id, size)
+//	@Param			sort_by		query	string	false	"Field using for sorting. Default: id"	Enums(id, size, actions_count, name)
+//	@Param			vm_type		query	string	false	"Comma-separated list of virtual machine types"
+//	@Param			name		query	string	false	"Part of rollup name"
 //	@Produce		json
 //	@Success		200	{array}		responses.Rollup
 //	@Failure		400	{object}	Error
@@ -112,6 +122,11 @@ func (handler *RollupHandler) List(c echo.Context) error {
 		Offset:    req.Offset,
 		SortOrder: pgSort(req.Sort),
 		SortField: req.SortField,
+		VmType:    req.VmType,
+		Name:      req.Name,
+	}
+	for i := range fltrs.VmType {
+		fltrs.VmType[i] = strings.ToLower(fltrs.VmType[i])
 	}
 	rollups, err := handler.rollups.ListExt(c.Request().Context(), fltrs)
 	if err != nil {
@@ -142,6 +157,23 @@ func (p *getRollupList) SetDefault() {
 	}
 }
 
+type getRollupActions struct {
+	Hash   string `param:"hash"   validate:"required,base64url"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Cursor string `query:"cursor" validate:"omitempty"`
+}
+
+func (p *getRollupActions) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
 // Actions godoc
 //
 //	@Summary		Get rollup actions
@@ -152,13 +184,14 @@ func (p *getRollupList) SetDefault() {
 //	@Param			limit			query	integer					false	"Count of requested entities"			minimum(1)		maximum(100)
 //	@Param			offset			query	integer					false	"Offset"								minimum(1)
 //	@Param			sort			query	string					false	"Sort order"							Enums(asc, desc)
+//	@Param			cursor			query	string					false	"Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored"
 //	@Produce		json
-//	@Success		200	{array}		responses.RollupAction
+//	@Success		200	{array}		responses.RollupAction	"Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)"
 //	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/rollup/{hash}/actions [get]
 func (handler *RollupHandler) Actions(c echo.Context) error {
-	req, err := bindAndValidate[getRollupList](c)
+	req, err := bindAndValidate[getRollupActions](c)
 	if err != nil {
 		return badRequestError(c, err)
 	}
@@ -174,7 +207,22 @@ func (handler *RollupHandler) Actions(c echo.Context) error {
 		return handleError(c, err, handler.rollups)
 	}
 
-	actions, err := handler.actions.ByRollup(c.Request().Context(), rollup.Id, req.Limit, req.Offset, pgSort(req.Sort))
+	fltrs := storage.RollupActionsFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+	cursorMode := isCursorRequest(c)
+	if cursorMode {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		fltrs.Cursor = cursor
+		fltrs.Offset = 0
+	}
+
+	actions, err := handler.actions.ByRollup(c.Request().Context(), rollup.Id, fltrs)
 	if err != nil {
 		return handleError(c, err, handler.rollups)
 	}
@@ -184,9 +232,86 @@ func (handler *RollupHandler) Actions(c echo.Context) error {
 		response[i] = responses.NewRollupAction(actions[i])
 	}
 
+	if cursorMode {
+		return returnPage(c, response, fltrs.Limit, func(action responses.RollupAction) (time.Time, uint64) {
+			return action.Time, action.Id
+		})
+	}
 	return returnArray(c, response)
 }
 
+type rollupExportRequest struct {
+	Hash   string `param:"hash"   validate:"required,base64url"`
+	Format string `query:"format" validate:"omitempty,oneof=csv ndjson"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (p *rollupExportRequest) SetDefault() {
+	if p.Format == "" {
+		p.Format = exportFormatCsv
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
+// ExportActions godoc
+//
+//	@Summary		Export rollup actions
+//	@Description	Stream all rollup actions matched the filters in CSV or NDJSON format
+//	@Tags			rollup
+//	@ID				rollup-actions-export
+//	@Param			hash	path	string	true	"Base64Url encoded rollup id"
+//	@Param			format	query	string	false	"Export format"					Enums(csv, ndjson)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			from	query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Success		200
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/rollup/{hash}/actions/export [get]
+func (handler *RollupHandler) ExportActions(c echo.Context) error {
+	req, err := bindAndValidate[rollupExportRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	rollup, err := handler.rollups.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	fltrs := storage.RollupActionsFilter{
+		Sort: pgSort(req.Sort),
+	}
+	if req.From > 0 {
+		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		fltrs.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+
+	e, err := newExporter(c, req.Format, "rollup_actions", actionExportHeader, rollupActionExportRow)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	err = handler.actions.StreamByRollup(c.Request().Context(), rollup.Id, fltrs, func(action storage.RollupAction) error {
+		return e.Write(responses.NewRollupAction(action))
+	})
+	return e.Close(err)
+}
+
 // Count godoc
 //
 //	@Summary		Get count of rollups in network
@@ -250,3 +375,90 @@ func (handler *RollupHandler) Addresses(c echo.Context) error {
 
 	return returnArray(c, response)
 }
+
+type rollupMetadataRequest struct {
+	Hash        string `json:"-"           param:"hash" validate:"required,base64url"`
+	Name        string `json:"name"        validate:"required,max=256"`
+	Slug        string `json:"slug"        validate:"omitempty,max=256"`
+	Website     string `json:"website"     validate:"omitempty,url"`
+	Logo        string `json:"logo"        validate:"omitempty,url"`
+	Description string `json:"description" validate:"omitempty,max=4096"`
+	VmType      string `json:"vm_type"     validate:"omitempty,max=64"`
+	Contact     string `json:"contact"     validate:"omitempty,max=256"`
+}
+
+// SetMetadata godoc
+//
+//	@Summary		Set rollup metadata
+//	@Description	Create or replace human-readable information about rollup. Slug is derived from the name if it's empty. The metadata is replaced by the registry file with greater version.
+//	@Tags			rollup
+//	@ID				set-rollup-metadata
+//	@Accept			json
+//	@Param			hash	path	string					true	"Base64Url encoded rollup id"
+//	@Param			request	body	rollupMetadataRequest	true	"Metadata"
+//	@Produce		json
+//	@Success		200	{object}	responses.RollupMetadata
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/rollup/{hash}/metadata [put]
+func (handler *RollupHandler) SetMetadata(c echo.Context) error {
+	req, err := bindAndValidate[rollupMetadataRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	metadata, err := registry.Rollup{
+		Id:          req.Hash,
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Website:     req.Website,
+		Logo:        req.Logo,
+		Description: req.Description,
+		VmType:      req.VmType,
+		Contact:     req.Contact,
+	}.Metadata()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	metadata.CreatedAt = time.Now().UTC()
+	metadata.UpdatedAt = metadata.CreatedAt
+
+	if err := handler.metadata.Upsert(c.Request().Context(), metadata); err != nil {
+		return handleError(c, err, handler.metadata)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewRollupMetadata(*metadata))
+}
+
+// DeleteMetadata godoc
+//
+//	@Summary		Delete rollup metadata
+//	@Description	Delete human-readable information about rollup
+//	@Tags			rollup
+//	@ID				delete-rollup-metadata
+//	@Param			hash	path	string	true	"Base64Url encoded rollup id"
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/rollup/{hash}/metadata [delete]
+func (handler *RollupHandler) DeleteMetadata(c echo.Context) error {
+	req, err := bindAndValidate[getRollupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.metadata.DeleteByRollupId(c.Request().Context(), hash); err != nil {
+		return handleError(c, err, handler.metadata)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
@@ -24,12 +25,13 @@ import (
 // RollupTestSuite -
 type RollupTestSuite struct {
 	suite.Suite
-	rollups *mock.MockIRollup
-	actions *mock.MockIAction
-	state   *mock.MockIState
-	echo    *echo.Echo
-	handler *RollupHandler
-	ctrl    *gomock.Controller
+	rollups  *mock.MockIRollup
+	metadata *mock.MockIRollupMetadata
+	actions  *mock.MockIAction
+	state    *mock.MockIState
+	echo     *echo.Echo
+	handler  *RollupHandler
+	ctrl     *gomock.Controller
 }
 
 // SetupSuite -
@@ -38,9 +40,10 @@ func (s *RollupTestSuite) SetupSuite() {
 	s.echo.Validator = NewApiValidator()
 	s.ctrl = gomock.NewController(s.T())
 	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.metadata = mock.NewMockIRollupMetadata(s.ctrl)
 	s.actions = mock.NewMockIAction(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewRollupHandler(s.rollups, s.actions, s.state, testIndexerName)
+	s.handler = NewRollupHandler(s.rollups, s.metadata, s.actions, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -148,7 +151,10 @@ func (s *RollupTestSuite) TestActions() {
 		Times(1)
 
 	s.actions.EXPECT().
-		ByRollup(gomock.Any(), uint64(1), 10, 0, sdk.SortOrderDesc).
+		ByRollup(gomock.Any(), uint64(1), storage.RollupActionsFilter{
+			Limit: 10,
+			Sort:  sdk.SortOrderDesc,
+		}).
 		Return([]storage.RollupAction{
 			{
 				RollupId: 1,
@@ -251,3 +257,132 @@ func (s *RollupTestSuite) TestAddresses() {
 	s.Require().EqualValues(10, address.Nonce)
 	s.Require().Equal(testAddressHash, address.Hash)
 }
+
+func (s *RollupTestSuite) TestListWithMetadata() {
+	q := make(url.Values)
+	q.Set("sort_by", "name")
+	q.Set("sort", "asc")
+	q.Set("vm_type", "EVM,svm")
+	q.Set("name", "fla")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup")
+
+	rollup := testRollup
+	rollup.Metadata = &storage.RollupMetadata{
+		Name:    "Flame",
+		Slug:    "flame",
+		VmType:  "evm",
+		Version: 1,
+	}
+
+	s.rollups.EXPECT().
+		ListExt(gomock.Any(), storage.RollupListFilter{
+			Limit:     10,
+			Offset:    0,
+			SortField: "name",
+			SortOrder: sdk.SortOrderAsc,
+			VmType:    []string{"evm", "svm"},
+			Name:      "fla",
+		}).
+		Return([]storage.Rollup{rollup}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var rollups []responses.Rollup
+	err := json.NewDecoder(rec.Body).Decode(&rollups)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+	s.Require().NotNil(rollups[0].Metadata)
+	s.Require().Equal("Flame", rollups[0].Metadata.Name)
+	s.Require().Equal("flame", rollups[0].Metadata.Slug)
+	s.Require().Equal("evm", rollups[0].Metadata.VmType)
+	s.Require().EqualValues(1, rollups[0].Metadata.Version)
+}
+
+func (s *RollupTestSuite) TestListInvalidSortField() {
+	q := make(url.Values)
+	q.Set("sort_by", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *RollupTestSuite) TestSetMetadata() {
+	body := `{"name":"Flame Rollup","website":"https://example.com","vm_type":"EVM"}`
+	req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/metadata")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.metadata.EXPECT().
+		Upsert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, m *storage.RollupMetadata) error {
+			s.Require().Equal(testRollup.AstriaId, m.RollupId)
+			s.Require().Equal("Flame Rollup", m.Name)
+			s.Require().Equal("flame-rollup", m.Slug)
+			s.Require().Equal("evm", m.VmType)
+			s.Require().Equal("https://example.com", m.Website)
+			s.Require().False(m.UpdatedAt.IsZero())
+			m.Id = 1
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.SetMetadata(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var metadata responses.RollupMetadata
+	err := json.NewDecoder(rec.Body).Decode(&metadata)
+	s.Require().NoError(err)
+	s.Require().Equal("Flame Rollup", metadata.Name)
+	s.Require().Equal("flame-rollup", metadata.Slug)
+}
+
+func (s *RollupTestSuite) TestSetMetadataInvalid() {
+	for _, body := range []string{
+		`{"website":"https://example.com"}`,
+		`{"name":"Flame","website":"not url"}`,
+		`{"name":"Flame","slug":"Invalid Slug"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/rollup/:hash/metadata")
+		c.SetParamNames("hash")
+		c.SetParamValues(testRollupURLHash)
+
+		s.Require().NoError(s.handler.SetMetadata(c), body)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func (s *RollupTestSuite) TestDeleteMetadata() {
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/metadata")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.metadata.EXPECT().
+		DeleteByRollupId(gomock.Any(), testRollup.AstriaId).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.DeleteMetadata(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
@@ -38,14 +38,24 @@ func NewSearchHandler(
 
 type searchRequest struct {
 	Search string `query:"query" validate:"required"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+func (p *searchRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
 }
 
 // Search godoc
 //
 //	@Summary				Search by hash or text
+//	@Description			Searches blocks by height or hash, transactions by hash, addresses by hex hash or bech32, rollups by hex or base64 identity and validators by name or address.
+//	@Description			Hex hashes may be shortened to a prefix of at least 8 symbols. Results are sorted by rank: exact matches go first.
 //	@Tags					search
 //	@ID						search
 //	@Param					query	query	string	true	"Search string"
+//	@Param					limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
 //	@Produce				json
 //	@Success				200	{array}		responses.SearchResult
 //	@Failure				400	{object}	Error
@@ -57,7 +67,9 @@ func (s *SearchHandler) Search(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
-	results, err := s.search.Search(c.Request().Context(), req.Search)
+	req.SetDefault()
+
+	results, err := s.search.Search(c.Request().Context(), req.Search, req.Limit)
 	if err != nil {
 		return internalServerError(c, err)
 	}
@@ -67,7 +67,7 @@ func (s *SearchTestSuite) TestSearchAddress() {
 	c.SetPath("/search")
 
 	s.search.EXPECT().
-		Search(gomock.Any(), testAddressHash).
+		Search(gomock.Any(), testAddressHash, 10).
 		Return([]storage.SearchResult{
 			{
 				Id:    testAddress.Id,
@@ -106,7 +106,7 @@ func (s *SearchTestSuite) TestSearchBlock() {
 	c.SetPath("/search")
 
 	s.search.EXPECT().
-		Search(gomock.Any(), testBlockHash).
+		Search(gomock.Any(), testBlockHash, 10).
 		Return([]storage.SearchResult{
 			{
 				Type:  "block",
@@ -145,7 +145,7 @@ func (s *SearchTestSuite) TestSearchTx() {
 	c.SetPath("/search")
 
 	s.search.EXPECT().
-		Search(gomock.Any(), testTxHash).
+		Search(gomock.Any(), testTxHash, 10).
 		Return([]storage.SearchResult{
 			{
 				Type:  "tx",
@@ -184,7 +184,7 @@ func (s *SearchTestSuite) TestSearchRollup() {
 	c.SetPath("/search")
 
 	s.search.EXPECT().
-		Search(gomock.Any(), testRollupHash).
+		Search(gomock.Any(), testRollupHash, 10).
 		Return([]storage.SearchResult{
 			{
 				Type:  "rollup",
@@ -223,7 +223,7 @@ func (s *SearchTestSuite) TestSearchValidator() {
 	c.SetPath("/search")
 
 	s.search.EXPECT().
-		Search(gomock.Any(), "nam").
+		Search(gomock.Any(), "nam", 10).
 		Return([]storage.SearchResult{
 			{
 				Type:  "validator",
@@ -251,3 +251,36 @@ func (s *SearchTestSuite) TestSearchValidator() {
 	s.Require().Equal("name", result.Value)
 	s.Require().NotNil(result.Body)
 }
+
+func (s *SearchTestSuite) TestSearchLimit() {
+	q := make(url.Values)
+	q.Add("query", "node")
+	q.Add("limit", "2")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.search.EXPECT().
+		Search(gomock.Any(), "node", 2).
+		Return([]storage.SearchResult{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *SearchTestSuite) TestSearchInvalidLimit() {
+	q := make(url.Values)
+	q.Add("query", "node")
+	q.Add("limit", "101")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
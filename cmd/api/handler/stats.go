@@ -6,10 +6,14 @@ package handler
 import (
 	"encoding/base64"
 	"net/http"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type StatsHandler struct {
@@ -43,22 +47,26 @@ func (sh StatsHandler) Summary(c echo.Context) error {
 }
 
 type seriesRequest struct {
-	Timeframe  string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
+	Timeframe  string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=minute hour day week month"`
 	SeriesName string `example:"tps"        param:"name"      swaggertype:"string"  validate:"required,oneof=data_size tps bps rbps fee supply_change block_time tx_count bytes_in_block gas_price gas_efficiency gas_used gas_wanted"`
 	From       int64  `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
 	To         int64  `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+	Points     int    `example:"100"        query:"points"    swaggertype:"integer" validate:"omitempty,min=1,max=1000"`
 }
 
 // Series godoc
 //
 //	@Summary		Get histogram with precomputed stats
-//	@Description	Get histogram with precomputed stats by series name and timeframe
+//	@Description	Get histogram with precomputed stats by series name and timeframe.
+//	@Description	If `from` or `to` is passed, the whole range is returned: buckets are merged if the range contains more of them than `points` (1000 by default).
+//	@Description	Otherwise the last 100 buckets are returned. `min` and `max` are extremes of merged buckets or of per-block rates for `tps`, `bps` and `rbps`.
 //	@Tags			stats
 //	@ID				stats-series
-//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(minute, hour, day, week, month)
 //	@Param			name		path	string	true	"Series name"					Enums(data_size, tps, bps, rbps, fee, supply_change, block_time, tx_count, bytes_in_block, gas_price, gas_efficiency, gas_used, gas_wanted)
 //	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
 //	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Param			points		query	integer	false	"Maximum count of points. Requires from or to"	minimum(1)	maximum(1000)
 //	@Produce		json
 //	@Success		200	{array}		responses.SeriesItem
 //	@Failure		400	{object}	Error
@@ -69,12 +77,16 @@ func (sh StatsHandler) Series(c echo.Context) error {
 	if err != nil {
 		return badRequestError(c, err)
 	}
+	seriesReq, err := newSeriesRequest(req.From, req.To, req.Points)
+	if err != nil {
+		return badRequestError(c, err)
+	}
 
 	histogram, err := sh.repo.Series(
 		c.Request().Context(),
 		storage.Timeframe(req.Timeframe),
 		req.SeriesName,
-		storage.NewSeriesRequest(req.From, req.To),
+		seriesReq,
 	)
 	if err != nil {
 		return internalServerError(c, err)
@@ -87,25 +99,38 @@ func (sh StatsHandler) Series(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+// newSeriesRequest - points are applied to the range only, so they can't be passed without its bounds
+func newSeriesRequest(from, to int64, points int) (storage.SeriesRequest, error) {
+	if points > 0 && from == 0 && to == 0 {
+		return storage.SeriesRequest{}, errors.New("points can't be passed without from or to")
+	}
+	req := storage.NewSeriesRequest(from, to)
+	req.Points = points
+	return req, nil
+}
+
 type rollupSeriesRequest struct {
 	Hash       string `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" param:"hash"      swaggertype:"string"  validate:"required,base64url"`
-	Timeframe  string `example:"hour"                                         param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
+	Timeframe  string `example:"hour"                                         param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day week month"`
 	SeriesName string `example:"size"                                         param:"name"      swaggertype:"string"  validate:"required,oneof=size avg_size min_size max_size actions_count"`
 	From       int64  `example:"1692892095"                                   query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
 	To         int64  `example:"1692892095"                                   query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+	Points     int    `example:"100"                                          query:"points"    swaggertype:"integer" validate:"omitempty,min=1,max=1000"`
 }
 
 // RollupSeries godoc
 //
 //	@Summary		Get histogram with precomputed rollup stats
-//	@Description	Get histogram with precomputed rollup by series name and timeframe
+//	@Description	Get histogram with precomputed rollup by series name and timeframe. Range and merging of buckets are the same as in the network series.
+//	@Description	Minute timeframe isn't available for rollups: minute buckets of every rollup are as large as the rollup actions table.
 //	@Tags			stats
 //	@ID				stats-rollup-series
 //	@Param			hash		path	string	true	"Base64Url encoded rollup id"
-//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, week, month)
 //	@Param			name		path	string	true	"Series name"					Enums(size, avg_size, min_size, max_size, actions_count)
 //	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
 //	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Param			points		query	integer	false	"Maximum count of points. Requires from or to"	minimum(1)	maximum(1000)
 //	@Produce		json
 //	@Success		200	{array}		responses.RollupSeriesItem
 //	@Failure		400	{object}	Error
@@ -116,6 +141,10 @@ func (sh StatsHandler) RollupSeries(c echo.Context) error {
 	if err != nil {
 		return badRequestError(c, err)
 	}
+	seriesReq, err := newSeriesRequest(req.From, req.To, req.Points)
+	if err != nil {
+		return badRequestError(c, err)
+	}
 
 	hash, err := base64.URLEncoding.DecodeString(req.Hash)
 	if err != nil {
@@ -132,7 +161,7 @@ func (sh StatsHandler) RollupSeries(c echo.Context) error {
 		rollup.Id,
 		storage.Timeframe(req.Timeframe),
 		req.SeriesName,
-		storage.NewSeriesRequest(req.From, req.To),
+		seriesReq,
 	)
 	if err != nil {
 		return internalServerError(c, err)
@@ -144,3 +173,205 @@ func (sh StatsHandler) RollupSeries(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type holdersRequest struct {
+	Currency string `example:"nria" query:"currency" swaggertype:"string"  validate:"omitempty"`
+	Limit    int    `example:"10"   query:"limit"    swaggertype:"integer" validate:"omitempty,min=1,max=100"`
+}
+
+func (p *holdersRequest) SetDefault() {
+	if p.Currency == "" {
+		p.Currency = currency.DefaultCurrency
+	}
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// Holders godoc
+//
+//	@Summary		Get holders distribution
+//	@Description	Get top holders of the asset, their share of supply, Gini coefficient and count of holders by balance ranges.
+//	@Description	Gini coefficient is estimated by the balance ranges, so it's a lower bound of the exact value.
+//	@Tags			stats
+//	@ID				stats-holders
+//	@Param			currency	query	string	false	"Currency. Default: nria"
+//	@Param			limit		query	integer	false	"Count of top holders"		minimum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{object}	responses.HolderStats
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/holders [get]
+func (sh StatsHandler) Holders(c echo.Context) error {
+	req, err := bindAndValidate[holdersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	stats, err := sh.repo.Holders(c.Request().Context(), req.Currency, req.Limit)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	return c.JSON(http.StatusOK, responses.NewHolderStats(stats))
+}
+
+type activeAddressesRequest struct {
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// ActiveAddresses godoc
+//
+//	@Summary		Get daily active addresses
+//	@Description	Get daily count of addresses which signed transactions or received funds.
+//	@Description	If `from` or `to` is passed, the whole range is returned. Otherwise the last 100 days are returned.
+//	@Tags			stats
+//	@ID				stats-active-addresses
+//	@Param			from	query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.ActiveAddressesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/active_addresses [get]
+func (sh StatsHandler) ActiveAddresses(c echo.Context) error {
+	req, err := bindAndValidate[activeAddressesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	items, err := sh.repo.ActiveAddresses(c.Request().Context(), storage.NewSeriesRequest(req.From, req.To))
+	if err != nil {
+		return internalServerError(c, err)
+	}
+
+	response := make([]responses.ActiveAddressesItem, len(items))
+	for i := range items {
+		response[i] = responses.NewActiveAddressesItem(items[i])
+	}
+	return returnArray(c, response)
+}
+
+type actionSeriesRequest struct {
+	Type      string `example:"transfer"   param:"type"      swaggertype:"string"  validate:"required,action_type"`
+	Timeframe string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=minute hour day week month"`
+	From      int64  `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To        int64  `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+	Points    int    `example:"100"        query:"points"    swaggertype:"integer" validate:"omitempty,min=1,max=1000"`
+}
+
+// ActionSeries godoc
+//
+//	@Summary		Get histogram of actions by type
+//	@Description	Get count of actions of the type and transferred volume by timeframe. Volume is zero for action types without value.
+//	@Description	Range and merging of buckets are the same as in the network series. Count and volume of merged buckets are summed, min and max are their extremes.
+//	@Tags			stats
+//	@ID				stats-action-series
+//	@Param			type		path	types.ActionType	true	"Action type"
+//	@Param			timeframe	path	string				true	"Timeframe"						Enums(minute, hour, day, week, month)
+//	@Param			from		query	integer				false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to			query	integer				false	"Time to in unix timestamp"		mininum(1)
+//	@Param			points		query	integer				false	"Maximum count of points. Requires from or to"	minimum(1)	maximum(1000)
+//	@Produce		json
+//	@Success		200	{array}		responses.ActionSeriesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/series/actions/{type}/{timeframe} [get]
+func (sh StatsHandler) ActionSeries(c echo.Context) error {
+	req, err := bindAndValidate[actionSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	seriesReq, err := newSeriesRequest(req.From, req.To, req.Points)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	items, err := sh.repo.ActionSeries(
+		c.Request().Context(),
+		storage.Timeframe(req.Timeframe),
+		types.ActionType(req.Type),
+		seriesReq,
+	)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+
+	response := make([]responses.ActionSeriesItem, len(items))
+	for i := range items {
+		response[i] = responses.NewActionSeriesItem(items[i])
+	}
+	return returnArray(c, response)
+}
+
+var topRollupsWindows = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// topRollupsByFee - fee metric of the leaderboard. It's accepted to explain why it's unavailable:
+// fees are paid by transactions and aren't attributed to rollups, so neither rollup actions nor their aggregates contain them.
+const topRollupsByFee = "fee"
+
+var errTopRollupsByFee = errors.New("sorting by fee is unavailable: fees aren't attributed to rollups")
+
+type topRollupsRequest struct {
+	Window string `example:"24h"  query:"window" swaggertype:"string"  validate:"omitempty,oneof=24h 7d 30d"`
+	By     string `example:"size" query:"by"     swaggertype:"string"  validate:"omitempty,oneof=size actions signers fee"`
+	Limit  int    `example:"10"   query:"limit"  swaggertype:"integer" validate:"omitempty,min=1,max=100"`
+}
+
+func (p *topRollupsRequest) SetDefault() {
+	if p.Window == "" {
+		p.Window = "24h"
+	}
+	if p.By == "" {
+		p.By = storage.TopRollupsBySize
+	}
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// TopRollups godoc
+//
+//	@Summary		Get rollup leaderboard
+//	@Description	Get rollups with the largest activity in the window ended now. Activity is compared with the previous window of the same length.
+//	@Description	Sorting by fee returns 400: fees are paid by transactions and aren't attributed to rollups.
+//	@Tags			stats
+//	@ID				stats-rollups-top
+//	@Param			window	query	string	false	"Window. Default: 24h"				Enums(24h, 7d, 30d)
+//	@Param			by		query	string	false	"Sorting metric. Default: size"		Enums(size, actions, signers)
+//	@Param			limit	query	integer	false	"Count of requested entities"		minimum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{array}		responses.TopRollup
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/rollups/top [get]
+func (sh StatsHandler) TopRollups(c echo.Context) error {
+	req, err := bindAndValidate[topRollupsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+	if req.By == topRollupsByFee {
+		return badRequestError(c, errTopRollupsByFee)
+	}
+
+	items, err := sh.repo.TopRollups(c.Request().Context(), storage.TopRollupsRequest{
+		Window: topRollupsWindows[req.Window],
+		By:     req.By,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return internalServerError(c, err)
+	}
+
+	response := make([]responses.TopRollup, len(items))
+	for i := range items {
+		response[i] = responses.NewTopRollup(items[i])
+	}
+	return returnArray(c, response)
+}
@@ -8,11 +8,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -66,8 +70,10 @@ func (s *StatsTestSuite) TestBlockStatsHistogram() {
 	} {
 
 		for _, tf := range []storage.Timeframe{
+			storage.TimeframeMinute,
 			storage.TimeframeHour,
 			storage.TimeframeDay,
+			storage.TimeframeWeek,
 			storage.TimeframeMonth,
 		} {
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -104,6 +110,61 @@ func (s *StatsTestSuite) TestBlockStatsHistogram() {
 	}
 }
 
+func (s *StatsTestSuite) TestSeriesPoints() {
+	q := make(url.Values)
+	q.Set("from", "1692892095")
+	q.Set("to", "1695484095")
+	q.Set("points", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/series/:name/:timeframe")
+	c.SetParamNames("name", "timeframe")
+	c.SetParamValues(storage.SeriesTPS, string(storage.TimeframeMinute))
+
+	seriesReq := storage.NewSeriesRequest(1692892095, 1695484095)
+	seriesReq.Points = 100
+
+	s.stats.EXPECT().
+		Series(gomock.Any(), storage.TimeframeMinute, storage.SeriesTPS, seriesReq).
+		Return([]storage.SeriesItem{
+			{
+				Time:  testTime,
+				Value: "0.5",
+				Max:   "1",
+				Min:   "0",
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Series(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.SeriesItem
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Len(response, 1)
+	s.Require().Equal("0.5", response[0].Value)
+	s.Require().Equal("1", response[0].Max)
+	s.Require().Equal("0", response[0].Min)
+}
+
+func (s *StatsTestSuite) TestSeriesPointsWithoutFrom() {
+	q := make(url.Values)
+	q.Set("points", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/series/:name/:timeframe")
+	c.SetParamNames("name", "timeframe")
+	c.SetParamValues(storage.SeriesTPS, string(storage.TimeframeHour))
+
+	s.Require().NoError(s.handler.Series(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *StatsTestSuite) TestRollupStatsHistogram() {
 	for _, name := range []string{
 		storage.RollupSeriesActionsCount,
@@ -189,3 +250,244 @@ func (s *StatsTestSuite) TestSummary() {
 	s.Require().EqualValues("10000", summary.Supply)
 	s.Require().EqualValues(10, summary.TxCount)
 }
+
+func (s *StatsTestSuite) TestHolders() {
+	q := make(url.Values)
+	q.Set("limit", "1")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/holders")
+
+	s.stats.EXPECT().
+		Holders(gomock.Any(), currency.DefaultCurrency, 1).
+		Return(storage.HolderStats{
+			Currency:     currency.DefaultCurrency,
+			HoldersCount: 3,
+			Supply:       decimal.RequireFromString("4000"),
+			Gini:         0.5,
+			Top:          []storage.Address{testAddress},
+			Buckets: []storage.HolderBucket{
+				{
+					Currency: currency.DefaultCurrency,
+					Bucket:   0,
+					Count:    2,
+					Total:    decimal.RequireFromString("10"),
+				}, {
+					Currency: currency.DefaultCurrency,
+					Bucket:   3,
+					Count:    1,
+					Total:    decimal.RequireFromString("3990"),
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Holders(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var stats responses.HolderStats
+	err := json.NewDecoder(rec.Body).Decode(&stats)
+	s.Require().NoError(err)
+	s.Require().Equal(currency.DefaultCurrency, stats.Currency)
+	s.Require().EqualValues(3, stats.HoldersCount)
+	s.Require().Equal("4000", stats.Supply)
+	s.Require().EqualValues(0.5, stats.Gini)
+
+	s.Require().Len(stats.Top, 1)
+	s.Require().Equal(testAddress.String(), stats.Top[0].Address)
+	s.Require().Equal("1000", stats.Top[0].Balance)
+	s.Require().EqualValues(0.25, stats.Top[0].Share)
+
+	s.Require().Len(stats.Distribution, 2)
+	s.Require().Equal("0", stats.Distribution[0].From)
+	s.Require().Equal("10", stats.Distribution[0].To)
+	s.Require().EqualValues(2, stats.Distribution[0].Count)
+	s.Require().Equal("1000", stats.Distribution[1].From)
+	s.Require().Equal("10000", stats.Distribution[1].To)
+	s.Require().EqualValues(0.9975, stats.Distribution[1].Share)
+}
+
+func (s *StatsTestSuite) TestHoldersInvalidLimit() {
+	q := make(url.Values)
+	q.Set("limit", "1000")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/holders")
+
+	s.Require().NoError(s.handler.Holders(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *StatsTestSuite) TestActiveAddresses() {
+	q := make(url.Values)
+	q.Set("from", "1692892095")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/active_addresses")
+
+	s.stats.EXPECT().
+		ActiveAddresses(gomock.Any(), storage.NewSeriesRequest(1692892095, 0)).
+		Return([]storage.ActiveAddressesItem{
+			{
+				Time:      testTime,
+				Active:    3,
+				Signers:   2,
+				Receivers: 2,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ActiveAddresses(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.ActiveAddressesItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal(testTime, items[0].Time)
+	s.Require().EqualValues(3, items[0].Active)
+	s.Require().EqualValues(2, items[0].Signers)
+	s.Require().EqualValues(2, items[0].Receivers)
+}
+
+func (s *StatsTestSuite) TestTopRollups() {
+	q := make(url.Values)
+	q.Set("window", "7d")
+	q.Set("by", "signers")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/rollups/top")
+
+	s.stats.EXPECT().
+		TopRollups(gomock.Any(), storage.TopRollupsRequest{
+			Window: 7 * 24 * time.Hour,
+			By:     storage.TopRollupsBySigners,
+			Limit:  10,
+		}).
+		Return([]storage.TopRollupItem{
+			{
+				RollupId:         testRollup.Id,
+				Size:             150,
+				ActionsCount:     10,
+				Signers:          2,
+				SizeShare:        0.75,
+				PrevSize:         100,
+				PrevActionsCount: 0,
+				PrevSigners:      4,
+				Rollup:           &testRollup,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.TopRollups(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.TopRollup
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	item := items[0]
+	s.Require().NotNil(item.Rollup)
+	s.Require().EqualValues(testRollup.Id, item.Rollup.Id)
+	s.Require().EqualValues(150, item.Size)
+	s.Require().EqualValues(0.75, item.SizeShare)
+	s.Require().NotNil(item.SizeChange)
+	s.Require().EqualValues(0.5, *item.SizeChange)
+	s.Require().Nil(item.ActionsCountChange)
+	s.Require().NotNil(item.SignersChange)
+	s.Require().EqualValues(-0.5, *item.SignersChange)
+}
+
+func (s *StatsTestSuite) TestTopRollupsInvalidWindow() {
+	for _, param := range []string{"window=1y", "by=volume", "limit=1000"} {
+		req := httptest.NewRequest(http.MethodGet, "/?"+param, nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/rollups/top")
+
+		s.Require().NoError(s.handler.TopRollups(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, param)
+	}
+}
+
+func (s *StatsTestSuite) TestTopRollupsByFee() {
+	req := httptest.NewRequest(http.MethodGet, "/?by=fee", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/rollups/top")
+
+	s.Require().NoError(s.handler.TopRollups(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var response Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal(errTopRollupsByFee.Error(), response.Message)
+}
+
+func (s *StatsTestSuite) TestActionSeries() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/series/actions/:type/:timeframe")
+		c.SetParamNames("type", "timeframe")
+		c.SetParamValues(string(types.ActionTypeTransfer), string(tf))
+
+		s.stats.EXPECT().
+			ActionSeries(gomock.Any(), tf, types.ActionTypeTransfer, gomock.Any()).
+			Return([]storage.ActionSeriesItem{
+				{
+					Time:      testTime,
+					Count:     10,
+					Volume:    decimal.RequireFromString("1000"),
+					MaxCount:  7,
+					MinCount:  3,
+					MaxVolume: decimal.RequireFromString("900"),
+					MinVolume: decimal.RequireFromString("100"),
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.ActionSeries(c))
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var response []responses.ActionSeriesItem
+		err := json.NewDecoder(rec.Body).Decode(&response)
+		s.Require().NoError(err)
+		s.Require().Len(response, 1)
+
+		item := response[0]
+		s.Require().Equal(testTime, item.Time)
+		s.Require().EqualValues(10, item.Count)
+		s.Require().Equal("1000", item.Volume)
+		s.Require().EqualValues(7, item.MaxCount)
+		s.Require().EqualValues(3, item.MinCount)
+		s.Require().Equal("900", item.MaxVolume)
+		s.Require().Equal("100", item.MinVolume)
+	}
+}
+
+func (s *StatsTestSuite) TestActionSeriesInvalidType() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/series/actions/:type/:timeframe")
+	c.SetParamNames("type", "timeframe")
+	c.SetParamValues("unknown", "hour")
+
+	s.Require().NoError(s.handler.ActionSeries(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
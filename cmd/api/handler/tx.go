@@ -8,15 +8,20 @@ import (
 	"net/http"
 	"time"
 
+	astria "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transactions/v1alpha1"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type TxHandler struct {
 	tx          storage.ITx
+	raw         storage.IRawTx
 	actions     storage.IAction
 	rollups     storage.IRollup
 	state       storage.IState
@@ -25,6 +30,7 @@ type TxHandler struct {
 
 func NewTxHandler(
 	tx storage.ITx,
+	raw storage.IRawTx,
 	actions storage.IAction,
 	rollups storage.IRollup,
 	state storage.IState,
@@ -32,6 +38,7 @@ func NewTxHandler(
 ) *TxHandler {
 	return &TxHandler{
 		tx:          tx,
+		raw:         raw,
 		actions:     actions,
 		rollups:     rollups,
 		state:       state,
@@ -75,6 +82,92 @@ func (handler *TxHandler) Get(c echo.Context) error {
 	return c.JSON(http.StatusOK, responses.NewTx(tx))
 }
 
+// Raw godoc
+//
+//	@Summary		Get raw transaction
+//	@Description	Get encoded SignedTransaction as it was included in the block
+//	@Tags			transactions
+//	@ID				get-transaction-raw
+//	@Param			hash	path	string	true	"Transaction hash in hexadecimal"	minlength(64)	maxlength(64)
+//	@Produce		octet-stream
+//	@Success		200	{file}	binary
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/tx/{hash}/raw [get]
+func (handler *TxHandler) Raw(c echo.Context) error {
+	raw, err := handler.getRaw(c)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, raw)
+}
+
+// Decoded godoc
+//
+//	@Summary		Get decoded transaction
+//	@Description	Get SignedTransaction decoded from raw bytes in canonical protobuf JSON
+//	@Tags			transactions
+//	@ID				get-transaction-decoded
+//	@Param			hash	path	string	true	"Transaction hash in hexadecimal"	minlength(64)	maxlength(64)
+//	@Produce		json
+//	@Success		200	{object}	object
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/tx/{hash}/decoded [get]
+func (handler *TxHandler) Decoded(c echo.Context) error {
+	raw, err := handler.getRaw(c)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+
+	var signedTx astria.SignedTransaction
+	if err := proto.Unmarshal(raw, &signedTx); err != nil {
+		return internalServerError(c, errors.Wrap(err, "tx decoding"))
+	}
+	data, err := protojson.Marshal(&signedTx)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	return c.JSONBlob(http.StatusOK, data)
+}
+
+// getRaw - returns decompressed raw transaction by hash from the request. If response was already written, it returns nil.
+func (handler *TxHandler) getRaw(c echo.Context) ([]byte, error) {
+	req, err := bindAndValidate[getTxRequest](c)
+	if err != nil {
+		return nil, badRequestError(c, err)
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, badRequestError(c, err)
+	}
+
+	tx, err := handler.tx.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return nil, handleError(c, err, handler.tx)
+	}
+
+	raw, err := handler.raw.ByTxId(c.Request().Context(), tx.Id)
+	if err != nil {
+		return nil, handleError(c, err, handler.raw)
+	}
+
+	data, err := raw.Decompressed()
+	if err != nil {
+		return nil, internalServerError(c, errors.Wrap(err, "tx decompression"))
+	}
+	return data, nil
+}
+
 type txListRequest struct {
 	Limit       uint64      `query:"limit"        validate:"omitempty,min=1,max=100"`
 	Offset      uint64      `query:"offset"       validate:"omitempty,min=0"`
@@ -83,6 +176,7 @@ type txListRequest struct {
 	Status      StringArray `query:"status"       validate:"omitempty,dive,status"`
 	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
 	WithActions bool        `query:"with_actions" validate:"omitempty"`
+	Cursor      string      `query:"cursor"       validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -112,8 +206,9 @@ func (p *txListRequest) SetDefault() {
 //	@Param			to					query	integer				false	"Time to in unix timestamp"		mininum(1)
 //	@Param			height				query	integer				false	"Block number"					mininum(1)
 //	@Param			messages			query	boolean				false	"If true join actions"			mininum(1)
+//	@Param			cursor				query	string				false	"Keyset pagination cursor. If passed (even empty) response is wrapped into page with next_cursor and offset is ignored"
 //	@Produce		json
-//	@Success		200	{array}		responses.Tx
+//	@Success		200	{array}		responses.Tx	"Array of entities. If cursor is passed the response is a page object with items array and next_cursor string (responses.Page)"
 //	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/tx [get]
@@ -142,6 +237,15 @@ func (handler *TxHandler) List(c echo.Context) error {
 	for i := range req.ActionTypes {
 		fltrs.ActionTypes.SetType(storageTypes.ActionType(req.ActionTypes[i]))
 	}
+	cursorMode := isCursorRequest(c)
+	if cursorMode {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		fltrs.Cursor = cursor
+		fltrs.Offset = 0
+	}
 
 	txs, err := handler.tx.Filter(c.Request().Context(), fltrs)
 	if err != nil {
@@ -151,9 +255,86 @@ func (handler *TxHandler) List(c echo.Context) error {
 	for i := range txs {
 		response[i] = responses.NewTx(txs[i])
 	}
+	if cursorMode {
+		return returnPage(c, response, fltrs.Limit, func(tx responses.Tx) (time.Time, uint64) {
+			return tx.Time, tx.Id
+		})
+	}
 	return returnArray(c, response)
 }
 
+type txExportRequest struct {
+	Format      string      `query:"format"       validate:"omitempty,oneof=csv ndjson"`
+	Sort        string      `query:"sort"         validate:"omitempty,oneof=asc desc"`
+	Height      uint64      `query:"height"       validate:"omitempty,min=1"`
+	Status      StringArray `query:"status"       validate:"omitempty,dive,status"`
+	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
+
+	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (p *txExportRequest) SetDefault() {
+	if p.Format == "" {
+		p.Format = exportFormatCsv
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
+// Export godoc
+//
+//	@Summary		Export transactions
+//	@Description	Stream all transactions matched the filters in CSV or NDJSON format
+//	@Tags			transactions
+//	@ID				export-transactions
+//	@Param			format			query	string				false	"Export format"						Enums(csv, ndjson)
+//	@Param			sort			query	string				false	"Sort order"						Enums(asc, desc)
+//	@Param			status			query	types.Status		false	"Comma-separated status list"
+//	@Param			action_types	query	types.ActionType	false	"Comma-separated action types list"
+//	@Param			from			query	integer				false	"Time from in unix timestamp"		mininum(1)
+//	@Param			to				query	integer				false	"Time to in unix timestamp"			mininum(1)
+//	@Param			height			query	integer				false	"Block number"						mininum(1)
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Success		200
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/tx/export [get]
+func (handler *TxHandler) Export(c echo.Context) error {
+	req, err := bindAndValidate[txExportRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.TxFilter{
+		Sort:        pgSort(req.Sort),
+		Status:      req.Status,
+		Height:      req.Height,
+		ActionTypes: types.NewActionTypeMask(),
+	}
+	if req.From > 0 {
+		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		fltrs.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+	for i := range req.ActionTypes {
+		fltrs.ActionTypes.SetType(storageTypes.ActionType(req.ActionTypes[i]))
+	}
+
+	e, err := newExporter(c, req.Format, "txs", txExportHeader, txExportRow)
+	if err != nil {
+		return internalServerError(c, err)
+	}
+	err = handler.tx.Stream(c.Request().Context(), fltrs, func(tx storage.Tx) error {
+		return e.Write(responses.NewTx(tx))
+	})
+	return e.Close(err)
+}
+
 type txRequestWithPagination struct {
 	Hash   string `param:"hash"   validate:"required,hexadecimal,len=64"`
 	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
@@ -5,6 +5,7 @@ package handler
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"encoding/json"
 	"net/http"
@@ -13,19 +14,24 @@ import (
 	"testing"
 	"time"
 
+	astria "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transactions/v1alpha1"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
 	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 )
 
 // TxTestSuite -
 type TxTestSuite struct {
 	suite.Suite
 	tx      *mock.MockITx
+	raw     *mock.MockIRawTx
 	actions *mock.MockIAction
 	rollups *mock.MockIRollup
 	state   *mock.MockIState
@@ -43,10 +49,11 @@ func (s *TxTestSuite) SetupSuite() {
 	s.echo.Validator = NewApiValidator()
 	s.ctrl = gomock.NewController(s.T())
 	s.tx = mock.NewMockITx(s.ctrl)
+	s.raw = mock.NewMockIRawTx(s.ctrl)
 	s.actions = mock.NewMockIAction(s.ctrl)
 	s.rollups = mock.NewMockIRollup(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewTxHandler(s.tx, s.actions, s.rollups, s.state, testIndexerName)
+	s.handler = NewTxHandler(s.tx, s.raw, s.actions, s.rollups, s.state, testIndexerName)
 }
 
 func (s *TxTestSuite) TearDownSuite() {
@@ -88,6 +95,108 @@ func (s *TxTestSuite) TestGet() {
 	s.Require().Equal(types.StatusSuccess, tx.Status)
 }
 
+func (s *TxTestSuite) TestRaw() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash/raw")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash)
+
+	raw := testRawTx(s.T())
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTx.Hash).
+		Return(testTx, nil).
+		Times(1)
+
+	s.raw.EXPECT().
+		ByTxId(gomock.Any(), testTx.Id).
+		Return(storage.RawTx{
+			Id:     testTx.Id,
+			Height: testTx.Height,
+			Time:   testTx.Time,
+			Data:   raw,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Raw(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal(echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+	s.Require().Equal(raw, rec.Body.Bytes())
+}
+
+func (s *TxTestSuite) TestRawNotStored() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash/raw")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash)
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTx.Hash).
+		Return(testTx, nil).
+		Times(1)
+
+	s.raw.EXPECT().
+		ByTxId(gomock.Any(), testTx.Id).
+		Return(storage.RawTx{}, sql.ErrNoRows).
+		Times(1)
+
+	s.raw.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Raw(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *TxTestSuite) TestDecoded() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash/decoded")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash)
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTx.Hash).
+		Return(testTx, nil).
+		Times(1)
+
+	compressed, err := types.CompressionGzip.Compress(testRawTx(s.T()))
+	s.Require().NoError(err)
+
+	s.raw.EXPECT().
+		ByTxId(gomock.Any(), testTx.Id).
+		Return(storage.RawTx{
+			Id:          testTx.Id,
+			Height:      testTx.Height,
+			Time:        testTx.Time,
+			Compression: types.CompressionGzip,
+			Data:        compressed,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Decoded(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var decoded map[string]any
+	err = json.NewDecoder(rec.Body).Decode(&decoded)
+	s.Require().NoError(err)
+	s.Require().Contains(decoded, "signature")
+	s.Require().Contains(decoded, "transaction")
+
+	transaction, ok := decoded["transaction"].(map[string]any)
+	s.Require().True(ok)
+	params, ok := transaction["params"].(map[string]any)
+	s.Require().True(ok)
+	s.Require().EqualValues(10, params["nonce"])
+	s.Require().Equal("astria-dusk-5", params["chainId"])
+}
+
 func (s *TxTestSuite) TestGetInvalidTx() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -154,6 +263,89 @@ func (s *TxTestSuite) TestList() {
 	s.Require().Equal(types.StatusSuccess, tx.Status)
 }
 
+func (s *TxTestSuite) TestListWithCursor() {
+	q := make(url.Values)
+	q.Set("limit", "1")
+	q.Set("sort", "desc")
+	q.Set("offset", "10")
+	q.Set("cursor", encodeCursor(testTime, 2))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx")
+
+	s.tx.EXPECT().
+		Filter(gomock.Any(), storage.TxFilter{
+			Limit:       1,
+			Sort:        pgSort(desc),
+			Cursor:      storage.NewCursor(testTime, 2),
+			ActionTypes: types.NewActionTypeMask(),
+		}).
+		Return([]storage.Tx{
+			testTx,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var page responses.Page[responses.Tx]
+	err := json.NewDecoder(rec.Body).Decode(&page)
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Require().EqualValues(1, page.Items[0].Id)
+	s.Require().Equal(encodeCursor(testTime, 1), page.NextCursor)
+}
+
+func (s *TxTestSuite) TestListWithInvalidCursor() {
+	q := make(url.Values)
+	q.Set("cursor", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *TxTestSuite) TestExport() {
+	q := make(url.Values)
+	q.Set("format", "ndjson")
+	q.Set("sort", "desc")
+	q.Set("status", "success")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/export")
+
+	s.tx.EXPECT().
+		Stream(gomock.Any(), storage.TxFilter{
+			Sort:        pgSort(desc),
+			Status:      []string{"success"},
+			ActionTypes: types.NewActionTypeMask(),
+		}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ storage.TxFilter, fn func(storage.Tx) error) error {
+			return fn(testTx)
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Export(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("application/x-ndjson", rec.Header().Get(echo.HeaderContentType))
+	s.Require().Contains(rec.Header().Get(echo.HeaderContentDisposition), "txs.ndjson")
+
+	var tx responses.Tx
+	decoder := json.NewDecoder(rec.Body)
+	s.Require().NoError(decoder.Decode(&tx))
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().Equal(testTxHash, tx.Hash)
+	s.Require().False(decoder.More())
+}
+
 func (s *TxTestSuite) TestListValidationStatusError() {
 	q := make(url.Values)
 	q.Set("limit", "2")
@@ -427,3 +619,18 @@ func (s *TxTestSuite) TestRollupActionsCount() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(1234, count)
 }
+
+func testRawTx(t *testing.T) []byte {
+	raw, err := proto.Marshal(&astria.SignedTransaction{
+		Signature: testsuite.RandomHash(64),
+		PublicKey: testsuite.RandomHash(32),
+		Transaction: &astria.UnsignedTransaction{
+			Params: &astria.TransactionParams{
+				Nonce:   10,
+				ChainId: "astria-dusk-5",
+			},
+		},
+	})
+	require.NoError(t, err)
+	return raw
+}
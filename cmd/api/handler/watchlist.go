@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+type WatchlistHandler struct {
+	watchlists storage.IWatchlist
+}
+
+func NewWatchlistHandler(watchlists storage.IWatchlist) *WatchlistHandler {
+	return &WatchlistHandler{
+		watchlists: watchlists,
+	}
+}
+
+type watchlistRequest struct {
+	Name        string   `json:"name"         validate:"omitempty,max=256"`
+	Address     string   `json:"address"      validate:"required_without=Rollup,excluded_with=Rollup,omitempty,address"`
+	Rollup      string   `json:"rollup"       validate:"required_without=Address,omitempty,base64url"`
+	ActionTypes []string `json:"action_types" validate:"omitempty,dive,action_type"`
+	Threshold   string   `json:"threshold"    validate:"omitempty,number"`
+	Currency    string   `json:"currency"     validate:"omitempty"`
+	Direction   string   `json:"direction"    validate:"omitempty,oneof=any in out"`
+	WebhookUrl  string   `json:"webhook_url"  validate:"omitempty,url"`
+	Secret      string   `json:"secret"       validate:"omitempty"`
+	Enabled     *bool    `json:"enabled"      validate:"omitempty"`
+}
+
+func (req watchlistRequest) fill(w *storage.Watchlist) error {
+	w.Name = req.Name
+	w.Address = nil
+	w.RollupId = nil
+
+	if req.Address != "" {
+		hash, err := hex.DecodeString(req.Address)
+		if err != nil {
+			return errors.Wrap(errInvalidAddress, err.Error())
+		}
+		w.Address = hash
+	}
+	if req.Rollup != "" {
+		rollupId, err := base64.URLEncoding.DecodeString(req.Rollup)
+		if err != nil {
+			return errors.Wrap(err, "invalid rollup")
+		}
+		w.RollupId = rollupId
+	}
+
+	w.ActionTypes = types.NewActionTypeMask(req.ActionTypes...).Bits
+
+	w.Threshold = decimal.Zero
+	if req.Threshold != "" {
+		threshold, err := decimal.NewFromString(req.Threshold)
+		if err != nil {
+			return errors.Wrap(err, "invalid threshold")
+		}
+		if threshold.IsNegative() {
+			return errors.New("threshold should be non-negative")
+		}
+		w.Threshold = threshold
+	}
+
+	w.Currency = req.Currency
+	w.Direction = types.DirectionAny
+	if req.Direction != "" {
+		w.Direction = types.Direction(req.Direction)
+	}
+	w.WebhookUrl = req.WebhookUrl
+	w.Secret = req.Secret
+	w.Enabled = req.Enabled == nil || *req.Enabled
+	return nil
+}
+
+type getWatchlistRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Get godoc
+//
+//	@Summary		Get watchlist rule
+//	@Description	Get watchlist rule
+//	@Tags			watchlist
+//	@ID				get-watchlist
+//	@Param			id	path	integer	true	"Internal identity"	mininum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.Watchlist
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists/{id} [get]
+func (handler *WatchlistHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getWatchlistRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	watchlist, err := handler.watchlists.GetByID(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewWatchlist(*watchlist))
+}
+
+// List godoc
+//
+//	@Summary		List watchlist rules
+//	@Description	List watchlist rules
+//	@Tags			watchlist
+//	@ID				list-watchlist
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.Watchlist
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists [get]
+func (handler *WatchlistHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	watchlists, err := handler.watchlists.List(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	response := make([]responses.Watchlist, len(watchlists))
+	for i := range watchlists {
+		response[i] = responses.NewWatchlist(*watchlists[i])
+	}
+	return returnArray(c, response)
+}
+
+// Create godoc
+//
+//	@Summary		Create watchlist rule
+//	@Description	Create rule which describes activity of address or rollup. Exactly one of address or rollup should be passed.
+//	@Tags			watchlist
+//	@ID				create-watchlist
+//	@Accept			json
+//	@Param			request	body	watchlistRequest	true	"Rule"
+//	@Produce		json
+//	@Success		201	{object}	responses.Watchlist
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists [post]
+func (handler *WatchlistHandler) Create(c echo.Context) error {
+	req, err := bindAndValidate[watchlistRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	var watchlist storage.Watchlist
+	if err := req.fill(&watchlist); err != nil {
+		return badRequestError(c, err)
+	}
+	watchlist.CreatedAt = time.Now().UTC()
+	watchlist.UpdatedAt = watchlist.CreatedAt
+
+	if err := handler.watchlists.Save(c.Request().Context(), &watchlist); err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	return c.JSON(http.StatusCreated, responses.NewWatchlist(watchlist))
+}
+
+type updateWatchlistRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+	watchlistRequest
+}
+
+// Update godoc
+//
+//	@Summary		Update watchlist rule
+//	@Description	Replace all fields of the watchlist rule
+//	@Tags			watchlist
+//	@ID				update-watchlist
+//	@Accept			json
+//	@Param			id		path	integer				true	"Internal identity"	mininum(1)
+//	@Param			request	body	watchlistRequest	true	"Rule"
+//	@Produce		json
+//	@Success		200	{object}	responses.Watchlist
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists/{id} [put]
+func (handler *WatchlistHandler) Update(c echo.Context) error {
+	req, err := bindAndValidate[updateWatchlistRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	watchlist, err := handler.watchlists.GetByID(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	if err := req.fill(watchlist); err != nil {
+		return badRequestError(c, err)
+	}
+	watchlist.UpdatedAt = time.Now().UTC()
+
+	if err := handler.watchlists.Update(c.Request().Context(), watchlist); err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewWatchlist(*watchlist))
+}
+
+// Delete godoc
+//
+//	@Summary		Delete watchlist rule
+//	@Description	Delete watchlist rule with all its alerts
+//	@Tags			watchlist
+//	@ID				delete-watchlist
+//	@Param			id	path	integer	true	"Internal identity"	mininum(1)
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists/{id} [delete]
+func (handler *WatchlistHandler) Delete(c echo.Context) error {
+	req, err := bindAndValidate[getWatchlistRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.watchlists.Delete(c.Request().Context(), req.Id); err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type watchlistAlertsRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (p *watchlistAlertsRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// Alerts godoc
+//
+//	@Summary		Get fired alerts of watchlist rule
+//	@Description	Get fired alerts of watchlist rule
+//	@Tags			watchlist
+//	@ID				watchlist-alerts
+//	@Param			id		path	integer	true	"Internal identity"				mininum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.WatchlistAlert
+//	@Failure		400	{object}	Error
+//	@Failure		401	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Security		ApiKeyAuth
+//	@Router			/v1/watchlists/{id}/alerts [get]
+func (handler *WatchlistHandler) Alerts(c echo.Context) error {
+	req, err := bindAndValidate[watchlistAlertsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	alerts, err := handler.watchlists.Alerts(c.Request().Context(), req.Id, req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.watchlists)
+	}
+
+	response := make([]responses.WatchlistAlert, len(alerts))
+	for i := range alerts {
+		response[i] = responses.NewWatchlistAlert(alerts[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testWatchlist = storage.Watchlist{
+	Id:          1,
+	Name:        "treasury",
+	Address:     testsuite.MustHexDecode("115F94D8C98FFD73FE65182611140F0EDC7C3C94"),
+	ActionTypes: types.ActionTypeTransferBits,
+	Threshold:   decimal.RequireFromString("1000"),
+	Currency:    "nria",
+	Direction:   types.DirectionOut,
+	Enabled:     true,
+	CreatedAt:   time.Now(),
+	UpdatedAt:   time.Now(),
+}
+
+// WatchlistTestSuite -
+type WatchlistTestSuite struct {
+	suite.Suite
+	watchlists *mock.MockIWatchlist
+	echo       *echo.Echo
+	handler    *WatchlistHandler
+	ctrl       *gomock.Controller
+}
+
+// SetupSuite -
+func (s *WatchlistTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.watchlists = mock.NewMockIWatchlist(s.ctrl)
+	s.handler = NewWatchlistHandler(s.watchlists)
+}
+
+// TearDownSuite -
+func (s *WatchlistTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteWatchlist_Run(t *testing.T) {
+	suite.Run(t, new(WatchlistTestSuite))
+}
+
+func (s *WatchlistTestSuite) TestCreate() {
+	body := `{"name":"treasury","address":"115F94D8C98FFD73FE65182611140F0EDC7C3C94","action_types":["transfer"],"threshold":"1000","currency":"nria","direction":"out"}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists")
+
+	s.watchlists.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, w *storage.Watchlist) error {
+			s.Require().Equal("treasury", w.Name)
+			s.Require().Equal(testWatchlist.Address, w.Address)
+			s.Require().Nil(w.RollupId)
+			s.Require().Equal(types.ActionTypeTransferBits, w.ActionTypes)
+			s.Require().Equal("1000", w.Threshold.String())
+			s.Require().Equal(types.DirectionOut, w.Direction)
+			s.Require().True(w.Enabled)
+			w.Id = 1
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Create(c))
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var watchlist responses.Watchlist
+	err := json.NewDecoder(rec.Body).Decode(&watchlist)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, watchlist.Id)
+	s.Require().Equal("115f94d8c98ffd73fe65182611140f0edc7c3c94", watchlist.Address)
+	s.Require().Equal([]string{"transfer"}, watchlist.ActionTypes)
+	s.Require().Equal("out", watchlist.Direction)
+}
+
+func (s *WatchlistTestSuite) TestCreateValidation() {
+	for _, body := range []string{
+		`{"name":"empty"}`,
+		`{"address":"115F94D8C98FFD73FE65182611140F0EDC7C3C94","rollup":"` + testRollupURLHash + `"}`,
+		`{"address":"invalid"}`,
+		`{"address":"115F94D8C98FFD73FE65182611140F0EDC7C3C94","direction":"both"}`,
+		`{"address":"115F94D8C98FFD73FE65182611140F0EDC7C3C94","action_types":["unknown"]}`,
+		`{"address":"115F94D8C98FFD73FE65182611140F0EDC7C3C94","threshold":"-1"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/watchlists")
+
+		s.Require().NoError(s.handler.Create(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func (s *WatchlistTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.watchlists.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&testWatchlist, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var watchlist responses.Watchlist
+	err := json.NewDecoder(rec.Body).Decode(&watchlist)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, watchlist.Id)
+	s.Require().Equal("treasury", watchlist.Name)
+	s.Require().Equal("1000", watchlist.Threshold)
+	s.Require().Equal("nria", watchlist.Currency)
+}
+
+func (s *WatchlistTestSuite) TestList() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists")
+
+	s.watchlists.EXPECT().
+		List(gomock.Any(), uint64(10), uint64(0), sdk.SortOrderAsc).
+		Return([]*storage.Watchlist{&testWatchlist}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var watchlists []responses.Watchlist
+	err := json.NewDecoder(rec.Body).Decode(&watchlists)
+	s.Require().NoError(err)
+	s.Require().Len(watchlists, 1)
+}
+
+func (s *WatchlistTestSuite) TestUpdate() {
+	body := `{"rollup":"` + testRollupURLHash + `","enabled":false}`
+	req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	watchlist := testWatchlist
+	s.watchlists.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&watchlist, nil).
+		Times(1)
+
+	s.watchlists.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, w *storage.Watchlist) error {
+			s.Require().EqualValues(1, w.Id)
+			s.Require().Nil(w.Address)
+			s.Require().Equal(testRollup.AstriaId, w.RollupId)
+			s.Require().Equal(types.DirectionAny, w.Direction)
+			s.Require().False(w.Enabled)
+			return nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Update(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *WatchlistTestSuite) TestDelete() {
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.watchlists.EXPECT().
+		Delete(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Delete(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *WatchlistTestSuite) TestAlerts() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/watchlists/:id/alerts")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.watchlists.EXPECT().
+		Alerts(gomock.Any(), uint64(1), 10, 0, sdk.SortOrderDesc).
+		Return([]storage.WatchlistAlert{
+			{
+				Id:          1,
+				WatchlistId: 1,
+				Height:      100,
+				Time:        time.Now(),
+				TxHash:      testTx.Hash,
+				ActionType:  types.ActionTypeTransfer,
+				Amount:      decimal.RequireFromString("1000"),
+				Currency:    "nria",
+				Direction:   types.DirectionOut,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Alerts(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var alerts []responses.WatchlistAlert
+	err := json.NewDecoder(rec.Body).Decode(&alerts)
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 1)
+	s.Require().Equal("transfer", alerts[0].ActionType)
+	s.Require().Equal("1000", alerts[0].Amount)
+	s.Require().Equal("out", alerts[0].Direction)
+}
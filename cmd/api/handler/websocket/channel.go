@@ -5,10 +5,35 @@ package websocket
 
 import (
 	sdkSync "github.com/dipdup-net/indexer-sdk/pkg/sync"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
 
 	"github.com/pkg/errors"
 )
 
+// outgoing - notification which is serialized once and shared between all receiving clients
+type outgoing struct {
+	msg  any
+	raw  []byte
+	data *websocket.PreparedMessage
+}
+
+func newOutgoing(msg any) (*outgoing, error) {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode notification")
+	}
+	data, err := websocket.NewPreparedMessage(websocket.TextMessage, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "prepare notification")
+	}
+	return &outgoing{
+		msg:  msg,
+		raw:  raw,
+		data: data,
+	}, nil
+}
+
 type processor[I any, M INotification] func(data I) Notification[M]
 
 type Channel[I any, M INotification] struct {
@@ -40,10 +65,20 @@ func (channel *Channel[I, M]) processMessage(msg I) error {
 
 	data := channel.processor(msg)
 
+	// notification is encoded lazily: only if at least one client receives it
+	var out *outgoing
 	if err := channel.clients.Range(func(_ uint64, value client) (error, bool) {
-		if channel.filters.Filter(value, data) {
-			value.Notify(data)
+		if !channel.filters.Filter(value, data) {
+			return nil, false
+		}
+		if out == nil {
+			encoded, err := newOutgoing(data)
+			if err != nil {
+				return err, true
+			}
+			out = encoded
 		}
+		value.Notify(out)
 		return nil, false
 	}); err != nil {
 		return errors.Wrap(err, "write message to client")
@@ -52,8 +52,8 @@ func (c *testHeadClient) DetachFilters(msg Unsubscribe) error {
 	return nil
 }
 
-func (c *testHeadClient) Notify(msg any) {
-	c.ch <- msg
+func (c *testHeadClient) Notify(msg *outgoing) {
+	c.ch <- msg.msg
 }
 
 func (c *testHeadClient) WriteMessages(ctx context.Context, ws *websocket.Conn, log echo.Logger) {
@@ -7,9 +7,11 @@ import (
 	"context"
 	"io"
 	"net"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-io/workerpool"
 	"github.com/goccy/go-json"
 	"github.com/gorilla/websocket"
@@ -21,7 +23,7 @@ type client interface {
 	Id() uint64
 	ApplyFilters(msg Subscribe) error
 	DetachFilters(msg Unsubscribe) error
-	Notify(msg any)
+	Notify(msg *outgoing)
 	WriteMessages(ctx context.Context, ws *websocket.Conn, log echo.Logger)
 	ReadMessages(ctx context.Context, ws *websocket.Conn, sub *Client, log echo.Logger)
 	Filters() *Filters
@@ -38,25 +40,60 @@ const (
 	pingInterval = (pongWait * 9) / 10
 )
 
+// close reasons which are sent to client when server drops it
+const (
+	closeReasonSlowConsumer = "slow consumer"
+	closeReasonReplayFailed = "replay failed"
+)
+
 type Client struct {
 	id      uint64
 	manager *Manager
-	filters *Filters
-	ch      chan any
+	admin   bool
+	ch      chan *outgoing
 	g       workerpool.Group
 
+	// filters are replaced on every change and never mutated after publishing,
+	// because they are read by fan-out and replay goroutines
+	filters   *atomic.Pointer[Filters]
+	filtersMx *sync.Mutex
+
+	replayMx *sync.Mutex
+	replays  map[string]*replay
+
+	writeTimeout time.Duration
+	dropOnce     *sync.Once
+	dropped      chan struct{}
+	dropReason   string
+
 	closed *atomic.Bool
 }
 
 func newClient(id uint64, manager *Manager) *Client {
 	closed := new(atomic.Bool)
 	closed.Store(false)
+
+	filters := new(atomic.Pointer[Filters])
+	filters.Store(new(Filters))
+
+	queueSize, writeTimeout := defaultQueueSize, defaultWriteTimeout
+	if manager != nil {
+		queueSize, writeTimeout = manager.cfg.QueueSize, manager.cfg.WriteTimeout
+	}
+
 	return &Client{
-		id:      id,
-		manager: manager,
-		ch:      make(chan any, 1024),
-		g:       workerpool.NewGroup(),
-		closed:  closed,
+		id:           id,
+		manager:      manager,
+		ch:           make(chan *outgoing, queueSize),
+		g:            workerpool.NewGroup(),
+		filters:      filters,
+		filtersMx:    new(sync.Mutex),
+		replayMx:     new(sync.Mutex),
+		replays:      make(map[string]*replay),
+		writeTimeout: writeTimeout,
+		dropOnce:     new(sync.Once),
+		dropped:      make(chan struct{}),
+		closed:       closed,
 	}
 }
 
@@ -65,44 +102,204 @@ func (c *Client) Id() uint64 {
 }
 
 func (c *Client) Filters() *Filters {
-	return c.filters
+	return c.filters.Load()
+}
+
+// copyFilters - returns copy of current filters which can be changed and published
+func (c *Client) copyFilters() *Filters {
+	fltrs := *c.filters.Load()
+	return &fltrs
 }
 
 func (c *Client) ApplyFilters(msg Subscribe) error {
-	if c.filters == nil {
-		c.filters = &Filters{}
-	}
+	c.filtersMx.Lock()
+	defer c.filtersMx.Unlock()
+
+	next := c.copyFilters()
 	switch msg.Channel {
 	case ChannelHead:
-		c.filters.head = true
+		next.head = true
 	case ChannelBlocks:
-		c.filters.blocks = true
+		var fltrs BlockFilters
+		if len(msg.Filters) > 0 {
+			if err := json.Unmarshal(msg.Filters, &fltrs); err != nil {
+				return errors.Wrap(ErrUnavailableFilter, err.Error())
+			}
+		}
+		blockFilters, err := newBlockFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		next.blocks = true
+		next.blockFilters = blockFilters
+	case ChannelAlerts:
+		if !c.admin {
+			return ErrUnauthorized
+		}
+		var fltrs AlertFilters
+		if len(msg.Filters) > 0 {
+			if err := json.Unmarshal(msg.Filters, &fltrs); err != nil {
+				return errors.Wrap(ErrUnavailableFilter, err.Error())
+			}
+		}
+		next.alerts = true
+		next.watchlists = make(map[uint64]struct{}, len(fltrs.Watchlists))
+		for i := range fltrs.Watchlists {
+			next.watchlists[fltrs.Watchlists[i]] = struct{}{}
+		}
+	case ChannelTxs:
+		var fltrs TransactionFilters
+		if len(msg.Filters) > 0 {
+			if err := json.Unmarshal(msg.Filters, &fltrs); err != nil {
+				return errors.Wrap(ErrUnavailableFilter, err.Error())
+			}
+		}
+		txFilters, err := newTxFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		next.txs = true
+		next.txFilters = txFilters
+	case ChannelActions:
+		var fltrs ActionFilters
+		if len(msg.Filters) > 0 {
+			if err := json.Unmarshal(msg.Filters, &fltrs); err != nil {
+				return errors.Wrap(ErrUnavailableFilter, err.Error())
+			}
+		}
+		actionFilters, err := newActionFilters(fltrs)
+		if err != nil {
+			return err
+		}
+		next.actions = true
+		next.actionFilters = actionFilters
+	case ChannelRollup:
+		var fltrs RollupFilters
+		if len(msg.Filters) > 0 {
+			if err := json.Unmarshal(msg.Filters, &fltrs); err != nil {
+				return errors.Wrap(ErrUnavailableFilter, err.Error())
+			}
+		}
+		rollups, err := newRollupSet(fltrs)
+		if err != nil {
+			return err
+		}
+		next.rollup = true
+		next.rollups = rollups
+		next.rollupWithData = fltrs.WithData
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
+	c.filters.Store(next)
 	return nil
 }
 
 func (c *Client) DetachFilters(msg Unsubscribe) error {
-	if c.filters == nil {
-		return nil
-	}
+	c.setReplay(msg.Channel, nil)
+
+	c.filtersMx.Lock()
+	defer c.filtersMx.Unlock()
+
+	next := c.copyFilters()
 	switch msg.Channel {
 	case ChannelHead:
-		c.filters.head = false
+		next.head = false
 	case ChannelBlocks:
-		c.filters.blocks = false
+		next.blocks = false
+		next.blockFilters = nil
+	case ChannelAlerts:
+		next.alerts = false
+		next.watchlists = nil
+	case ChannelTxs:
+		next.txs = false
+		next.txFilters = nil
+	case ChannelActions:
+		next.actions = false
+		next.actionFilters = nil
+	case ChannelRollup:
+		next.rollup = false
+		next.rollups = nil
+		next.rollupWithData = false
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
+	c.filters.Store(next)
 	return nil
 }
 
-func (c *Client) Notify(msg any) {
-	if c.closed.Load() {
+// Notify - puts message to the client queue without blocking. If the queue is full the client is dropped.
+func (c *Client) Notify(msg *outgoing) {
+	if c.closed.Load() || c.isDropped() {
+		return
+	}
+	if channel, height, ok := messageHeight(msg.msg); ok {
+		if r := c.getReplay(channel); r != nil {
+			if !r.push(msg, height, c.enqueue) {
+				c.drop(closeReasonSlowConsumer)
+			}
+			return
+		}
+	}
+	c.enqueue(msg)
+}
+
+func (c *Client) enqueue(msg *outgoing) {
+	select {
+	case c.ch <- msg:
+	default:
+		c.drop(closeReasonSlowConsumer)
+	}
+}
+
+// drop - disconnects the client with the close reason. Only the first call has effect.
+func (c *Client) drop(reason string) {
+	c.dropOnce.Do(func() {
+		c.dropReason = reason
+		close(c.dropped)
+		if c.manager != nil {
+			c.manager.metrics.dropped.WithLabelValues(reason).Inc()
+		}
+	})
+}
+
+func (c *Client) isDropped() bool {
+	select {
+	case <-c.dropped:
+		return true
+	default:
+		return false
+	}
+}
+
+// send - sends message blocking until there is space in the queue or context is canceled
+func (c *Client) send(ctx context.Context, msg *outgoing) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case c.ch <- msg:
+		return nil
+	}
+}
+
+func (c *Client) getReplay(channel string) *replay {
+	c.replayMx.Lock()
+	defer c.replayMx.Unlock()
+	return c.replays[channel]
+}
+
+// setReplay - sets replay state of the channel and cancels the previous one. Passing nil removes the state.
+func (c *Client) setReplay(channel string, r *replay) {
+	c.replayMx.Lock()
+	defer c.replayMx.Unlock()
+
+	if prev, ok := c.replays[channel]; ok && prev.cancel != nil {
+		prev.cancel()
+	}
+	if r == nil {
+		delete(c.replays, channel)
 		return
 	}
-	c.ch <- msg
+	c.replays[channel] = r
 }
 
 func (c *Client) Close() error {
@@ -122,11 +319,20 @@ func (c *Client) writeThread(ctx context.Context, ws *websocket.Conn, log echo.L
 			return
 
 		case <-ticker.C:
-			if err := ws.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+			if err := ws.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(c.writeTimeout)); err != nil {
 				log.Errorf("writemsg: %s", err)
+				c.interruptReading(ws)
 				return
 			}
 
+		case <-c.dropped:
+			msg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, c.dropReason)
+			if err := ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(c.writeTimeout)); err != nil {
+				log.Errorf("send close message: %s", err)
+			}
+			c.interruptReading(ws)
+			return
+
 		case msg, ok := <-c.ch:
 			if !ok {
 				if err := ws.WriteMessage(websocket.CloseMessage, nil); err != nil {
@@ -135,13 +341,23 @@ func (c *Client) writeThread(ctx context.Context, ws *websocket.Conn, log echo.L
 				return
 			}
 
-			if err := ws.WriteJSON(msg); err != nil {
+			if err := ws.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil {
+				log.Errorf("set write deadline: %s", err)
+			}
+			if err := ws.WritePreparedMessage(msg.data); err != nil {
 				log.Errorf("send client message: %s", err)
+				c.interruptReading(ws)
+				return
 			}
 		}
 	}
 }
 
+// interruptReading - unblocks reading thread when connection can't be used anymore
+func (c *Client) interruptReading(ws *websocket.Conn) {
+	_ = ws.SetReadDeadline(time.Now())
+}
+
 func (c *Client) WriteMessages(ctx context.Context, ws *websocket.Conn, log echo.Logger) {
 	c.g.GoCtx(ctx, func(ctx context.Context) {
 		c.writeThread(ctx, ws, log)
@@ -161,6 +377,8 @@ func (c *Client) ReadMessages(ctx context.Context, ws *websocket.Conn, sub *Clie
 		select {
 		case <-ctx.Done():
 			return
+		case <-c.dropped:
+			return
 		default:
 			if err := c.read(ctx, ws); err != nil {
 				timeoutErr, ok := err.(net.Error)
@@ -172,13 +390,7 @@ func (c *Client) ReadMessages(ctx context.Context, ws *websocket.Conn, sub *Clie
 					return
 				case ok && timeoutErr.Timeout():
 					return
-				case websocket.IsCloseError(err,
-					websocket.CloseNormalClosure,
-					websocket.CloseAbnormalClosure,
-					websocket.CloseNoStatusReceived,
-					websocket.CloseGoingAway):
-					c.manager.RemoveClientFromChannel(ChannelHead, c)
-					c.manager.RemoveClientFromChannel(ChannelBlocks, c)
+				case errors.As(err, new(*websocket.CloseError)):
 					return
 				}
 				log.Errorf("read websocket message: %s", err.Error())
@@ -213,7 +425,20 @@ func (c *Client) handleSubscribeMessage(ctx context.Context, msg Message) error
 		return err
 	}
 
+	if subscribeMsg.FromHeight == 0 {
+		c.setReplay(subscribeMsg.Channel, nil)
+		c.manager.AddClientToChannel(subscribeMsg.Channel, c)
+		return nil
+	}
+
+	replayCtx, r, err := c.manager.startReplay(ctx, c, subscribeMsg.Channel, pkgTypes.Level(subscribeMsg.FromHeight))
+	if err != nil {
+		return err
+	}
 	c.manager.AddClientToChannel(subscribeMsg.Channel, c)
+	c.g.GoCtx(replayCtx, func(ctx context.Context) {
+		c.manager.replay(ctx, c, subscribeMsg.Channel, r)
+	})
 	return nil
 }
 
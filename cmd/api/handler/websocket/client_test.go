@@ -4,8 +4,14 @@
 package websocket
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,5 +19,144 @@ func TestNotifyClosedClient(t *testing.T) {
 	client := newClient(10, nil)
 	err := client.Close()
 	require.NoError(t, err, "closing client")
-	client.Notify("test")
+	client.Notify(&outgoing{msg: "test"})
+}
+
+func TestSlowClientDropped(t *testing.T) {
+	manager := NewManager(Config{QueueSize: 2}, nil, nil, nil, nil, nil)
+	client := newClient(1, manager)
+
+	for i := 0; i < 3; i++ {
+		client.Notify(&outgoing{msg: i})
+	}
+	require.True(t, client.isDropped())
+	require.Equal(t, closeReasonSlowConsumer, client.dropReason)
+	require.Len(t, client.ch, 2)
+
+	client.Notify(&outgoing{msg: 4})
+	require.Len(t, client.ch, 2)
+
+	total, max := manager.queueDepth()
+	require.Zero(t, total, "client is not registered in manager")
+	require.Zero(t, max)
+
+	manager.clients.Set(client.id, client)
+	total, max = manager.queueDepth()
+	require.Equal(t, 2, total)
+	require.Equal(t, 2, max)
+}
+
+func TestChannelSharesEncodedMessage(t *testing.T) {
+	channel := NewChannel[storage.Block, *responses.Block](
+		blockProcessor,
+		BlockFilter{},
+	)
+
+	first := newClient(1, nil)
+	first.filters.Store(&Filters{blocks: true})
+	second := newClient(2, nil)
+	second.filters.Store(&Filters{blocks: true})
+	skipped := newClient(3, nil)
+	skipped.filters.Store(&Filters{head: true})
+
+	channel.AddClient(first)
+	channel.AddClient(second)
+	channel.AddClient(skipped)
+
+	require.NoError(t, channel.processMessage(storage.Block{Height: 100}))
+	require.Len(t, first.ch, 1)
+	require.Len(t, second.ch, 1)
+	require.Len(t, skipped.ch, 0)
+
+	msg := <-first.ch
+	require.Same(t, msg, <-second.ch)
+	require.NotNil(t, msg.data)
+	notification, ok := msg.msg.(Notification[*responses.Block])
+	require.True(t, ok)
+	require.Equal(t, ChannelBlocks, notification.Channel)
+}
+
+func TestSubscribeWhileBroadcasting(t *testing.T) {
+	channel := NewChannel[storage.WatchlistAlert, *responses.WatchlistAlert](
+		alertProcessor,
+		AlertFilter{},
+	)
+
+	client := newClient(1, nil)
+	client.admin = true
+	channel.AddClient(client)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case <-client.ch:
+			default:
+				require.NoError(t, channel.processMessage(storage.WatchlistAlert{WatchlistId: 1}))
+			}
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, client.ApplyFilters(Subscribe{
+			Channel: ChannelAlerts,
+			Filters: json.RawMessage(`{"watchlists":[1,2]}`),
+		}))
+		require.NoError(t, client.DetachFilters(Unsubscribe{Channel: ChannelAlerts}))
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestManagerConnectionLimit(t *testing.T) {
+	manager := NewManager(Config{MaxConnectionsPerIp: 2}, nil, nil, nil, nil, nil)
+
+	require.True(t, manager.acquireConnection("127.0.0.1"))
+	require.True(t, manager.acquireConnection("127.0.0.1"))
+	require.False(t, manager.acquireConnection("127.0.0.1"))
+	require.True(t, manager.acquireConnection("127.0.0.2"))
+
+	manager.releaseConnection("127.0.0.1")
+	require.True(t, manager.acquireConnection("127.0.0.1"))
+
+	manager.releaseConnection("127.0.0.2")
+	require.NotContains(t, manager.connections, "127.0.0.2")
+}
+
+func TestAlertsRequireApiKey(t *testing.T) {
+	manager := NewManager(Config{ApiKey: "secret"}, nil, nil, nil, nil, nil)
+
+	for _, key := range []string{"", "invalid"} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/ws", nil)
+		req.Header.Set(apiKeyHeader, key)
+		require.False(t, manager.isAdmin(req), key)
+	}
+
+	anonymous := newClient(1, manager)
+	err := anonymous.ApplyFilters(Subscribe{Channel: ChannelAlerts})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.False(t, anonymous.Filters().alerts)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/ws", nil)
+	req.Header.Set(apiKeyHeader, "secret")
+	admin := newClient(2, manager)
+	admin.admin = manager.isAdmin(req)
+	require.True(t, admin.admin)
+
+	err = admin.ApplyFilters(Subscribe{
+		Channel: ChannelAlerts,
+		Filters: json.RawMessage(`{"watchlists":[1]}`),
+	})
+	require.NoError(t, err)
+	require.True(t, admin.Filters().alerts)
+	require.Contains(t, admin.Filters().watchlists, uint64(1))
+
+	disabled := NewManager(Config{}, nil, nil, nil, nil, nil)
+	req.Header.Set(apiKeyHeader, "")
+	require.False(t, disabled.isAdmin(req), "alerts are disabled without api key")
 }
@@ -9,4 +9,6 @@ var (
 	ErrUnknownMethod     = errors.New("unknown method")
 	ErrUnknownChannel    = errors.New("unknown channel")
 	ErrUnavailableFilter = errors.New("unknown filter value")
+	ErrTooManyConnects   = errors.New("too many connections from the address")
+	ErrUnauthorized      = errors.New("valid api key is required for the channel")
 )
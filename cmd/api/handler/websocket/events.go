@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/goccy/go-json"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// eventsHeartbeat - interval of comments which are sent to keep connection alive through proxies
+	eventsHeartbeat = 15 * time.Second
+
+	headerLastEventId = "Last-Event-ID"
+)
+
+type eventsRequest struct {
+	channels []string
+	blocks   BlockFilters
+
+	// lastEventId - height of the last received block. Zero if the stream is not resumed.
+	lastEventId uint64
+}
+
+func parseEventsRequest(c echo.Context) (eventsRequest, error) {
+	req := eventsRequest{
+		channels: []string{ChannelHead, ChannelBlocks},
+		blocks: BlockFilters{
+			Actions:   splitQueryParam(c.QueryParam("action_types")),
+			Proposers: splitQueryParam(c.QueryParam("proposers")),
+		},
+	}
+
+	if channels := splitQueryParam(c.QueryParam("channels")); len(channels) > 0 {
+		for i := range channels {
+			if channels[i] != ChannelHead && channels[i] != ChannelBlocks {
+				return req, errors.Wrapf(ErrUnknownChannel, "%s is not available in events stream", channels[i])
+			}
+		}
+		req.channels = channels
+	}
+
+	// browsers can't set headers for the first request so query parameter is supported too
+	lastEventId := c.Request().Header.Get(headerLastEventId)
+	if lastEventId == "" {
+		lastEventId = c.QueryParam("last_event_id")
+	}
+	if lastEventId != "" {
+		id, err := strconv.ParseUint(lastEventId, 10, 64)
+		if err != nil {
+			return req, errors.Wrap(ErrUnavailableFilter, "last event id should be block height")
+		}
+		req.lastEventId = id
+	}
+	return req, nil
+}
+
+func splitQueryParam(value string) []string {
+	if value == "" {
+		return nil
+	}
+	result := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// HandleEvents godoc
+//
+//	@Summary		Server-sent events stream
+//	@Description	Stream of notifications of `head` and `blocks` channels in server-sent events format. Data of every event is the same notification as in websocket API. Block events have identity equal to block height. Pass the height in `Last-Event-ID` header (or `last_event_id` parameter) to resume the stream: missed blocks are sent before live ones.
+//	@Tags			websocket
+//	@ID				events
+//	@Param			channels		query	string	false	"Comma-separated list of channels: head, blocks. All channels by default"
+//	@Param			action_types	query	string	false	"Comma-separated list of action types. Blocks containing at least one action of the types are sent"
+//	@Param			proposers		query	string	false	"Comma-separated list of proposer consensus addresses"
+//	@Param			last_event_id	query	integer	false	"Height of the last received block"	minimum(1)
+//	@Param			Last-Event-ID	header	integer	false	"Height of the last received block"	minimum(1)
+//	@Produce		text/event-stream
+//	@Success		200
+//	@Failure		400	{object}	echo.HTTPError
+//	@Failure		429	{object}	echo.HTTPError
+//	@Router			/v1/events [get]
+func (manager *Manager) HandleEvents(c echo.Context) error {
+	req, err := parseEventsRequest(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ip := c.RealIP()
+	if !manager.acquireConnection(ip) {
+		manager.metrics.connections.WithLabelValues("rejected").Inc()
+		return echo.NewHTTPError(http.StatusTooManyRequests, ErrTooManyConnects.Error())
+	}
+	defer manager.releaseConnection(ip)
+
+	sub := newClient(manager.clientId.Add(1), manager)
+	for _, channel := range req.channels {
+		msg := Subscribe{Channel: channel}
+		if channel == ChannelBlocks {
+			filters, err := json.Marshal(req.blocks)
+			if err != nil {
+				return err
+			}
+			msg.Filters = filters
+		}
+		if err := sub.ApplyFilters(msg); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	var (
+		replayCtx context.Context
+		r         *replay
+	)
+	if req.lastEventId > 0 && sub.Filters().blocks {
+		replayCtx, r, err = manager.startReplay(ctx, sub, ChannelBlocks, pkgTypes.Level(req.lastEventId+1))
+		if err != nil {
+			if errors.Is(err, ErrUnavailableFilter) {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			return err
+		}
+	}
+
+	manager.metrics.connections.WithLabelValues("accepted").Inc()
+	manager.clients.Set(sub.id, sub)
+	for _, channel := range req.channels {
+		manager.AddClientToChannel(channel, sub)
+	}
+	if r != nil {
+		sub.g.GoCtx(replayCtx, func(ctx context.Context) {
+			manager.replay(ctx, sub, ChannelBlocks, r)
+		})
+	}
+
+	if err := sub.writeEvents(ctx, c.Response()); err != nil {
+		log.Debug().Err(err).Uint64("client", sub.id).Msg("write events")
+	}
+
+	manager.removeClient(sub)
+	cancel()
+	return sub.Close()
+}
+
+// writeEvents - writes notifications from the client queue to the response in server-sent events format
+func (c *Client) writeEvents(ctx context.Context, w *echo.Response) error {
+	header := w.Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	rc := http.NewResponseController(w)
+	write := func(data []byte) error {
+		if err := rc.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+
+	ticker := time.NewTicker(eventsHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			if err := write([]byte(": ping\n\n")); err != nil {
+				return err
+			}
+
+		case <-c.dropped:
+			data, err := json.Marshal(echo.Map{"message": c.dropReason})
+			if err != nil {
+				return err
+			}
+			return write(formatEvent("error", "", data))
+
+		case msg, ok := <-c.ch:
+			if !ok {
+				return nil
+			}
+			if err := write(newEvent(msg)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// newEvent - returns server-sent event of the notification. Only block events have identity to resume the stream from the height.
+func newEvent(msg *outgoing) []byte {
+	var name, id string
+	if n, ok := msg.msg.(interface{ channelName() string }); ok {
+		name = n.channelName()
+	}
+	if channel, height, ok := messageHeight(msg.msg); ok && channel == ChannelBlocks {
+		id = strconv.FormatUint(uint64(height), 10)
+	}
+	return formatEvent(name, id, msg.raw)
+}
+
+func formatEvent(name, id string, data []byte) []byte {
+	var buf bytes.Buffer
+	if id != "" {
+		buf.WriteString("id: ")
+		buf.WriteString(id)
+		buf.WriteByte('\n')
+	}
+	if name != "" {
+		buf.WriteString("event: ")
+		buf.WriteString(name)
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("data: ")
+	buf.Write(data)
+	buf.WriteString("\n\n")
+	return buf.Bytes()
+}
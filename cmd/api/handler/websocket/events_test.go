@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseEventsRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		header  string
+		want    eventsRequest
+		wantErr bool
+	}{
+		{
+			name:  "default",
+			query: "",
+			want: eventsRequest{
+				channels: []string{ChannelHead, ChannelBlocks},
+			},
+		}, {
+			name:   "filters and header",
+			query:  "channels=blocks&action_types=sequence,transfer&proposers=E641C7A2C964833E556AEF934FBF166B712874B6",
+			header: "100",
+			want: eventsRequest{
+				channels: []string{ChannelBlocks},
+				blocks: BlockFilters{
+					Actions:   []string{"sequence", "transfer"},
+					Proposers: []string{"E641C7A2C964833E556AEF934FBF166B712874B6"},
+				},
+				lastEventId: 100,
+			},
+		}, {
+			name:  "last event id in query",
+			query: "channels=blocks&last_event_id=10",
+			want: eventsRequest{
+				channels:    []string{ChannelBlocks},
+				lastEventId: 10,
+			},
+		}, {
+			name:    "unknown channel",
+			query:   "channels=txs",
+			wantErr: true,
+		}, {
+			name:    "invalid last event id",
+			query:   "",
+			header:  "abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events?"+tt.query, nil)
+			if tt.header != "" {
+				req.Header.Set(headerLastEventId, tt.header)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			got, err := parseEventsRequest(c)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandleEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blocks := mock.NewMockIBlock(ctrl)
+	manager := NewManager(Config{}, nil, blocks, nil, nil, nil)
+
+	blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 10}, nil).
+		Times(1)
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(10), true).
+		Return(storage.Block{Height: 10, Time: time.Now()}, nil).
+		Times(1)
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(11), true).
+		Return(storage.Block{}, sql.ErrNoRows).
+		Times(1)
+	blocks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	e := echo.New()
+	e.GET("/events", manager.HandleEvents)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events?channels=blocks", nil)
+	require.NoError(t, err)
+	req.Header.Set(headerLastEventId, "9")
+
+	response, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "text/event-stream", response.Header.Get(echo.HeaderContentType))
+
+	reader := bufio.NewReader(response.Body)
+	readEvent := func() []string {
+		lines := make([]string, 0)
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				return lines
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	event := readEvent()
+	require.Len(t, event, 3)
+	require.Equal(t, "id: 10", event[0])
+	require.Equal(t, "event: blocks", event[1])
+	require.Contains(t, event[2], `"height":10`)
+
+	require.NoError(t, manager.blocks.processMessage(storage.Block{Height: 11, Time: time.Now()}))
+
+	event = readEvent()
+	require.Len(t, event, 3)
+	require.Equal(t, "id: 11", event[0])
+	require.Contains(t, event[2], `"channel":"blocks"`)
+}
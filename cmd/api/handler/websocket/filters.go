@@ -4,7 +4,13 @@
 package websocket
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/pkg/errors"
 )
 
 type Filterable[M INotification] interface {
@@ -31,13 +37,294 @@ func (f BlockFilter) Filter(c client, msg Notification[*responses.Block]) bool {
 		return false
 	}
 	fltrs := c.Filters()
-	if fltrs == nil {
+	if fltrs == nil || !fltrs.blocks {
+		return false
+	}
+	if fltrs.blockFilters == nil {
+		return true
+	}
+	return fltrs.blockFilters.match(*msg.Body)
+}
+
+type AlertFilter struct{}
+
+func (f AlertFilter) Filter(c client, msg Notification[*responses.WatchlistAlert]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || !fltrs.alerts {
+		return false
+	}
+	if len(fltrs.watchlists) == 0 {
+		return true
+	}
+	_, ok := fltrs.watchlists[msg.Body.WatchlistId]
+	return ok
+}
+
+type TxFilter struct{}
+
+func (f TxFilter) Filter(c client, msg Notification[*responses.Tx]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || !fltrs.txs || fltrs.txFilters == nil {
+		return false
+	}
+	return fltrs.txFilters.match(*msg.Body)
+}
+
+type ActionFilter struct{}
+
+func (f ActionFilter) Filter(c client, msg Notification[*responses.Action]) bool {
+	if msg.Body == nil {
 		return false
 	}
-	return fltrs.blocks
+	fltrs := c.Filters()
+	if fltrs == nil || !fltrs.actions || fltrs.actionFilters == nil {
+		return false
+	}
+	return fltrs.actionFilters.match(*msg.Body)
+}
+
+type RollupFilter struct{}
+
+func (f RollupFilter) Filter(c client, msg Notification[*RollupMessage]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil || !fltrs.rollup {
+		return false
+	}
+	if msg.Body.Type == RollupMessageEndOfBlock {
+		return true
+	}
+	_, ok := fltrs.rollups[msg.Body.Rollup]
+	return ok
 }
 
 type Filters struct {
-	head   bool
-	blocks bool
+	head    bool
+	blocks  bool
+	alerts  bool
+	txs     bool
+	actions bool
+	rollup  bool
+
+	watchlists     map[uint64]struct{}
+	blockFilters   *blockFilters
+	txFilters      *txFilters
+	actionFilters  *actionFilters
+	rollups        map[string]struct{}
+	rollupWithData bool
+}
+
+// newRollupSet - returns set of base64url encoded rollup ids in canonical form
+func newRollupSet(fltrs RollupFilters) (map[string]struct{}, error) {
+	if len(fltrs.Rollups) == 0 {
+		return nil, errors.Wrap(ErrUnavailableFilter, "at least one rollup is required")
+	}
+	result := make(map[string]struct{}, len(fltrs.Rollups))
+	for i := range fltrs.Rollups {
+		id, err := base64.URLEncoding.DecodeString(fltrs.Rollups[i])
+		if err != nil || len(id) == 0 {
+			return nil, errors.Wrapf(ErrUnavailableFilter, "invalid rollup: %s", fltrs.Rollups[i])
+		}
+		result[base64.URLEncoding.EncodeToString(id)] = struct{}{}
+	}
+	return result, nil
+}
+
+type blockFilters struct {
+	mask      types.ActionTypeMask
+	proposers map[string]struct{}
+}
+
+func newBlockFilters(fltrs BlockFilters) (*blockFilters, error) {
+	result := &blockFilters{
+		mask:      types.NewActionTypeMask(),
+		proposers: make(map[string]struct{}, len(fltrs.Proposers)),
+	}
+	for i := range fltrs.Actions {
+		actionType, err := types.ParseActionType(fltrs.Actions[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, err.Error())
+		}
+		result.mask.SetType(actionType)
+	}
+	for i := range fltrs.Proposers {
+		if _, err := hex.DecodeString(fltrs.Proposers[i]); err != nil || fltrs.Proposers[i] == "" {
+			return nil, errors.Wrapf(ErrUnavailableFilter, "invalid proposer: %s", fltrs.Proposers[i])
+		}
+		result.proposers[strings.ToLower(fltrs.Proposers[i])] = struct{}{}
+	}
+	return result, nil
+}
+
+// match - block is matched by action type if it contains at least one action of the type
+func (f *blockFilters) match(block responses.Block) bool {
+	if !f.mask.Empty() && f.mask.Bits&types.NewActionTypeMask(block.ActionTypes...).Bits == 0 {
+		return false
+	}
+	if len(f.proposers) > 0 {
+		if block.Proposer == nil {
+			return false
+		}
+		if _, ok := f.proposers[strings.ToLower(block.Proposer.ConsAddress)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type txFilters struct {
+	status map[types.Status]struct{}
+	entityFilters
+}
+
+func newTxFilters(fltrs TransactionFilters) (*txFilters, error) {
+	result := &txFilters{
+		status: make(map[types.Status]struct{}, len(fltrs.Status)),
+	}
+	for i := range fltrs.Status {
+		status, err := types.ParseStatus(fltrs.Status[i])
+		if err != nil {
+			return nil, errors.Wrap(ErrUnavailableFilter, err.Error())
+		}
+		result.status[status] = struct{}{}
+	}
+	entity, err := newEntityFilters(fltrs.Actions, fltrs.Addresses, fltrs.Rollups)
+	if err != nil {
+		return nil, err
+	}
+	result.entityFilters = entity
+	return result, nil
+}
+
+// match - transaction is matched by address if it's signer or if address is mentioned in data of one of its actions.
+// Transaction is matched by rollup if one of its actions refers to the rollup.
+func (f *txFilters) match(tx responses.Tx) bool {
+	if len(f.status) > 0 {
+		if _, ok := f.status[tx.Status]; !ok {
+			return false
+		}
+	}
+	if !f.mask.Empty() && f.mask.Bits&types.NewActionTypeMask(tx.ActionTypes...).Bits == 0 {
+		return false
+	}
+	if len(f.addresses) > 0 {
+		_, ok := f.addresses[strings.ToLower(tx.Signer)]
+		for i := 0; i < len(tx.Actions) && !ok; i++ {
+			ok = f.hasAddress(tx.Actions[i].Data)
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(f.rollups) > 0 {
+		var ok bool
+		for i := 0; i < len(tx.Actions) && !ok; i++ {
+			ok = f.hasRollup(tx.Actions[i].Data)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type actionFilters struct {
+	entityFilters
+}
+
+func newActionFilters(fltrs ActionFilters) (*actionFilters, error) {
+	entity, err := newEntityFilters(fltrs.Actions, fltrs.Addresses, fltrs.Rollups)
+	if err != nil {
+		return nil, err
+	}
+	return &actionFilters{entity}, nil
+}
+
+// match - action is matched by address if address is mentioned in action data
+func (f *actionFilters) match(action responses.Action) bool {
+	if !f.mask.Empty() && f.mask.Bits&types.NewActionTypeMask(action.Type.String()).Bits == 0 {
+		return false
+	}
+	if len(f.addresses) > 0 && !f.hasAddress(action.Data) {
+		return false
+	}
+	if len(f.rollups) > 0 && !f.hasRollup(action.Data) {
+		return false
+	}
+	return true
+}
+
+type entityFilters struct {
+	mask      types.ActionTypeMask
+	addresses map[string]struct{}
+	rollups   map[string]struct{}
+}
+
+func newEntityFilters(actionTypes, addresses, rollups []string) (entityFilters, error) {
+	result := entityFilters{
+		mask:      types.NewActionTypeMask(),
+		addresses: make(map[string]struct{}, len(addresses)),
+		rollups:   make(map[string]struct{}, len(rollups)),
+	}
+	for i := range actionTypes {
+		actionType, err := types.ParseActionType(actionTypes[i])
+		if err != nil {
+			return result, errors.Wrap(ErrUnavailableFilter, err.Error())
+		}
+		result.mask.SetType(actionType)
+	}
+	for i := range addresses {
+		if _, err := hex.DecodeString(addresses[i]); err != nil || addresses[i] == "" {
+			return result, errors.Wrapf(ErrUnavailableFilter, "invalid address: %s", addresses[i])
+		}
+		result.addresses[strings.ToLower(addresses[i])] = struct{}{}
+	}
+	for i := range rollups {
+		id, err := base64.URLEncoding.DecodeString(rollups[i])
+		if err != nil {
+			return result, errors.Wrapf(ErrUnavailableFilter, "invalid rollup: %s", rollups[i])
+		}
+		result.rollups[string(id)] = struct{}{}
+	}
+	return result, nil
+}
+
+func (f entityFilters) hasAddress(data map[string]any) bool {
+	for _, value := range data {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if _, ok := f.addresses[strings.ToLower(str)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRollup - rollup id is stored as bytes which become base64 string after reading from database
+func (f entityFilters) hasRollup(data map[string]any) bool {
+	var id []byte
+	switch value := data["rollup_id"].(type) {
+	case []byte:
+		id = value
+	case string:
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return false
+		}
+		id = decoded
+	default:
+		return false
+	}
+	_, ok := f.rollups[string(id)]
+	return ok
 }
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSequenceAction = responses.Action{
+		Type: types.ActionTypeSequence,
+		Data: map[string]any{
+			"rollup_id": "GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=",
+			"data":      "AQIDBA==",
+		},
+	}
+	testTransferAction = responses.Action{
+		Type: types.ActionTypeTransfer,
+		Data: map[string]any{
+			"to":     "230592632006db2733444bb6de11db3f4b2f9ae4",
+			"amount": "1000",
+		},
+	}
+	testFilterTx = responses.Tx{
+		Status:      types.StatusSuccess,
+		Signer:      "2e046327a2ccac7c8f8018ed44e43184b502eb3e",
+		ActionTypes: []string{"sequence", "transfer"},
+		Actions:     []responses.Action{testSequenceAction, testTransferAction},
+	}
+)
+
+func TestTxFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters TransactionFilters
+		want    bool
+	}{
+		{
+			name: "empty",
+			want: true,
+		}, {
+			name:    "status",
+			filters: TransactionFilters{Status: []string{"success"}},
+			want:    true,
+		}, {
+			name:    "wrong status",
+			filters: TransactionFilters{Status: []string{"failed"}},
+		}, {
+			name:    "action type",
+			filters: TransactionFilters{Actions: []string{"transfer", "mint"}},
+			want:    true,
+		}, {
+			name:    "wrong action type",
+			filters: TransactionFilters{Actions: []string{"mint"}},
+		}, {
+			name:    "signer",
+			filters: TransactionFilters{Addresses: []string{"2E046327A2CCAC7C8F8018ED44E43184B502EB3E"}},
+			want:    true,
+		}, {
+			name:    "receiver",
+			filters: TransactionFilters{Addresses: []string{"230592632006db2733444bb6de11db3f4b2f9ae4"}},
+			want:    true,
+		}, {
+			name:    "unknown address",
+			filters: TransactionFilters{Addresses: []string{"115f94d8c98ffd73fe65182611140f0edc7c3c94"}},
+		}, {
+			name:    "rollup",
+			filters: TransactionFilters{Rollups: []string{"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk="}},
+			want:    true,
+		}, {
+			name: "all",
+			filters: TransactionFilters{
+				Status:    []string{"success"},
+				Actions:   []string{"sequence"},
+				Addresses: []string{"230592632006db2733444bb6de11db3f4b2f9ae4"},
+				Rollups:   []string{"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk="},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newTxFilters(tt.filters)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fltrs.match(testFilterTx))
+		})
+	}
+}
+
+func TestActionFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filters  ActionFilters
+		sequence bool
+		transfer bool
+	}{
+		{
+			name:     "empty",
+			sequence: true,
+			transfer: true,
+		}, {
+			name:     "action type",
+			filters:  ActionFilters{Actions: []string{"sequence"}},
+			sequence: true,
+		}, {
+			name:     "address",
+			filters:  ActionFilters{Addresses: []string{"230592632006DB2733444BB6DE11DB3F4B2F9AE4"}},
+			transfer: true,
+		}, {
+			name:     "rollup",
+			filters:  ActionFilters{Rollups: []string{"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk="}},
+			sequence: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newActionFilters(tt.filters)
+			require.NoError(t, err)
+			require.Equal(t, tt.sequence, fltrs.match(testSequenceAction))
+			require.Equal(t, tt.transfer, fltrs.match(testTransferAction))
+		})
+	}
+}
+
+func TestApplyFilters(t *testing.T) {
+	client := newClient(1, nil)
+
+	err := client.ApplyFilters(Subscribe{
+		Channel: ChannelTxs,
+		Filters: json.RawMessage(`{"status":["success"],"action_type":["transfer"]}`),
+	})
+	require.NoError(t, err)
+	require.True(t, client.Filters().txs)
+	require.NotNil(t, client.Filters().txFilters)
+
+	err = client.ApplyFilters(Subscribe{Channel: ChannelActions})
+	require.NoError(t, err)
+	require.True(t, client.Filters().actions)
+
+	for _, filters := range []string{
+		`{"status":["unknown"]}`,
+		`{"action_type":["unknown"]}`,
+		`{"addresses":["invalid"]}`,
+		`{"rollups":["%%%"]}`,
+	} {
+		err := client.ApplyFilters(Subscribe{
+			Channel: ChannelTxs,
+			Filters: json.RawMessage(filters),
+		})
+		require.ErrorIs(t, err, ErrUnavailableFilter, filters)
+	}
+
+	err = client.DetachFilters(Unsubscribe{Channel: ChannelTxs})
+	require.NoError(t, err)
+	require.False(t, client.Filters().txs)
+	require.Nil(t, client.Filters().txFilters)
+}
+
+func TestBlockFilter(t *testing.T) {
+	block := responses.Block{
+		ActionTypes: []string{"sequence"},
+		Proposer: &responses.ShortValidator{
+			ConsAddress: "E641C7A2C964833E556AEF934FBF166B712874B6",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filters BlockFilters
+		want    bool
+	}{
+		{
+			name: "empty",
+			want: true,
+		}, {
+			name:    "action type",
+			filters: BlockFilters{Actions: []string{"sequence", "transfer"}},
+			want:    true,
+		}, {
+			name:    "wrong action type",
+			filters: BlockFilters{Actions: []string{"transfer"}},
+		}, {
+			name:    "proposer",
+			filters: BlockFilters{Proposers: []string{"e641c7a2c964833e556aef934fbf166b712874b6"}},
+			want:    true,
+		}, {
+			name:    "wrong proposer",
+			filters: BlockFilters{Proposers: []string{"115f94d8c98ffd73fe65182611140f0edc7c3c94"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltrs, err := newBlockFilters(tt.filters)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fltrs.match(block))
+		})
+	}
+
+	_, err := newBlockFilters(BlockFilters{Proposers: []string{"invalid"}})
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+}
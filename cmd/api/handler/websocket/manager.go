@@ -5,8 +5,11 @@ package websocket
 
 import (
 	"context"
+	"crypto/subtle"
 	"net/http"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dipdup-io/workerpool"
 	sdkSync "github.com/dipdup-net/indexer-sdk/pkg/sync"
@@ -16,22 +19,81 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultQueueSize           = 1024
+	defaultMaxConnectionsPerIp = 10
+	defaultWriteTimeout        = 10 * time.Second
+
+	apiKeyHeader = "X-API-Key"
+)
+
+// Config - limits of websocket server. Zero values are replaced with defaults.
+type Config struct {
+	// QueueSize - maximum count of messages waiting to be sent to one client. The client is dropped on overflow.
+	QueueSize int
+	// MaxConnectionsPerIp - maximum count of simultaneous connections from one IP address
+	MaxConnectionsPerIp int
+	// WriteTimeout - timeout of writing one message to client
+	WriteTimeout time.Duration
+	// ApiKey - administrative API key which owns watchlists. Only connections passing it in `X-API-Key` header
+	// can subscribe to alerts. Alerts channel is unavailable if it's empty.
+	ApiKey string
+}
+
 type Manager struct {
 	upgrader websocket.Upgrader
 	clientId *atomic.Uint64
 	clients  *sdkSync.Map[uint64, *Client]
 	observer *bus.Observer
+	cfg      Config
+	metrics  *metrics
 
-	head   *Channel[storage.State, *responses.State]
-	blocks *Channel[storage.Block, *responses.Block]
+	connectionsMx *sync.Mutex
+	connections   map[string]int
+
+	blockStorage  storage.IBlock
+	txStorage     storage.ITx
+	actionStorage storage.IAction
+	rollupStorage storage.IRollup
+
+	head    *Channel[storage.State, *responses.State]
+	blocks  *Channel[storage.Block, *responses.Block]
+	alerts  *Channel[storage.WatchlistAlert, *responses.WatchlistAlert]
+	txs     *Channel[storage.Tx, *responses.Tx]
+	actions *Channel[storage.ActionWithTx, *responses.Action]
+
+	rollup     *Channel[rollupEvent, *RollupMessage]
+	rollupData *Channel[rollupEvent, *RollupMessage]
+
+	// rollupBlocks - queue of blocks which rollup actions are loaded from the database.
+	// Loading is done out of the main loop, so slow queries don't delay other channels.
+	rollupBlocks chan *storage.Block
 
 	g workerpool.Group
 }
 
-func NewManager(observer *bus.Observer) *Manager {
+func NewManager(
+	cfg Config,
+	observer *bus.Observer,
+	blocks storage.IBlock,
+	txs storage.ITx,
+	actions storage.IAction,
+	rollups storage.IRollup,
+) *Manager {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	if cfg.MaxConnectionsPerIp <= 0 {
+		cfg.MaxConnectionsPerIp = defaultMaxConnectionsPerIp
+	}
+	if cfg.WriteTimeout <= 0 {
+		cfg.WriteTimeout = defaultWriteTimeout
+	}
+
 	manager := &Manager{
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
@@ -40,11 +102,20 @@ func NewManager(observer *bus.Observer) *Manager {
 				return true
 			},
 		},
-		observer: observer,
-		clientId: new(atomic.Uint64),
-		clients:  sdkSync.NewMap[uint64, *Client](),
-		g:        workerpool.NewGroup(),
+		observer:      observer,
+		cfg:           cfg,
+		connectionsMx: new(sync.Mutex),
+		connections:   make(map[string]int),
+		blockStorage:  blocks,
+		txStorage:     txs,
+		actionStorage: actions,
+		rollupStorage: rollups,
+		clientId:      new(atomic.Uint64),
+		clients:       sdkSync.NewMap[uint64, *Client](),
+		rollupBlocks:  make(chan *storage.Block, cfg.QueueSize),
+		g:             workerpool.NewGroup(),
 	}
+	manager.metrics = newMetrics(manager)
 
 	manager.head = NewChannel[storage.State, *responses.State](
 		headProcessor,
@@ -54,6 +125,26 @@ func NewManager(observer *bus.Observer) *Manager {
 		blockProcessor,
 		BlockFilter{},
 	)
+	manager.alerts = NewChannel[storage.WatchlistAlert, *responses.WatchlistAlert](
+		alertProcessor,
+		AlertFilter{},
+	)
+	manager.txs = NewChannel[storage.Tx, *responses.Tx](
+		txProcessor,
+		TxFilter{},
+	)
+	manager.actions = NewChannel[storage.ActionWithTx, *responses.Action](
+		actionProcessor,
+		ActionFilter{},
+	)
+	manager.rollup = NewChannel[rollupEvent, *RollupMessage](
+		rollupProcessor,
+		RollupFilter{},
+	)
+	manager.rollupData = NewChannel[rollupEvent, *RollupMessage](
+		rollupDataProcessor,
+		RollupFilter{},
+	)
 
 	return manager
 }
@@ -71,8 +162,93 @@ func (manager *Manager) listen(ctx context.Context) {
 			if err := manager.blocks.processMessage(*block); err != nil {
 				log.Err(err).Msg("handle block")
 			}
+			manager.enqueueRollups(ctx, block)
+		case alert := <-manager.observer.Alerts():
+			if err := manager.alerts.processMessage(*alert); err != nil {
+				log.Err(err).Msg("handle alert")
+			}
+		case tx := <-manager.observer.Txs():
+			manager.handleTx(tx)
+		}
+	}
+}
+
+func (manager *Manager) handleTx(tx *storage.Tx) {
+	if tx == nil {
+		return
+	}
+	if err := manager.txs.processMessage(*tx); err != nil {
+		log.Err(err).Msg("handle tx")
+	}
+	for i := range tx.Actions {
+		if err := manager.actions.processMessage(storage.ActionWithTx{
+			Action: tx.Actions[i],
+			Tx:     tx,
+		}); err != nil {
+			log.Err(err).Msg("handle action")
+		}
+	}
+}
+
+// enqueueRollups - passes the block to the rollup actions loader. It blocks only if the queue is overflowed.
+func (manager *Manager) enqueueRollups(ctx context.Context, block *storage.Block) {
+	if block == nil || manager.rollupStorage == nil {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case manager.rollupBlocks <- block:
+	}
+}
+
+// listenRollups - loads rollup actions of queued blocks and sends them to rollup channels in order of blocks
+func (manager *Manager) listenRollups(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case block := <-manager.rollupBlocks:
+			if err := manager.handleRollups(ctx, block); err != nil {
+				log.Err(err).Msg("handle rollup actions")
+			}
+		}
+	}
+}
+
+const rollupActionsPage = 100
+
+// handleRollups - sends rollup actions of the block in order followed by the end of block marker
+func (manager *Manager) handleRollups(ctx context.Context, block *storage.Block) error {
+	if block == nil || manager.rollupStorage == nil {
+		return nil
+	}
+	if manager.rollup.clients.Len() == 0 && manager.rollupData.clients.Len() == 0 {
+		return nil
+	}
+
+	for offset := 0; ; offset += rollupActionsPage {
+		actions, err := manager.rollupStorage.ActionsByHeight(ctx, block.Height, rollupActionsPage, offset)
+		if err != nil {
+			return err
+		}
+		for i := range actions {
+			if err := manager.processRollupEvent(rollupEvent{block: block, action: &actions[i]}); err != nil {
+				return err
+			}
+		}
+		if len(actions) < rollupActionsPage {
+			break
 		}
 	}
+
+	return manager.processRollupEvent(rollupEvent{block: block})
+}
+
+func (manager *Manager) processRollupEvent(event rollupEvent) error {
+	if err := manager.rollup.processMessage(event); err != nil {
+		return err
+	}
+	return manager.rollupData.processMessage(event)
 }
 
 // Handle godoc
@@ -84,21 +260,30 @@ func (manager *Manager) listen(ctx context.Context) {
 //	@Produce				json
 //	@Router					/v1/ws [get]
 func (manager *Manager) Handle(c echo.Context) error {
+	ip := c.RealIP()
+	if !manager.acquireConnection(ip) {
+		manager.metrics.connections.WithLabelValues("rejected").Inc()
+		return echo.NewHTTPError(http.StatusTooManyRequests, ErrTooManyConnects.Error())
+	}
+	defer manager.releaseConnection(ip)
+
 	ws, err := manager.upgrader.Upgrade(c.Response(), c.Request(), nil)
 	if err != nil {
 		return err
 	}
 	ws.SetReadLimit(1024 * 10) // 10KB
+	manager.metrics.connections.WithLabelValues("accepted").Inc()
 
 	sId := manager.clientId.Add(1)
 	sub := newClient(sId, manager)
+	sub.admin = manager.isAdmin(c.Request())
 
 	manager.clients.Set(sId, sub)
 
 	ctx, cancel := context.WithCancel(c.Request().Context())
 	sub.WriteMessages(ctx, ws, c.Logger())
 	sub.ReadMessages(ctx, ws, sub, c.Logger())
-	manager.clients.Delete(sId)
+	manager.removeClient(sub)
 	cancel()
 
 	if err := sub.Close(); err != nil {
@@ -108,8 +293,68 @@ func (manager *Manager) Handle(c echo.Context) error {
 	return ws.Close()
 }
 
+// isAdmin - checks that request contains the administrative API key
+func (manager *Manager) isAdmin(r *http.Request) bool {
+	if manager.cfg.ApiKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get(apiKeyHeader)), []byte(manager.cfg.ApiKey)) == 1
+}
+
+// acquireConnection - reserves connection slot for the IP address. Returns false if the limit is reached.
+func (manager *Manager) acquireConnection(ip string) bool {
+	manager.connectionsMx.Lock()
+	defer manager.connectionsMx.Unlock()
+
+	if manager.connections[ip] >= manager.cfg.MaxConnectionsPerIp {
+		return false
+	}
+	manager.connections[ip]++
+	return true
+}
+
+func (manager *Manager) releaseConnection(ip string) {
+	manager.connectionsMx.Lock()
+	defer manager.connectionsMx.Unlock()
+
+	if manager.connections[ip] <= 1 {
+		delete(manager.connections, ip)
+		return
+	}
+	manager.connections[ip]--
+}
+
+// removeClient - unsubscribes client from all channels. After that the client does not receive notifications and can be closed safely.
+func (manager *Manager) removeClient(client *Client) {
+	for _, channel := range []string{
+		ChannelHead, ChannelBlocks, ChannelAlerts, ChannelTxs, ChannelActions, ChannelRollup,
+	} {
+		manager.RemoveClientFromChannel(channel, client)
+	}
+	manager.clients.Delete(client.id)
+}
+
+// queueDepth - returns total and maximum count of messages waiting in client queues
+func (manager *Manager) queueDepth() (total int, max int) {
+	_ = manager.clients.Range(func(_ uint64, value *Client) (error, bool) {
+		depth := len(value.ch)
+		total += depth
+		if depth > max {
+			max = depth
+		}
+		return nil, false
+	})
+	return
+}
+
+// Collectors - returns prometheus collectors of websocket server metrics
+func (manager *Manager) Collectors() []prometheus.Collector {
+	return manager.metrics.collectors()
+}
+
 func (manager *Manager) Start(ctx context.Context) {
 	manager.g.GoCtx(ctx, manager.listen)
+	manager.g.GoCtx(ctx, manager.listenRollups)
 }
 
 func (manager *Manager) Close() error {
@@ -129,6 +374,20 @@ func (manager *Manager) AddClientToChannel(channel string, client *Client) {
 		manager.head.AddClient(client)
 	case ChannelBlocks:
 		manager.blocks.AddClient(client)
+	case ChannelAlerts:
+		manager.alerts.AddClient(client)
+	case ChannelTxs:
+		manager.txs.AddClient(client)
+	case ChannelActions:
+		manager.actions.AddClient(client)
+	case ChannelRollup:
+		manager.rollup.RemoveClient(client.id)
+		manager.rollupData.RemoveClient(client.id)
+		if fltrs := client.Filters(); fltrs != nil && fltrs.rollupWithData {
+			manager.rollupData.AddClient(client)
+		} else {
+			manager.rollup.AddClient(client)
+		}
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
@@ -140,6 +399,15 @@ func (manager *Manager) RemoveClientFromChannel(channel string, client *Client)
 		manager.head.RemoveClient(client.id)
 	case ChannelBlocks:
 		manager.blocks.RemoveClient(client.id)
+	case ChannelAlerts:
+		manager.alerts.RemoveClient(client.id)
+	case ChannelTxs:
+		manager.txs.RemoveClient(client.id)
+	case ChannelActions:
+		manager.actions.RemoveClient(client.id)
+	case ChannelRollup:
+		manager.rollup.RemoveClient(client.id)
+		manager.rollupData.RemoveClient(client.id)
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManager_HandleRollups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rollups := mock.NewMockIRollup(ctrl)
+	manager := NewManager(Config{}, nil, nil, nil, nil, rollups)
+
+	first := &storage.Rollup{Id: 1, AstriaId: testsuite.MustHexDecode("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539")}
+	second := &storage.Rollup{Id: 2, AstriaId: testsuite.MustHexDecode("2e046327a2ccac7c8f8018ed44e43184b502eb3e")}
+
+	block := &storage.Block{
+		Height: 100,
+		Time:   time.Now(),
+		Hash:   testsuite.RandomHash(32),
+	}
+
+	rollups.EXPECT().
+		ActionsByHeight(gomock.Any(), pkgTypes.Level(100), rollupActionsPage, 0).
+		Return([]storage.RollupAction{
+			{
+				ActionId: 1,
+				Height:   100,
+				Size:     4,
+				Rollup:   first,
+				Action: &storage.Action{
+					Id:   1,
+					Type: types.ActionTypeSequence,
+					Data: map[string]any{
+						"rollup_id": first.AstriaId,
+						"data":      []byte{1, 2, 3, 4},
+					},
+				},
+			}, {
+				ActionId: 2,
+				Height:   100,
+				Size:     2,
+				Rollup:   second,
+				Action: &storage.Action{
+					Id:   2,
+					Type: types.ActionTypeSequence,
+					Data: map[string]any{
+						"rollup_id": second.AstriaId,
+						"data":      []byte{1, 2},
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	withData := newTestHeadClient(1)
+	require.NoError(t, applyTestRollupFilters(withData, RollupFilters{
+		Rollups:  []string{"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk="},
+		WithData: true,
+	}))
+	manager.rollupData.AddClient(withData)
+
+	withoutData := newTestHeadClient(2)
+	require.NoError(t, applyTestRollupFilters(withoutData, RollupFilters{
+		Rollups: []string{"LgRjJ6LMrHyPgBjtROQxhLUC6z4="},
+	}))
+	manager.rollup.AddClient(withoutData)
+
+	err := manager.handleRollups(context.Background(), block)
+	require.NoError(t, err)
+
+	msgs := readRollupMessages(t, withData, 2)
+	require.Equal(t, RollupMessageAction, msgs[0].Type)
+	require.Equal(t, "GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=", msgs[0].Rollup)
+	require.EqualValues(t, 4, msgs[0].Size)
+	require.Contains(t, msgs[0].Action.Data, "data")
+	require.Equal(t, RollupMessageEndOfBlock, msgs[1].Type)
+	require.EqualValues(t, 100, msgs[1].Height)
+	require.EqualValues(t, block.Hash, msgs[1].Hash)
+
+	msgs = readRollupMessages(t, withoutData, 2)
+	require.Equal(t, RollupMessageAction, msgs[0].Type)
+	require.Equal(t, "LgRjJ6LMrHyPgBjtROQxhLUC6z4=", msgs[0].Rollup)
+	require.NotContains(t, msgs[0].Action.Data, "data")
+	require.Contains(t, msgs[0].Action.Data, "rollup_id")
+	require.Equal(t, RollupMessageEndOfBlock, msgs[1].Type)
+}
+
+func TestManager_RollupsQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rollups := mock.NewMockIRollup(ctrl)
+	manager := NewManager(Config{}, nil, nil, nil, nil, rollups)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan struct{})
+	for _, height := range []pkgTypes.Level{100, 101} {
+		rollups.EXPECT().
+			ActionsByHeight(gomock.Any(), height, rollupActionsPage, 0).
+			DoAndReturn(func(_ context.Context, _ pkgTypes.Level, _, _ int) ([]storage.RollupAction, error) {
+				<-release
+				return nil, nil
+			}).
+			Times(1)
+	}
+
+	client := newTestHeadClient(1)
+	require.NoError(t, applyTestRollupFilters(client, RollupFilters{
+		Rollups: []string{"LgRjJ6LMrHyPgBjtROQxhLUC6z4="},
+	}))
+	manager.rollup.AddClient(client)
+
+	manager.g.GoCtx(ctx, manager.listenRollups)
+
+	// slow loading of rollup actions doesn't block the caller
+	manager.enqueueRollups(ctx, &storage.Block{Height: 100, Time: time.Now()})
+	manager.enqueueRollups(ctx, &storage.Block{Height: 101, Time: time.Now()})
+	require.Len(t, client.ch, 0)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		return len(client.ch) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	msgs := readRollupMessages(t, client, 2)
+	require.EqualValues(t, 100, msgs[0].Height)
+	require.EqualValues(t, 101, msgs[1].Height)
+
+	cancel()
+	manager.g.Wait()
+}
+
+func applyTestRollupFilters(c *testHeadClient, fltrs RollupFilters) error {
+	rollups, err := newRollupSet(fltrs)
+	if err != nil {
+		return err
+	}
+	c.fltrs.rollup = true
+	c.fltrs.rollups = rollups
+	c.fltrs.rollupWithData = fltrs.WithData
+	return nil
+}
+
+func readRollupMessages(t *testing.T, c *testHeadClient, count int) []*RollupMessage {
+	require.Len(t, c.ch, count)
+
+	result := make([]*RollupMessage, count)
+	for i := range result {
+		msg, ok := (<-c.ch).(Notification[*RollupMessage])
+		require.True(t, ok)
+		require.Equal(t, ChannelRollup, msg.Channel)
+		result[i] = msg.Body
+	}
+	return result
+}
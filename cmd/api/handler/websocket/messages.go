@@ -4,7 +4,10 @@
 package websocket
 
 import (
+	"time"
+
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/goccy/go-json"
 )
 
@@ -16,8 +19,18 @@ const (
 
 // channels
 const (
-	ChannelHead   = "head"
-	ChannelBlocks = "blocks"
+	ChannelHead    = "head"
+	ChannelBlocks  = "blocks"
+	ChannelAlerts  = "alerts"
+	ChannelTxs     = "txs"
+	ChannelActions = "actions"
+	ChannelRollup  = "rollup"
+)
+
+// types of rollup channel messages
+const (
+	RollupMessageAction     = "action"
+	RollupMessageEndOfBlock = "end_of_block"
 )
 
 type Message struct {
@@ -26,21 +39,44 @@ type Message struct {
 }
 
 type Subscribe struct {
-	Channel string          `json:"channel" validate:"required,oneof=head tx"`
-	Filters json.RawMessage `json:"filters" validate:"required"`
+	Channel    string          `json:"channel"               validate:"required,oneof=head blocks alerts txs actions rollup"`
+	Filters    json.RawMessage `json:"filters"               validate:"required"`
+	FromHeight uint64          `json:"from_height,omitempty" validate:"omitempty,min=1"`
 }
 
 type Unsubscribe struct {
-	Channel string `json:"channel" validate:"required,oneof=head tx"`
+	Channel string `json:"channel" validate:"required,oneof=head blocks alerts txs actions rollup"`
+}
+
+type BlockFilters struct {
+	Actions   []string `json:"action_type,omitempty"`
+	Proposers []string `json:"proposers,omitempty"`
 }
 
 type TransactionFilters struct {
-	Status  []string `json:"status,omitempty"`
-	Actions []string `json:"action_type,omitempty"`
+	Status    []string `json:"status,omitempty"`
+	Actions   []string `json:"action_type,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+	Rollups   []string `json:"rollups,omitempty"`
+}
+
+type ActionFilters struct {
+	Actions   []string `json:"action_type,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+	Rollups   []string `json:"rollups,omitempty"`
+}
+
+type RollupFilters struct {
+	Rollups  []string `json:"rollups"`
+	WithData bool     `json:"with_data,omitempty"`
+}
+
+type AlertFilters struct {
+	Watchlists []uint64 `json:"watchlists,omitempty"`
 }
 
 type INotification interface {
-	*responses.Block | *responses.State
+	*responses.Block | *responses.State | *responses.WatchlistAlert | *responses.Tx | *responses.Action | *RollupMessage
 }
 
 type Notification[T INotification] struct {
@@ -48,6 +84,10 @@ type Notification[T INotification] struct {
 	Body    T      `json:"body"`
 }
 
+func (n Notification[T]) channelName() string {
+	return n.Channel
+}
+
 func NewBlockNotification(block responses.Block) Notification[*responses.Block] {
 	return Notification[*responses.Block]{
 		Channel: ChannelBlocks,
@@ -61,3 +101,43 @@ func NewStateNotification(state responses.State) Notification[*responses.State]
 		Body:    &state,
 	}
 }
+
+func NewAlertNotification(alert responses.WatchlistAlert) Notification[*responses.WatchlistAlert] {
+	return Notification[*responses.WatchlistAlert]{
+		Channel: ChannelAlerts,
+		Body:    &alert,
+	}
+}
+
+func NewTxNotification(tx responses.Tx) Notification[*responses.Tx] {
+	return Notification[*responses.Tx]{
+		Channel: ChannelTxs,
+		Body:    &tx,
+	}
+}
+
+func NewActionNotification(action responses.Action) Notification[*responses.Action] {
+	return Notification[*responses.Action]{
+		Channel: ChannelActions,
+		Body:    &action,
+	}
+}
+
+// RollupMessage - message of rollup channel. It's either rollup action or marker of the block end
+// which is sent for every block after all actions of subscribed rollups in the block.
+type RollupMessage struct {
+	Type   string            `json:"type"`
+	Height pkgTypes.Level    `json:"height"`
+	Time   time.Time         `json:"time"`
+	Hash   pkgTypes.Hex      `json:"hash,omitempty"`
+	Rollup string            `json:"rollup,omitempty"`
+	Size   int64             `json:"size,omitempty"`
+	Action *responses.Action `json:"action,omitempty"`
+}
+
+func NewRollupNotification(msg RollupMessage) Notification[*RollupMessage] {
+	return Notification[*RollupMessage]{
+		Channel: ChannelRollup,
+		Body:    &msg,
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "astria_api"
+	metricsSubsystem = "websocket"
+)
+
+// metrics - websocket server metrics. Collectors should be registered by the caller, see Manager.Collectors.
+type metrics struct {
+	connections *prometheus.CounterVec
+	dropped     *prometheus.CounterVec
+	clients     prometheus.GaugeFunc
+	queueDepth  prometheus.GaugeFunc
+	queueMax    prometheus.GaugeFunc
+}
+
+func newMetrics(manager *Manager) *metrics {
+	return &metrics{
+		connections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "connections_total",
+			Help:      "Count of websocket connection attempts by result",
+		}, []string{"result"}),
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "dropped_clients_total",
+			Help:      "Count of clients disconnected by server by reason",
+		}, []string{"reason"}),
+		clients: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "clients",
+			Help:      "Count of connected clients",
+		}, func() float64 {
+			return float64(manager.clients.Len())
+		}),
+		queueDepth: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "queue_depth",
+			Help:      "Total count of messages waiting in client queues",
+		}, func() float64 {
+			total, _ := manager.queueDepth()
+			return float64(total)
+		}),
+		queueMax: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "queue_depth_max",
+			Help:      "Maximum count of messages waiting in one client queue",
+		}, func() float64 {
+			_, max := manager.queueDepth()
+			return float64(max)
+		}),
+	}
+}
+
+func (m *metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.connections,
+		m.dropped,
+		m.clients,
+		m.queueDepth,
+		m.queueMax,
+	}
+}
@@ -4,6 +4,8 @@
 package websocket
 
 import (
+	"encoding/base64"
+
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 )
@@ -17,3 +19,64 @@ func headProcessor(state storage.State) Notification[*responses.State] {
 	response := responses.NewState(state)
 	return NewStateNotification(response)
 }
+
+func alertProcessor(alert storage.WatchlistAlert) Notification[*responses.WatchlistAlert] {
+	response := responses.NewWatchlistAlert(alert)
+	return NewAlertNotification(response)
+}
+
+func txProcessor(tx storage.Tx) Notification[*responses.Tx] {
+	response := responses.NewTx(tx)
+	return NewTxNotification(response)
+}
+
+func actionProcessor(action storage.ActionWithTx) Notification[*responses.Action] {
+	response := responses.NewActionWithTx(action)
+	return NewActionNotification(response)
+}
+
+// rollupEvent - rollup action of the block or the end of the block if action is nil
+type rollupEvent struct {
+	block  *storage.Block
+	action *storage.RollupAction
+}
+
+func rollupProcessor(event rollupEvent) Notification[*RollupMessage] {
+	return newRollupMessage(event, false)
+}
+
+func rollupDataProcessor(event rollupEvent) Notification[*RollupMessage] {
+	return newRollupMessage(event, true)
+}
+
+func newRollupMessage(event rollupEvent, withData bool) Notification[*RollupMessage] {
+	msg := RollupMessage{
+		Height: event.block.Height,
+		Time:   event.block.Time,
+	}
+	if event.action == nil {
+		msg.Type = RollupMessageEndOfBlock
+		msg.Hash = event.block.Hash
+		return NewRollupNotification(msg)
+	}
+
+	msg.Type = RollupMessageAction
+	msg.Size = event.action.Size
+	if event.action.Rollup != nil {
+		msg.Rollup = base64.URLEncoding.EncodeToString(event.action.Rollup.AstriaId)
+	}
+	if event.action.Action != nil {
+		action := responses.NewRollupAction(*event.action).Action
+		if !withData {
+			data := make(map[string]any, len(action.Data))
+			for key, value := range action.Data {
+				if key != "data" {
+					data[key] = value
+				}
+			}
+			action.Data = data
+		}
+		msg.Action = &action
+	}
+	return NewRollupNotification(msg)
+}
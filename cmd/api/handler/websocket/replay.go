@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxReplayDepth - maximum count of blocks which can be replayed by one subscription
+const maxReplayDepth = 10_000
+
+// replay - state of channel subscription which sends historical messages before live ones.
+// Live messages received during replaying are buffered. When history is sent they are flushed
+// except ones with height which was already replayed. So there are neither gaps nor duplicates.
+type replay struct {
+	from  pkgTypes.Level
+	limit int
+
+	mx      *sync.Mutex
+	live    bool
+	last    pkgTypes.Level
+	pending []*outgoing
+
+	cancel context.CancelFunc
+}
+
+func newReplay(from pkgTypes.Level, limit int) *replay {
+	return &replay{
+		from:    from,
+		limit:   limit,
+		last:    from - 1,
+		mx:      new(sync.Mutex),
+		pending: make([]*outgoing, 0),
+	}
+}
+
+// push - handles live message. Returns false if buffer of live messages is overflowed.
+func (r *replay) push(msg *outgoing, height pkgTypes.Level, send func(*outgoing)) bool {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if !r.live {
+		if len(r.pending) >= r.limit {
+			return false
+		}
+		r.pending = append(r.pending, msg)
+		return true
+	}
+	if height > r.last {
+		send(msg)
+	}
+	return true
+}
+
+// setLast - marks height as completely replayed
+func (r *replay) setLast(height pkgTypes.Level) {
+	r.mx.Lock()
+	r.last = height
+	r.mx.Unlock()
+}
+
+// finish - flushes buffered live messages and switches to live mode.
+// Messages are sent without holding the lock so the live fan-out is not blocked by the client.
+func (r *replay) finish(send func(*outgoing) error) {
+	for {
+		r.mx.Lock()
+		if len(r.pending) == 0 {
+			r.pending = nil
+			r.live = true
+			r.mx.Unlock()
+			return
+		}
+		batch, last := r.pending, r.last
+		r.pending = make([]*outgoing, 0)
+		r.mx.Unlock()
+
+		for i := range batch {
+			if _, height, ok := messageHeight(batch[i].msg); ok && height <= last {
+				continue
+			}
+			if err := send(batch[i]); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// messageHeight - returns channel and height of message if the channel supports replaying
+func messageHeight(msg any) (string, pkgTypes.Level, bool) {
+	switch typ := msg.(type) {
+	case Notification[*responses.Block]:
+		if typ.Body != nil {
+			return typ.Channel, pkgTypes.Level(typ.Body.Height), true
+		}
+	case Notification[*responses.Tx]:
+		if typ.Body != nil {
+			return typ.Channel, typ.Body.Height, true
+		}
+	case Notification[*responses.Action]:
+		if typ.Body != nil {
+			return typ.Channel, typ.Body.Height, true
+		}
+	case Notification[*RollupMessage]:
+		if typ.Body != nil {
+			return typ.Channel, typ.Body.Height, true
+		}
+	}
+	return "", 0, false
+}
+
+func isReplayable(channel string) bool {
+	switch channel {
+	case ChannelBlocks, ChannelTxs, ChannelActions, ChannelRollup:
+		return true
+	default:
+		return false
+	}
+}
+
+const historyPage = 100
+
+// startReplay - validates depth of replay and registers it in the client. Must be called before the client is added to the channel.
+// Returned context is canceled when the client unsubscribes from the channel.
+func (manager *Manager) startReplay(ctx context.Context, c *Client, channel string, from pkgTypes.Level) (context.Context, *replay, error) {
+	if manager.blockStorage == nil {
+		return nil, nil, errors.Wrap(ErrUnavailableFilter, "replay is unavailable")
+	}
+	if !isReplayable(channel) {
+		return nil, nil, errors.Wrapf(ErrUnavailableFilter, "channel %s does not support from_height", channel)
+	}
+	last, err := manager.blockStorage.Last(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "receiving last block")
+	}
+	if last.Height > from && last.Height-from > maxReplayDepth {
+		return nil, nil, errors.Wrapf(ErrUnavailableFilter, "from_height should be greater than %d", last.Height-maxReplayDepth)
+	}
+
+	replayCtx, cancel := context.WithCancel(ctx)
+	r := newReplay(from, manager.cfg.QueueSize)
+	r.cancel = cancel
+	c.setReplay(channel, r)
+	return replayCtx, r, nil
+}
+
+// replay - sends historical messages of the channel starting from the height and switches the channel to live mode.
+// If history can't be received the client is dropped, because switching to live mode would leave a gap in the stream.
+func (manager *Manager) replay(ctx context.Context, c *Client, channel string, r *replay) {
+	send := func(msg *outgoing) error {
+		return c.send(ctx, msg)
+	}
+
+	for height := r.from; ; height++ {
+		msgs, err := manager.history(ctx, c, channel, height)
+		if err != nil {
+			if manager.blockStorage.IsNoRows(err) {
+				break
+			}
+			if ctx.Err() == nil {
+				log.Err(err).Str("channel", channel).Uint64("height", uint64(height)).Msg("replay history")
+				c.drop(closeReasonReplayFailed)
+			}
+			return
+		}
+		for i := range msgs {
+			out, err := newOutgoing(msgs[i])
+			if err != nil {
+				log.Err(err).Str("channel", channel).Msg("replay message")
+				c.drop(closeReasonReplayFailed)
+				return
+			}
+			if err := send(out); err != nil {
+				return
+			}
+		}
+		r.setLast(height)
+	}
+
+	r.finish(send)
+}
+
+// history - returns messages of the channel at the height filtered by client filters
+func (manager *Manager) history(ctx context.Context, c *Client, channel string, height pkgTypes.Level) ([]any, error) {
+	block, err := manager.blockStorage.ByHeight(ctx, height, channel == ChannelBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]any, 0)
+	switch channel {
+	case ChannelBlocks:
+		if msg := blockProcessor(block); (BlockFilter{}).Filter(c, msg) {
+			msgs = append(msgs, msg)
+		}
+
+	case ChannelTxs:
+		for offset := 0; ; offset += historyPage {
+			txs, err := manager.txStorage.Filter(ctx, storage.TxFilter{
+				Limit:       historyPage,
+				Offset:      offset,
+				Sort:        sdk.SortOrderAsc,
+				Height:      uint64(height),
+				WithActions: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for i := range txs {
+				if msg := txProcessor(txs[i]); (TxFilter{}).Filter(c, msg) {
+					msgs = append(msgs, msg)
+				}
+			}
+			if len(txs) < historyPage {
+				break
+			}
+		}
+
+	case ChannelActions:
+		for offset := 0; ; offset += historyPage {
+			actions, err := manager.actionStorage.ByBlock(ctx, height, historyPage, offset)
+			if err != nil {
+				return nil, err
+			}
+			for i := range actions {
+				if msg := actionProcessor(actions[i]); (ActionFilter{}).Filter(c, msg) {
+					msgs = append(msgs, msg)
+				}
+			}
+			if len(actions) < historyPage {
+				break
+			}
+		}
+
+	case ChannelRollup:
+		withData := c.Filters().rollupWithData
+		for offset := 0; ; offset += historyPage {
+			actions, err := manager.rollupStorage.ActionsByHeight(ctx, height, historyPage, offset)
+			if err != nil {
+				return nil, err
+			}
+			for i := range actions {
+				msg := newRollupMessage(rollupEvent{block: &block, action: &actions[i]}, withData)
+				if (RollupFilter{}).Filter(c, msg) {
+					msgs = append(msgs, msg)
+				}
+			}
+			if len(actions) < historyPage {
+				break
+			}
+		}
+		msgs = append(msgs, newRollupMessage(rollupEvent{block: &block}, withData))
+	}
+	return msgs, nil
+}
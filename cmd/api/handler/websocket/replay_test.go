@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package websocket
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testBlockNotification(t *testing.T, height pkgTypes.Level) *outgoing {
+	out, err := newOutgoing(blockProcessor(storage.Block{Height: height, Time: time.Now()}))
+	require.NoError(t, err)
+	return out
+}
+
+func TestReplay_PushFinish(t *testing.T) {
+	r := newReplay(10, 2)
+
+	sent := make([]pkgTypes.Level, 0)
+	send := func(msg *outgoing) {
+		_, height, ok := messageHeight(msg.msg)
+		require.True(t, ok)
+		sent = append(sent, height)
+	}
+
+	require.True(t, r.push(testBlockNotification(t, 11), 11, send))
+	require.True(t, r.push(testBlockNotification(t, 12), 12, send))
+	require.False(t, r.push(testBlockNotification(t, 13), 13, send), "buffer overflow")
+	require.Empty(t, sent)
+
+	r.setLast(11)
+	r.finish(func(msg *outgoing) error {
+		send(msg)
+		return nil
+	})
+	require.Equal(t, []pkgTypes.Level{12}, sent)
+
+	require.True(t, r.push(testBlockNotification(t, 11), 11, send))
+	require.True(t, r.push(testBlockNotification(t, 13), 13, send))
+	require.Equal(t, []pkgTypes.Level{12, 13}, sent)
+}
+
+func TestManager_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blocks := mock.NewMockIBlock(ctrl)
+	manager := NewManager(Config{}, nil, blocks, nil, nil, nil)
+
+	blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 11}, nil).
+		Times(1)
+	for _, height := range []pkgTypes.Level{10, 11} {
+		blocks.EXPECT().
+			ByHeight(gomock.Any(), height, true).
+			Return(storage.Block{Height: height, Time: time.Now()}, nil).
+			Times(1)
+	}
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(12), true).
+		Return(storage.Block{}, sql.ErrNoRows).
+		Times(1)
+	blocks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	client := newClient(1, manager)
+	require.NoError(t, client.ApplyFilters(Subscribe{Channel: ChannelBlocks}))
+
+	ctx, r, err := manager.startReplay(context.Background(), client, ChannelBlocks, 10)
+	require.NoError(t, err)
+
+	// live messages received during replaying
+	client.Notify(testBlockNotification(t, 11))
+	client.Notify(testBlockNotification(t, 12))
+
+	manager.replay(ctx, client, ChannelBlocks, r)
+
+	// live message after replaying
+	client.Notify(testBlockNotification(t, 13))
+
+	require.Len(t, client.ch, 4)
+	for _, want := range []pkgTypes.Level{10, 11, 12, 13} {
+		_, height, ok := messageHeight((<-client.ch).msg)
+		require.True(t, ok)
+		require.Equal(t, want, height)
+	}
+
+	require.NoError(t, client.DetachFilters(Unsubscribe{Channel: ChannelBlocks}))
+	require.Nil(t, client.getReplay(ChannelBlocks))
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestManager_ReplayHistoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blocks := mock.NewMockIBlock(ctrl)
+	manager := NewManager(Config{}, nil, blocks, nil, nil, nil)
+
+	blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 11}, nil).
+		Times(1)
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(10), true).
+		Return(storage.Block{Height: 10, Time: time.Now()}, nil).
+		Times(1)
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(11), true).
+		Return(storage.Block{}, sql.ErrConnDone).
+		Times(1)
+	blocks.EXPECT().
+		IsNoRows(sql.ErrConnDone).
+		Return(false).
+		Times(1)
+
+	client := newClient(1, manager)
+	require.NoError(t, client.ApplyFilters(Subscribe{Channel: ChannelBlocks}))
+
+	ctx, r, err := manager.startReplay(context.Background(), client, ChannelBlocks, 10)
+	require.NoError(t, err)
+
+	client.Notify(testBlockNotification(t, 12))
+	manager.replay(ctx, client, ChannelBlocks, r)
+
+	require.True(t, client.isDropped())
+	require.Equal(t, closeReasonReplayFailed, client.dropReason)
+
+	// channel isn't switched to live mode, so buffered and next messages aren't sent after the gap
+	client.Notify(testBlockNotification(t, 13))
+	require.Len(t, client.ch, 1)
+	_, height, ok := messageHeight((<-client.ch).msg)
+	require.True(t, ok)
+	require.EqualValues(t, 10, height)
+}
+
+func TestManager_StartReplayValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blocks := mock.NewMockIBlock(ctrl)
+	manager := NewManager(Config{}, nil, blocks, nil, nil, nil)
+	client := newClient(1, manager)
+
+	_, _, err := manager.startReplay(context.Background(), client, ChannelHead, 1)
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+
+	blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: maxReplayDepth + 100}, nil).
+		Times(1)
+
+	_, _, err = manager.startReplay(context.Background(), client, ChannelBlocks, 1)
+	require.ErrorIs(t, err, ErrUnavailableFilter)
+	require.Nil(t, client.getReplay(ChannelBlocks))
+}
@@ -45,7 +45,7 @@ func TestWebsocket(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	blockMock := mock.NewMockIBlock(ctrl)
-	dispatcher, err := bus.NewDispatcher(listenerFactory, blockMock)
+	dispatcher, err := bus.NewDispatcher(listenerFactory, blockMock, nil, nil)
 	require.NoError(t, err)
 	dispatcher.Start(ctx)
 	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
@@ -84,7 +84,7 @@ func TestWebsocket(t *testing.T) {
 			}
 		}
 	}()
-	manager := ws.NewManager(observer)
+	manager := ws.NewManager(ws.Config{}, observer, nil, nil, nil, nil)
 	manager.Start(ctx)
 
 	server := httptest.NewServer(http.HandlerFunc(
@@ -14,8 +14,12 @@ import (
 	"github.com/celenium-io/astria-indexer/cmd/api/bus"
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler"
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/graphql"
+	grpcHandler "github.com/celenium-io/astria-indexer/cmd/api/handler/grpc"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
 	"github.com/celenium-io/astria-indexer/internal/profiler"
+	"github.com/celenium-io/astria-indexer/internal/registry"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
 	"github.com/dipdup-net/go-lib/config"
@@ -26,6 +30,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	echoSwagger "github.com/swaggo/echo-swagger"
@@ -100,6 +105,10 @@ func websocketSkipper(c echo.Context) bool {
 	return strings.Contains(c.Request().URL.Path, "ws")
 }
 
+func eventsSkipper(c echo.Context) bool {
+	return strings.HasSuffix(c.Request().URL.Path, "/events")
+}
+
 func postSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "blob") {
 		return true
@@ -107,9 +116,22 @@ func postSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "auth/rollup") {
 		return true
 	}
+	if strings.Contains(c.Request().URL.Path, "watchlists") {
+		return true
+	}
+	if strings.Contains(c.Request().URL.Path, "graphql") {
+		return true
+	}
+	if strings.HasPrefix(c.Request().URL.Path, "/v1/rollup/") && strings.HasSuffix(c.Request().URL.Path, "/metadata") {
+		return true
+	}
 	return false
 }
 
+func exportSkipper(c echo.Context) bool {
+	return strings.HasSuffix(c.Request().URL.Path, "/export")
+}
+
 func gzipSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "swagger") {
 		return true
@@ -117,14 +139,14 @@ func gzipSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "metrics") {
 		return true
 	}
-	return websocketSkipper(c)
+	return websocketSkipper(c) || eventsSkipper(c)
 }
 
 func cacheSkipper(c echo.Context) bool {
 	if c.Request().Method != http.MethodGet {
 		return true
 	}
-	if websocketSkipper(c) {
+	if websocketSkipper(c) || eventsSkipper(c) {
 		return true
 	}
 	if strings.Contains(c.Request().URL.Path, "metrics") {
@@ -133,9 +155,34 @@ func cacheSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "head") {
 		return true
 	}
+	if strings.Contains(c.Request().URL.Path, "watchlists") {
+		return true
+	}
+	if exportSkipper(c) {
+		return true
+	}
 	return false
 }
 
+func headersSkipper(c echo.Context) bool {
+	if c.Request().Method != http.MethodGet {
+		return true
+	}
+	if websocketSkipper(c) || eventsSkipper(c) || exportSkipper(c) {
+		return true
+	}
+	if strings.Contains(c.Request().URL.Path, "swagger") {
+		return true
+	}
+	if strings.Contains(c.Request().URL.Path, "metrics") {
+		return true
+	}
+	if strings.Contains(c.Request().URL.Path, "watchlists") {
+		return true
+	}
+	return strings.Contains(c.Request().URL.Path, "graphql")
+}
+
 func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 	e := echo.New()
 	e.Validator = handler.NewApiValidator()
@@ -146,6 +193,7 @@ func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 		LogMethod:    true,
 		LogUserAgent: true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			v.URI = ratelimit.StripApiKey(c.Request().URL).String()
 			switch {
 			case v.Status == http.StatusOK || v.Status == http.StatusNoContent:
 				log.Info().
@@ -202,9 +250,12 @@ func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 		timeout = time.Duration(cfg.RequestTimeout) * time.Second
 	}
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
-		Skipper: websocketSkipper,
+		Skipper: func(c echo.Context) bool {
+			return websocketSkipper(c) || exportSkipper(c) || eventsSkipper(c)
+		},
 		Timeout: timeout,
 	}))
+	e.Use(ExportMiddleware(cfg.Export))
 
 	if cfg.Prometheus {
 		e.Use(echoprometheus.NewMiddlewareWithConfig(echoprometheus.MiddlewareConfig{
@@ -212,7 +263,7 @@ func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 			Skipper:   websocketSkipper,
 		}))
 	}
-	if cfg.RateLimit > 0 {
+	if cfg.RateLimit > 0 && !cfg.ApiKeys.Enabled {
 		e.Use(middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
 			Skipper: websocketSkipper,
 			Store:   middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.RateLimit)),
@@ -228,10 +279,66 @@ func initEcho(cfg ApiConfig, db postgres.Storage, env string) *echo.Echo {
 	return e
 }
 
+var keysLimiter *ratelimit.Limiter
+
+func limiterSkipper(c echo.Context) bool {
+	if strings.Contains(c.Request().URL.Path, "swagger") {
+		return true
+	}
+	if strings.Contains(c.Request().URL.Path, "metrics") {
+		return true
+	}
+	return websocketSkipper(c)
+}
+
+func initKeysLimiter(ctx context.Context, e *echo.Echo, cfg ApiConfig, db postgres.Storage) {
+	if !cfg.ApiKeys.Enabled {
+		return
+	}
+	keysLimiter = ratelimit.NewLimiter(db.ApiKey, ratelimit.Config{
+		AdminKey:            cfg.ApiKey,
+		AnonymousRps:        cfg.ApiKeys.AnonymousRps,
+		AnonymousDailyQuota: cfg.ApiKeys.AnonymousDailyQuota,
+		CacheTTL:            time.Duration(cfg.ApiKeys.CacheTTL) * time.Second,
+		FlushInterval:       time.Duration(cfg.ApiKeys.FlushInterval) * time.Second,
+		MaxKeys:             cfg.ApiKeys.MaxKeys,
+		MaxAnonymous:        cfg.ApiKeys.MaxAnonymous,
+	})
+	keysLimiter.Start(ctx)
+	e.Use(keysLimiter.Middleware(limiterSkipper))
+}
+
+func initRollupRegistry(ctx context.Context, cfg ApiConfig, db postgres.Storage) {
+	if cfg.RollupRegistry == "" {
+		return
+	}
+	reg, err := registry.Load(cfg.RollupRegistry)
+	if err != nil {
+		panic(err)
+	}
+	metadata, err := reg.Metadata(time.Now().UTC())
+	if err != nil {
+		panic(errors.Wrap(err, cfg.RollupRegistry))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	count, err := db.RollupMetadata.ApplyRegistry(ctx, metadata...)
+	if err != nil {
+		panic(errors.Wrap(err, "apply rollup registry"))
+	}
+	log.Info().
+		Uint64("version", reg.Version).
+		Int("rollups", len(metadata)).
+		Int64("updated", count).
+		Msg("rollup registry is applied")
+}
+
 var dispatcher *bus.Dispatcher
 
 func initDispatcher(ctx context.Context, db postgres.Storage) {
-	d, err := bus.NewDispatcher(db, db.Blocks)
+	d, err := bus.NewDispatcher(db, db.Blocks, db.Tx, db.Watchlist)
 	if err != nil {
 		panic(err)
 	}
@@ -261,7 +368,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	searchHandler := handler.NewSearchHandler(db.Search, db.Address, db.Blocks, db.Tx, db.Rollup, db.Validator)
 	v1.GET("/search", searchHandler.Search)
 
-	addressHandlers := handler.NewAddressHandler(db.Address, db.Tx, db.Action, db.Rollup, db.State, cfg.Indexer.Name)
+	addressHandlers := handler.NewAddressHandler(db.Address, db.BalanceUpdate, db.Tx, db.Action, db.Rollup, db.State, cfg.Indexer.Name)
 	addressesGroup := v1.Group("/address")
 	{
 		addressesGroup.GET("", addressHandlers.List)
@@ -272,10 +379,13 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			addressGroup.GET("/txs", addressHandlers.Transactions)
 			addressGroup.GET("/actions", addressHandlers.Actions)
 			addressGroup.GET("/rollups", addressHandlers.Rollups)
+			addressGroup.GET("/export", addressHandlers.Export)
+			addressGroup.GET("/balance", addressHandlers.Balance)
+			addressGroup.GET("/balance/series/:timeframe", addressHandlers.BalanceSeries)
 		}
 	}
 
-	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Tx, db.Action, db.Rollup, db.State, cfg.Indexer.Name)
+	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Tx, db.Action, db.Rollup, db.State, db.RawBlock, cfg.Indexer.Name)
 	blockGroup := v1.Group("/block")
 	{
 		blockGroup.GET("", blockHandlers.List)
@@ -286,26 +396,30 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 			heightGroup.GET("/actions", blockHandlers.GetActions)
 			heightGroup.GET("/txs", blockHandlers.GetTransactions)
 			heightGroup.GET("/stats", blockHandlers.GetStats)
+			heightGroup.GET("/raw", blockHandlers.Raw)
 			heightGroup.GET("/rollup_actions", blockHandlers.GetRollupActions)
 			heightGroup.GET("/rollup_actions/count", blockHandlers.GetRollupsActionsCount)
 		}
 	}
 
-	txHandlers := handler.NewTxHandler(db.Tx, db.Action, db.Rollup, db.State, cfg.Indexer.Name)
+	txHandlers := handler.NewTxHandler(db.Tx, db.RawTx, db.Action, db.Rollup, db.State, cfg.Indexer.Name)
 	txGroup := v1.Group("/tx")
 	{
 		txGroup.GET("", txHandlers.List)
 		txGroup.GET("/count", txHandlers.Count)
+		txGroup.GET("/export", txHandlers.Export)
 		hashGroup := txGroup.Group("/:hash")
 		{
 			hashGroup.GET("", txHandlers.Get)
 			hashGroup.GET("/actions", txHandlers.GetActions)
+			hashGroup.GET("/raw", txHandlers.Raw)
+			hashGroup.GET("/decoded", txHandlers.Decoded)
 			hashGroup.GET("/rollup_actions", txHandlers.RollupActions)
 			hashGroup.GET("/rollup_actions/count", txHandlers.RollupActionsCount)
 		}
 	}
 
-	rollupsHandler := handler.NewRollupHandler(db.Rollup, db.Action, db.State, cfg.Indexer.Name)
+	rollupsHandler := handler.NewRollupHandler(db.Rollup, db.RollupMetadata, db.Action, db.State, cfg.Indexer.Name)
 	rollupsGroup := v1.Group("/rollup")
 	{
 		rollupsGroup.GET("", rollupsHandler.List)
@@ -315,7 +429,13 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		{
 			rollupGroup.GET("", rollupsHandler.Get)
 			rollupGroup.GET("/actions", rollupsHandler.Actions)
+			rollupGroup.GET("/actions/export", rollupsHandler.ExportActions)
 			rollupGroup.GET("/addresses", rollupsHandler.Addresses)
+
+			if cfg.ApiConfig.ApiKey != "" {
+				rollupGroup.PUT("/metadata", rollupsHandler.SetMetadata, ApiKeyMiddleware(cfg.ApiConfig.ApiKey))
+				rollupGroup.DELETE("/metadata", rollupsHandler.DeleteMetadata, ApiKeyMiddleware(cfg.ApiConfig.ApiKey))
+			}
 		}
 	}
 
@@ -336,11 +456,39 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	{
 		stats.GET("/summary", statsHandler.Summary)
 		stats.GET("/series/:name/:timeframe", statsHandler.Series)
+		stats.GET("/series/actions/:type/:timeframe", statsHandler.ActionSeries)
+		stats.GET("/holders", statsHandler.Holders)
+		stats.GET("/active_addresses", statsHandler.ActiveAddresses)
 
 		rollup := stats.Group("/rollup")
 		{
 			rollup.GET("/series/:hash/:name/:timeframe", statsHandler.RollupSeries)
 		}
+		stats.GET("/rollups/top", statsHandler.TopRollups)
+	}
+
+	if cfg.ApiConfig.ApiKey != "" {
+		watchlistHandler := handler.NewWatchlistHandler(db.Watchlist)
+		watchlists := v1.Group("/watchlists", ApiKeyMiddleware(cfg.ApiConfig.ApiKey))
+		{
+			watchlists.GET("", watchlistHandler.List)
+			watchlists.POST("", watchlistHandler.Create)
+			watchlistGroup := watchlists.Group("/:id")
+			{
+				watchlistGroup.GET("", watchlistHandler.Get)
+				watchlistGroup.PUT("", watchlistHandler.Update)
+				watchlistGroup.DELETE("", watchlistHandler.Delete)
+				watchlistGroup.GET("/alerts", watchlistHandler.Alerts)
+			}
+		}
+	} else {
+		log.Warn().Msg("api key is not set: watchlist endpoints are disabled")
+	}
+
+	if cfg.ApiConfig.GraphQL.Enabled {
+		if err := initGraphQL(v1, cfg, db); err != nil {
+			panic(err)
+		}
 	}
 
 	if cfg.ApiConfig.Prometheus {
@@ -350,7 +498,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	v1.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	if cfg.ApiConfig.Websocket {
-		initWebsocket(ctx, v1)
+		initWebsocket(ctx, v1, cfg, db)
 	}
 
 	log.Info().Msg("API routes:")
@@ -391,20 +539,94 @@ func initSentry(e *echo.Echo, db postgres.Storage, dsn, environment string) erro
 var (
 	wsManager     *websocket.Manager
 	endpointCache *cache.Cache
+	grpcServer    *grpcHandler.Server
 )
 
-func initWebsocket(ctx context.Context, group *echo.Group) {
-	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
-	wsManager = websocket.NewManager(observer)
+func initWebsocket(ctx context.Context, group *echo.Group, cfg Config, db postgres.Storage) {
+	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock, storage.ChannelAlert, storage.ChannelTx)
+	limits := cfg.ApiConfig.WebsocketLimits
+	wsManager = websocket.NewManager(websocket.Config{
+		QueueSize:           limits.QueueSize,
+		MaxConnectionsPerIp: limits.MaxConnectionsPerIp,
+		WriteTimeout:        time.Duration(limits.WriteTimeout) * time.Second,
+		ApiKey:              cfg.ApiConfig.ApiKey,
+	}, observer, db.Blocks, db.Tx, db.Action, db.Rollup)
+	if cfg.ApiConfig.Prometheus {
+		prometheus.MustRegister(wsManager.Collectors()...)
+	}
 	wsManager.Start(ctx)
 	group.GET("/ws", wsManager.Handle)
+	group.GET("/events", wsManager.HandleEvents)
 }
 
-func initCache(ctx context.Context, e *echo.Echo) {
+func initGraphQL(group *echo.Group, cfg Config, db postgres.Storage) error {
+	resolver := graphql.NewResolver(
+		db.Blocks, db.BlockStats, db.Tx, db.Action, db.Address, db.Rollup,
+		db.Validator, db.Stats, db.State, cfg.Indexer.Name,
+	)
+	graphqlHandler, err := graphql.NewHandler(resolver, graphql.Config{
+		MaxDepth:      cfg.ApiConfig.GraphQL.MaxDepth,
+		MaxComplexity: cfg.ApiConfig.GraphQL.MaxComplexity,
+	})
+	if err != nil {
+		return errors.Wrap(err, "graphql")
+	}
+	group.GET("/graphql", graphqlHandler.Handle)
+	group.POST("/graphql", graphqlHandler.Handle)
+	return nil
+}
+
+func initGrpc(ctx context.Context, cfg Config, db postgres.Storage) {
+	if !cfg.ApiConfig.Grpc.Enabled {
+		return
+	}
+	observer := dispatcher.Observe(storage.ChannelBlock)
+	grpcServer = grpcHandler.NewServer(db, observer, cfg.Indexer.Name)
+	if err := grpcServer.Start(ctx, cfg.ApiConfig.Grpc.Bind); err != nil {
+		panic(err)
+	}
+}
+
+// cacheRules - caching policies of routes. Finalized blocks and transactions never change,
+// so their successful responses are kept until eviction. Empty responses of not indexed entities expire with the next head.
+// Other routes are cached till the next head if not listed.
+var cacheRules = map[string]cache.Rule{
+	"/v1/block/:height":                              {Immutable: true},
+	"/v1/block/:height/actions":                      {Immutable: true},
+	"/v1/block/:height/txs":                          {Immutable: true},
+	"/v1/block/:height/stats":                        {Immutable: true},
+	"/v1/block/:height/rollup_actions":               {Immutable: true},
+	"/v1/block/:height/rollup_actions/count":         {Immutable: true},
+	"/v1/tx/:hash":                                   {Immutable: true},
+	"/v1/tx/:hash/actions":                           {Immutable: true},
+	"/v1/tx/:hash/raw":                               {Immutable: true},
+	"/v1/tx/:hash/decoded":                           {Immutable: true},
+	"/v1/tx/:hash/rollup_actions":                    {Immutable: true},
+	"/v1/tx/:hash/rollup_actions/count":              {Immutable: true},
+	"/v1/constants":                                  {TTL: time.Hour},
+	"/v1/enums":                                      {TTL: time.Hour},
+	"/v1/stats/series/:name/:timeframe":              {TTL: time.Minute},
+	"/v1/stats/rollup/series/:hash/:name/:timeframe": {TTL: time.Minute},
+	"/v1/address/:hash/balance/series/:timeframe":    {TTL: time.Minute},
+	"/v1/stats/holders":                              {TTL: time.Minute},
+	"/v1/stats/active_addresses":                     {TTL: time.Minute},
+	"/v1/stats/rollups/top":                          {TTL: time.Minute},
+	"/v1/stats/series/actions/:type/:timeframe":      {TTL: time.Minute},
+}
+
+func initCache(ctx context.Context, e *echo.Echo, cfg Config) {
 	observer := dispatcher.Observe(storage.ChannelHead)
 	endpointCache = cache.NewCache(cache.Config{
-		MaxEntitiesCount: 1000,
+		MaxBytes:         int64(cfg.ApiConfig.Cache.MaxSize) * 1024 * 1024,
+		MaxEntitiesCount: cfg.ApiConfig.Cache.MaxEntitiesCount,
+		Rules:            cacheRules,
 	}, observer)
+	if cfg.ApiConfig.Prometheus {
+		prometheus.MustRegister(endpointCache.Collectors()...)
+	}
+	e.Use(cache.HeadersMiddleware(endpointCache, cache.HeadersConfig{
+		MaxAge: time.Duration(cfg.Indexer.BlockPeriod) * time.Second,
+	}, headersSkipper))
 	e.Use(cache.Middleware(endpointCache, cacheSkipper))
 	endpointCache.Start(ctx)
 }
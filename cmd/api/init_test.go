@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/pkg/client"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testApiKey = "secret"
+
+var testRollupId = bytes.Repeat([]byte{0x19}, 32)
+
+func newTestServer(t *testing.T, db postgres.Storage) *httptest.Server {
+	cfg := Config{
+		Config: &config.Config{},
+		ApiConfig: ApiConfig{
+			ApiKey: testApiKey,
+		},
+	}
+
+	e := initEcho(cfg.ApiConfig, db, "test")
+	initHandlers(context.Background(), e, cfg, db)
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRollupMetadataRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := mock.NewMockIRollupMetadata(ctrl)
+	server := newTestServer(t, postgres.Storage{
+		RollupMetadata: metadata,
+	})
+	hash := base64.URLEncoding.EncodeToString(testRollupId)
+	link := server.URL + "/v1/rollup/" + hash + "/metadata"
+
+	t.Run("invalid api key", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPut, link, strings.NewReader(`{"name":"Flame"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(apiKeyHeader, "invalid")
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("put with api key", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPut, link, strings.NewReader(`{"name":"Flame"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(apiKeyHeader, testApiKey)
+
+		metadata.EXPECT().
+			Upsert(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("delete with api key", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodDelete, link, nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, testApiKey)
+
+		metadata.EXPECT().
+			DeleteByRollupId(gomock.Any(), testRollupId).
+			Return(nil).
+			Times(1)
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		require.Equal(t, http.StatusNoContent, response.StatusCode)
+	})
+}
+
+func TestClientRollupMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := mock.NewMockIRollupMetadata(ctrl)
+	server := newTestServer(t, postgres.Storage{
+		RollupMetadata: metadata,
+	})
+
+	c, err := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		ApiKey:     testApiKey,
+		MaxRetries: -1,
+	})
+	require.NoError(t, err)
+
+	hash := base64.URLEncoding.EncodeToString(testRollupId)
+
+	metadata.EXPECT().
+		Upsert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, m *storage.RollupMetadata) error {
+			require.Equal(t, testRollupId, m.RollupId)
+			require.Equal(t, "Flame Rollup", m.Name)
+			require.Equal(t, "evm", m.VmType)
+			return nil
+		}).
+		Times(1)
+
+	result, err := c.SetRollupMetadata(context.Background(), hash, client.RollupMetadataRequest{
+		Name:   "Flame Rollup",
+		VmType: "EVM",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Flame Rollup", result.Name)
+	require.Equal(t, "flame-rollup", result.Slug)
+
+	metadata.EXPECT().
+		DeleteByRollupId(gomock.Any(), testRollupId).
+		Return(nil).
+		Times(1)
+
+	err = c.DeleteRollupMetadata(context.Background(), hash)
+	require.NoError(t, err)
+}
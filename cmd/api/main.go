@@ -26,6 +26,10 @@ var rootCmd = &cobra.Command{
 // @host					api-dusk-5.astrotrek.io
 //
 // @query.collection.format	multi
+//
+// @securityDefinitions.apikey	ApiKeyAuth
+// @in							header
+// @name						X-API-Key
 func main() {
 	cfg, err := initConfig()
 	if err != nil {
@@ -43,10 +47,13 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 
 	db := initDatabase(cfg.Database, cfg.Indexer.ScriptsDir)
+	initRollupRegistry(ctx, cfg.ApiConfig, db)
 	e := initEcho(cfg.ApiConfig, db, cfg.Environment)
+	initKeysLimiter(ctx, e, cfg.ApiConfig, db)
 	initDispatcher(ctx, db)
 	initHandlers(ctx, e, *cfg, db)
-	initCache(ctx, e)
+	initGrpc(ctx, *cfg, db)
+	initCache(ctx, e, *cfg)
 
 	go func() {
 		if err := e.Start(cfg.ApiConfig.Bind); err != nil && errors.Is(err, http.ErrServerClosed) {
@@ -57,6 +64,11 @@ func main() {
 	<-ctx.Done()
 	cancel()
 
+	if grpcServer != nil {
+		if err := grpcServer.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
 	if wsManager != nil {
 		if err := wsManager.Close(); err != nil {
 			e.Logger.Fatal(err)
@@ -67,6 +79,11 @@ func main() {
 			e.Logger.Fatal(err)
 		}
 	}
+	if keysLimiter != nil {
+		if err := keysLimiter.Close(); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
 	if dispatcher != nil {
 		if err := dispatcher.Close(); err != nil {
 			e.Logger.Fatal(err)
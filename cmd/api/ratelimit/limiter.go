@@ -0,0 +1,391 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-io/workerpool"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	HeaderApiKey         = "X-API-Key"
+	QueryApiKey          = "api_key"
+	HeaderQuotaLimit     = "X-Quota-Limit"
+	HeaderQuotaRemaining = "X-Quota-Remaining"
+
+	defaultCacheTTL      = time.Minute
+	defaultFlushInterval = 10 * time.Second
+	defaultMaxKeys       = 10_000
+	defaultMaxAnonymous  = 100_000
+)
+
+var (
+	ErrInvalidKey      = errors.New("invalid api key")
+	ErrRevokedKey      = errors.New("api key is revoked")
+	ErrForbiddenGroup  = errors.New("api key does not allow requests to the endpoint")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrQuotaExceeded   = errors.New("daily quota is exceeded")
+)
+
+// Config - limits of requests. Zero anonymous values mean unlimited.
+type Config struct {
+	// AdminKey - key which is not limited. It's the key of administrative endpoints.
+	AdminKey            string
+	AnonymousRps        float64
+	AnonymousDailyQuota int64
+	// CacheTTL - interval of reloading key from database
+	CacheTTL time.Duration
+	// FlushInterval - interval of saving usage counters to database
+	FlushInterval time.Duration
+	// MaxKeys - limit of cached keys including unknown ones. The least recently used key is evicted when it's reached.
+	MaxKeys int
+	// MaxAnonymous - limit of tracked IP addresses of anonymous requests. The least recently seen address is evicted when it's reached.
+	MaxAnonymous int
+}
+
+type keyEntry struct {
+	key      storage.ApiKey
+	found    bool
+	limiter  *rate.Limiter
+	used     int64
+	loadedAt time.Time
+}
+
+type anonymousEntry struct {
+	limiter *rate.Limiter
+	used    int64
+}
+
+type usageKey struct {
+	keyId uint64
+	day   time.Time
+}
+
+// Limiter - limits requests by API keys stored in database. Requests without key are limited by IP address with anonymous tier.
+type Limiter struct {
+	keys storage.IApiKey
+	cfg  Config
+
+	mx        *sync.Mutex
+	day       time.Time
+	entries   *lru[*keyEntry]
+	anonymous *lru[*anonymousEntry]
+	pending   map[usageKey]int64
+
+	now func() time.Time
+	g   workerpool.Group
+}
+
+// NewLimiter -
+func NewLimiter(keys storage.IApiKey, cfg Config) *Limiter {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+	if cfg.MaxKeys <= 0 {
+		cfg.MaxKeys = defaultMaxKeys
+	}
+	if cfg.MaxAnonymous <= 0 {
+		cfg.MaxAnonymous = defaultMaxAnonymous
+	}
+	return &Limiter{
+		keys:      keys,
+		cfg:       cfg,
+		mx:        new(sync.Mutex),
+		entries:   newLRU[*keyEntry](cfg.MaxKeys),
+		anonymous: newLRU[*anonymousEntry](cfg.MaxAnonymous),
+		pending:   make(map[usageKey]int64),
+		now:       time.Now,
+		g:         workerpool.NewGroup(),
+	}
+}
+
+// Start - runs saving of usage counters
+func (l *Limiter) Start(ctx context.Context) {
+	l.g.GoCtx(ctx, l.flushThread)
+}
+
+// Close - waits the end of work and saves remaining usage counters
+func (l *Limiter) Close() error {
+	l.g.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return l.flush(ctx)
+}
+
+func (l *Limiter) flushThread(ctx context.Context) {
+	ticker := time.NewTicker(l.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.flush(ctx); err != nil {
+				log.Err(err).Msg("save api key usage")
+			}
+		}
+	}
+}
+
+func (l *Limiter) flush(ctx context.Context) error {
+	l.mx.Lock()
+	if len(l.pending) == 0 {
+		l.mx.Unlock()
+		return nil
+	}
+	pending := l.pending
+	l.pending = make(map[usageKey]int64)
+	l.mx.Unlock()
+
+	usage := make([]storage.ApiKeyUsage, 0, len(pending))
+	for key, requests := range pending {
+		usage = append(usage, storage.ApiKeyUsage{
+			KeyId:    key.keyId,
+			Day:      key.day,
+			Requests: requests,
+		})
+	}
+
+	if err := l.keys.SaveUsage(ctx, usage...); err != nil {
+		// return counters back to not lose them
+		l.mx.Lock()
+		for key, requests := range pending {
+			l.pending[key] += requests
+		}
+		l.mx.Unlock()
+		return err
+	}
+	return nil
+}
+
+// StripApiKey - returns URL without api key passed in query, so the key isn't written to logs and isn't a part of cache keys
+func StripApiKey(u *url.URL) *url.URL {
+	query := u.Query()
+	if !query.Has(QueryApiKey) {
+		return u
+	}
+	query.Del(QueryApiKey)
+
+	stripped := *u
+	stripped.RawQuery = query.Encode()
+	return &stripped
+}
+
+// Middleware - returns echo middleware which limits requests
+func (l *Limiter) Middleware(skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+
+			key := c.Request().Header.Get(HeaderApiKey)
+			if key == "" {
+				key = c.QueryParam(QueryApiKey)
+			}
+
+			var (
+				remaining int64
+				quota     int64
+				err       error
+			)
+			switch {
+			case key == "":
+				quota, remaining, err = l.allowAnonymous(c.RealIP())
+			case l.isAdmin(key):
+				return next(c)
+			default:
+				quota, remaining, err = l.allowKey(c.Request().Context(), key, EndpointGroup(c.Request().URL.Path))
+			}
+			if err != nil {
+				return echo.NewHTTPError(errorStatus(err), err.Error())
+			}
+
+			if quota > 0 {
+				header := c.Response().Header()
+				header.Set(HeaderQuotaLimit, strconv.FormatInt(quota, 10))
+				header.Set(HeaderQuotaRemaining, strconv.FormatInt(remaining, 10))
+			}
+			return next(c)
+		}
+	}
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrInvalidKey), errors.Is(err, ErrRevokedKey):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrForbiddenGroup):
+		return http.StatusForbidden
+	case errors.Is(err, ErrTooManyRequests), errors.Is(err, ErrQuotaExceeded):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (l *Limiter) isAdmin(key string) bool {
+	return l.cfg.AdminKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(l.cfg.AdminKey)) == 1
+}
+
+// EndpointGroup - returns group of endpoint which is the first path segment after API version. For example, `block` for `/v1/block/100`.
+func EndpointGroup(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 1 && parts[0] == "v1" {
+		return parts[1]
+	}
+	return parts[0]
+}
+
+// today - returns start of current day and resets daily counters if day was changed. Must be called under lock.
+func (l *Limiter) today() time.Time {
+	day := l.now().UTC().Truncate(24 * time.Hour)
+	if !day.Equal(l.day) {
+		l.day = day
+		l.anonymous.clear()
+		l.entries.each(func(id string, entry *keyEntry) {
+			if !entry.found && entry.loadedAt.Before(day) {
+				// unknown keys of the previous day aren't needed anymore
+				l.entries.remove(id)
+				return
+			}
+			entry.used = 0
+		})
+	}
+	return day
+}
+
+func (l *Limiter) allowAnonymous(ip string) (int64, int64, error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.today()
+
+	entry, ok := l.anonymous.get(ip)
+	if !ok {
+		entry = &anonymousEntry{
+			limiter: newRateLimiter(l.cfg.AnonymousRps),
+		}
+		l.anonymous.set(ip, entry)
+	}
+	if !entry.limiter.Allow() {
+		return 0, 0, ErrTooManyRequests
+	}
+	quota := l.cfg.AnonymousDailyQuota
+	if quota > 0 && entry.used >= quota {
+		return quota, 0, ErrQuotaExceeded
+	}
+	entry.used++
+	return quota, quota - entry.used, nil
+}
+
+func (l *Limiter) allowKey(ctx context.Context, key, group string) (int64, int64, error) {
+	hash := storage.HashApiKey(key)
+	id := hex.EncodeToString(hash)
+
+	l.mx.Lock()
+	entry, ok := l.entries.get(id)
+	stale := !ok || l.now().Sub(entry.loadedAt) > l.cfg.CacheTTL
+	l.mx.Unlock()
+
+	if stale {
+		loaded, err := l.load(ctx, hash)
+		if err != nil {
+			return 0, 0, err
+		}
+		l.mx.Lock()
+		if ok && entry.limiter.Limit() == loaded.limiter.Limit() {
+			// keep state of the limiter between reloads
+			loaded.limiter = entry.limiter
+		}
+		l.entries.set(id, loaded)
+		entry = loaded
+		l.mx.Unlock()
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	day := l.today()
+	switch {
+	case !entry.found:
+		return 0, 0, ErrInvalidKey
+	case entry.key.IsRevoked():
+		return 0, 0, ErrRevokedKey
+	case !entry.key.HasGroup(group):
+		return 0, 0, errors.Wrap(ErrForbiddenGroup, group)
+	case !entry.limiter.Allow():
+		return 0, 0, ErrTooManyRequests
+	}
+
+	quota := entry.key.DailyQuota
+	if quota > 0 && entry.used >= quota {
+		return quota, 0, ErrQuotaExceeded
+	}
+	entry.used++
+	l.pending[usageKey{entry.key.Id, day}]++
+	return quota, quota - entry.used, nil
+}
+
+// load - receives key and its today usage from database. Unknown keys are cached too to not request database every time.
+func (l *Limiter) load(ctx context.Context, hash []byte) (*keyEntry, error) {
+	entry := &keyEntry{
+		loadedAt: l.now(),
+		limiter:  rate.NewLimiter(0, 0),
+	}
+
+	key, err := l.keys.ByHash(ctx, hash)
+	if err != nil {
+		if l.keys.IsNoRows(err) {
+			return entry, nil
+		}
+		return nil, errors.Wrap(err, "receiving api key")
+	}
+	entry.key = key
+	entry.found = true
+	entry.limiter = newRateLimiter(key.Rps)
+
+	l.mx.Lock()
+	day := l.today()
+	pending := l.pending[usageKey{key.Id, day}]
+	l.mx.Unlock()
+
+	used, err := l.keys.Usage(ctx, key.Id, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "receiving api key usage")
+	}
+	entry.used = used + pending
+	return entry, nil
+}
+
+func newRateLimiter(rps float64) *rate.Limiter {
+	if rps <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	burst := int(rps)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
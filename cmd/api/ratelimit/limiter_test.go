@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testDay = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// LimiterTestSuite -
+type LimiterTestSuite struct {
+	suite.Suite
+
+	keys *mock.MockIApiKey
+	ctrl *gomock.Controller
+	echo *echo.Echo
+}
+
+// SetupTest -
+func (s *LimiterTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.keys = mock.NewMockIApiKey(s.ctrl)
+	s.echo = echo.New()
+}
+
+// TearDownTest -
+func (s *LimiterTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSuiteLimiter_Run(t *testing.T) {
+	suite.Run(t, new(LimiterTestSuite))
+}
+
+func (s *LimiterTestSuite) newLimiter(cfg Config) *Limiter {
+	limiter := NewLimiter(s.keys, cfg)
+	limiter.now = func() time.Time {
+		return testDay.Add(time.Hour)
+	}
+	return limiter
+}
+
+func (s *LimiterTestSuite) request(limiter *Limiter, path, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if key != "" {
+		req.Header.Set(HeaderApiKey, key)
+	}
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	handler := limiter.Middleware(nil)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	if err := handler(c); err != nil {
+		s.echo.HTTPErrorHandler(err, c)
+	}
+	return rec
+}
+
+func (s *LimiterTestSuite) expectKey(key storage.ApiKey, used int64) {
+	s.keys.EXPECT().
+		ByHash(gomock.Any(), storage.HashApiKey("secret")).
+		Return(key, nil).
+		Times(1)
+	s.keys.EXPECT().
+		Usage(gomock.Any(), key.Id, testDay).
+		Return(used, nil).
+		Times(1)
+}
+
+func (s *LimiterTestSuite) TestValidKey() {
+	s.expectKey(storage.ApiKey{
+		Id:         1,
+		Rps:        10,
+		DailyQuota: 100,
+		Groups:     []string{"block"},
+	}, 10)
+
+	limiter := s.newLimiter(Config{})
+
+	rec := s.request(limiter, "/v1/block/100", "secret")
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("100", rec.Header().Get(HeaderQuotaLimit))
+	s.Require().Equal("89", rec.Header().Get(HeaderQuotaRemaining))
+
+	rec = s.request(limiter, "/v1/tx", "secret")
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+
+	s.keys.EXPECT().
+		SaveUsage(gomock.Any(), storage.ApiKeyUsage{
+			KeyId:    1,
+			Day:      testDay,
+			Requests: 1,
+		}).
+		Return(nil).
+		Times(1)
+	s.Require().NoError(limiter.flush(context.Background()))
+	s.Require().Empty(limiter.pending)
+}
+
+func (s *LimiterTestSuite) TestUnknownKey() {
+	s.keys.EXPECT().
+		ByHash(gomock.Any(), storage.HashApiKey("secret")).
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		Times(1)
+	s.keys.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	limiter := s.newLimiter(Config{})
+	for i := 0; i < 2; i++ {
+		rec := s.request(limiter, "/v1/block", "secret")
+		s.Require().Equal(http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func (s *LimiterTestSuite) TestUnknownKeysBounded() {
+	s.keys.EXPECT().
+		ByHash(gomock.Any(), gomock.Any()).
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		Times(5)
+	s.keys.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(5)
+
+	limiter := s.newLimiter(Config{MaxKeys: 2})
+	for i := 0; i < 5; i++ {
+		rec := s.request(limiter, "/v1/block", fmt.Sprintf("random-%d", i))
+		s.Require().Equal(http.StatusUnauthorized, rec.Code)
+	}
+	s.Require().Equal(2, limiter.entries.len())
+}
+
+func (s *LimiterTestSuite) TestRevokedKey() {
+	revokedAt := testDay
+	s.keys.EXPECT().
+		ByHash(gomock.Any(), storage.HashApiKey("secret")).
+		Return(storage.ApiKey{Id: 1, RevokedAt: &revokedAt}, nil).
+		Times(1)
+	s.keys.EXPECT().
+		Usage(gomock.Any(), uint64(1), testDay).
+		Return(int64(0), nil).
+		Times(1)
+
+	rec := s.request(s.newLimiter(Config{}), "/v1/block", "secret")
+	s.Require().Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (s *LimiterTestSuite) TestKeyLimits() {
+	s.expectKey(storage.ApiKey{
+		Id:         1,
+		Rps:        2,
+		DailyQuota: 11,
+	}, 10)
+
+	limiter := s.newLimiter(Config{})
+
+	rec := s.request(limiter, "/v1/block", "secret")
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("0", rec.Header().Get(HeaderQuotaRemaining))
+
+	rec = s.request(limiter, "/v1/block", "secret")
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code, "quota")
+
+	rec = s.request(limiter, "/v1/block", "secret")
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code, "rps")
+}
+
+func (s *LimiterTestSuite) TestAnonymous() {
+	limiter := s.newLimiter(Config{
+		AnonymousRps:        1,
+		AnonymousDailyQuota: 10,
+	})
+
+	rec := s.request(limiter, "/v1/block", "")
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("9", rec.Header().Get(HeaderQuotaRemaining))
+
+	rec = s.request(limiter, "/v1/block", "")
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+	s.Require().Empty(limiter.pending)
+}
+
+func (s *LimiterTestSuite) TestAnonymousBounded() {
+	limiter := s.newLimiter(Config{
+		AnonymousRps: 1,
+		MaxAnonymous: 2,
+	})
+
+	for i := 0; i < 5; i++ {
+		_, _, err := limiter.allowAnonymous(fmt.Sprintf("10.0.0.%d", i))
+		s.Require().NoError(err)
+	}
+	s.Require().Equal(2, limiter.anonymous.len())
+}
+
+func (s *LimiterTestSuite) TestAdminKey() {
+	limiter := s.newLimiter(Config{
+		AdminKey:     "admin",
+		AnonymousRps: 1,
+	})
+
+	for i := 0; i < 5; i++ {
+		rec := s.request(limiter, "/v1/watchlists", "admin")
+		s.Require().Equal(http.StatusOK, rec.Code)
+	}
+}
+
+func (s *LimiterTestSuite) TestDayChange() {
+	s.expectKey(storage.ApiKey{
+		Id:         1,
+		DailyQuota: 1,
+	}, 1)
+
+	limiter := s.newLimiter(Config{})
+
+	rec := s.request(limiter, "/v1/block", "secret")
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+
+	limiter.now = func() time.Time {
+		return testDay.Add(25 * time.Hour)
+	}
+	limiter.cfg.CacheTTL = 24 * time.Hour
+
+	rec = s.request(limiter, "/v1/block", "secret")
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func TestEndpointGroup(t *testing.T) {
+	for path, want := range map[string]string{
+		"/v1/block/100":      "block",
+		"/v1/address/abc/tx": "address",
+		"/v1/head":           "head",
+		"/v1":                "v1",
+		"/metrics":           "metrics",
+	} {
+		if got := EndpointGroup(path); got != want {
+			t.Errorf("%s: got %s, want %s", path, got, want)
+		}
+	}
+}
+
+func TestStripApiKey(t *testing.T) {
+	for uri, want := range map[string]string{
+		"/v1/block?api_key=secret":          "/v1/block",
+		"/v1/block?limit=10&api_key=secret": "/v1/block?limit=10",
+		"/v1/block?sort=desc&limit=10":      "/v1/block?sort=desc&limit=10",
+	} {
+		u, err := url.Parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := StripApiKey(u).String(); got != want {
+			t.Errorf("%s: got %s, want %s", uri, got, want)
+		}
+	}
+}
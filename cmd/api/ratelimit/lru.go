@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import "container/list"
+
+type lruItem[V any] struct {
+	key   string
+	value V
+}
+
+// lru - map with limited count of entries. The least recently used entry is evicted when the limit is reached.
+// It isn't thread-safe: limiter uses it under its own lock.
+type lru[V any] struct {
+	max int
+	m   map[string]*list.Element
+	l   *list.List
+}
+
+func newLRU[V any](max int) *lru[V] {
+	return &lru[V]{
+		max: max,
+		m:   make(map[string]*list.Element),
+		l:   list.New(),
+	}
+}
+
+func (c *lru[V]) get(key string) (value V, ok bool) {
+	elem, ok := c.m[key]
+	if !ok {
+		return
+	}
+	c.l.MoveToFront(elem)
+	return elem.Value.(*lruItem[V]).value, true
+}
+
+func (c *lru[V]) set(key string, value V) {
+	if elem, ok := c.m[key]; ok {
+		elem.Value.(*lruItem[V]).value = value
+		c.l.MoveToFront(elem)
+		return
+	}
+
+	c.m[key] = c.l.PushFront(&lruItem[V]{key: key, value: value})
+	for c.max > 0 && c.l.Len() > c.max {
+		c.remove(c.l.Back().Value.(*lruItem[V]).key)
+	}
+}
+
+func (c *lru[V]) remove(key string) {
+	if elem, ok := c.m[key]; ok {
+		c.l.Remove(elem)
+		delete(c.m, key)
+	}
+}
+
+func (c *lru[V]) len() int {
+	return c.l.Len()
+}
+
+// each - calls handler for every entry. Handler may remove the entry it receives.
+func (c *lru[V]) each(handler func(key string, value V)) {
+	for elem := c.l.Front(); elem != nil; {
+		next := elem.Next()
+		item := elem.Value.(*lruItem[V])
+		handler(item.key, item.value)
+		elem = next
+	}
+}
+
+func (c *lru[V]) clear() {
+	c.m = make(map[string]*list.Element)
+	c.l.Init()
+}
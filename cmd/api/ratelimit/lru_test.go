@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	c := newLRU[int](2)
+	c.set("a", 1)
+	c.set("b", 2)
+
+	value, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, value)
+
+	// `b` is the least recently used entry
+	c.set("c", 3)
+	require.Equal(t, 2, c.len())
+	_, ok = c.get("b")
+	require.False(t, ok)
+
+	c.each(func(key string, value int) {
+		if key == "a" {
+			c.remove(key)
+		}
+	})
+	_, ok = c.get("a")
+	require.False(t, ok)
+	require.Equal(t, 1, c.len())
+
+	c.clear()
+	require.Equal(t, 0, c.len())
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
 	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 )
@@ -48,14 +49,20 @@ func handleSentry(next echo.HandlerFunc) echo.HandlerFunc {
 		}()
 
 		req = req.WithContext(transaction.Context())
+
+		// api key is stripped from the request which is sent to sentry
+		redacted := req.Clone(req.Context())
+		redacted.URL = ratelimit.StripApiKey(req.URL)
+		redacted.RequestURI = redacted.URL.RequestURI()
+
 		sentry.ConfigureScope(func(scope *sentry.Scope) {
 			scope.SetUser(sentry.User{
 				IPAddress: ctx.RealIP(),
 			})
-			scope.SetRequest(req)
+			scope.SetRequest(redacted)
 		})
 
-		defer recoverWithSentry(hub, req)
+		defer recoverWithSentry(hub, redacted)
 
 		ctx.SetRequest(req)
 		return next(ctx)
@@ -0,0 +1,13 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS balance_update_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		balance_update.address_id as address_id,
+		balance_update.currency as currency,
+		sum(update) as change,
+		count(*) as updates_count
+	from balance_update
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('balance_update_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -0,0 +1,13 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS balance_update_by_month
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 month'::interval, balance_update_by_day.ts) AS ts,
+		balance_update_by_day.address_id as address_id,
+		balance_update_by_day.currency as currency,
+		sum(change) as change,
+		sum(updates_count) as updates_count
+	from balance_update_by_day
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('balance_update_by_month', INTERVAL '1 minute', INTERVAL '1 hour');
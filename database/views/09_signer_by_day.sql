@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS signer_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, time) AS ts,
+		tx.signer_id as address_id,
+		count(*) as tx_count
+	from tx
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('signer_by_day', INTERVAL '1 minute', INTERVAL '1 minute');
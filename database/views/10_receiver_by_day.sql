@@ -0,0 +1,12 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS receiver_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, time) AS ts,
+		balance_update.address_id as address_id,
+		count(*) as updates_count
+	from balance_update
+	where balance_update.update > 0
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('receiver_by_day', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -0,0 +1,12 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS rollup_sender_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		rollup_action.rollup_id as rollup_id,
+		rollup_action.sender_id as sender_id,
+		count(*) as actions_count
+	from rollup_action
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('rollup_sender_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
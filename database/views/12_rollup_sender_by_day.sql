@@ -0,0 +1,12 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS rollup_sender_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, rollup_sender_by_hour.ts) AS ts,
+		rollup_sender_by_hour.rollup_id as rollup_id,
+		rollup_sender_by_hour.sender_id as sender_id,
+		sum(actions_count) as actions_count
+	from rollup_sender_by_hour
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('rollup_sender_by_day', INTERVAL '1 minute', INTERVAL '1 minute');
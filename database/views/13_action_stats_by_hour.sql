@@ -0,0 +1,12 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS action_stats_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		action.type as type,
+		count(*) as actions_count,
+		sum((action.data->>'amount')::numeric) as volume
+	from action
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('action_stats_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
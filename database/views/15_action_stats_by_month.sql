@@ -0,0 +1,12 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS action_stats_by_month
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 month'::interval, action_stats_by_day.ts) AS ts,
+		action_stats_by_day.type as type,
+		sum(actions_count) as actions_count,
+		sum(volume) as volume
+	from action_stats_by_day
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('action_stats_by_month', INTERVAL '1 minute', INTERVAL '1 hour');
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const rollupIdLength = 32
+
+var (
+	slugRegexp    = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	notSlugSymbol = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Registry - versioned list of known rollups. Metadata in the database is replaced by the registry only if its version is greater.
+type Registry struct {
+	Version uint64   `json:"version" yaml:"version"`
+	Rollups []Rollup `json:"rollups" yaml:"rollups"`
+}
+
+// Rollup - registry entry
+type Rollup struct {
+	Id          string `json:"id"          yaml:"id"`
+	Name        string `json:"name"        yaml:"name"`
+	Slug        string `json:"slug"        yaml:"slug"`
+	Website     string `json:"website"     yaml:"website"`
+	Logo        string `json:"logo"        yaml:"logo"`
+	Description string `json:"description" yaml:"description"`
+	VmType      string `json:"vm_type"     yaml:"vm_type"`
+	Contact     string `json:"contact"     yaml:"contact"`
+}
+
+// Load - reads registry from file. JSON is expected for files with `.json` extension and YAML otherwise.
+func Load(filename string) (Registry, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return Registry{}, errors.Wrap(err, "read registry")
+	}
+
+	var registry Registry
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		err = json.Unmarshal(data, &registry)
+	} else {
+		err = yaml.Unmarshal(data, &registry)
+	}
+	if err != nil {
+		return Registry{}, errors.Wrap(err, "decode registry")
+	}
+	return registry, nil
+}
+
+// Metadata - validates registry and converts it to storage models
+func (r Registry) Metadata(now time.Time) ([]*storage.RollupMetadata, error) {
+	if r.Version == 0 {
+		return nil, errors.New("registry version should be positive")
+	}
+
+	var (
+		metadata = make([]*storage.RollupMetadata, len(r.Rollups))
+		ids      = make(map[string]struct{}, len(r.Rollups))
+		slugs    = make(map[string]struct{}, len(r.Rollups))
+	)
+	for i := range r.Rollups {
+		m, err := r.Rollups[i].Metadata()
+		if err != nil {
+			return nil, errors.Wrapf(err, "rollup #%d", i)
+		}
+		if _, ok := ids[string(m.RollupId)]; ok {
+			return nil, errors.Errorf("rollup #%d: duplicate id %s", i, r.Rollups[i].Id)
+		}
+		ids[string(m.RollupId)] = struct{}{}
+		if _, ok := slugs[m.Slug]; ok {
+			return nil, errors.Errorf("rollup #%d: duplicate slug %s", i, m.Slug)
+		}
+		slugs[m.Slug] = struct{}{}
+
+		m.Version = r.Version
+		m.CreatedAt = now
+		m.UpdatedAt = now
+		metadata[i] = m
+	}
+	return metadata, nil
+}
+
+// Metadata - validates entry and converts it to storage model. Slug is derived from the name if it's empty.
+func (r Rollup) Metadata() (*storage.RollupMetadata, error) {
+	rollupId, err := DecodeRollupId(r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(r.Name)
+	if name == "" {
+		return nil, errors.New("empty name")
+	}
+
+	slug := r.Slug
+	if slug == "" {
+		slug = Slug(name)
+	}
+	if !slugRegexp.MatchString(slug) {
+		return nil, errors.Errorf("invalid slug: %s", slug)
+	}
+
+	return &storage.RollupMetadata{
+		RollupId:    rollupId,
+		Name:        name,
+		Slug:        slug,
+		Website:     r.Website,
+		Logo:        r.Logo,
+		Description: r.Description,
+		VmType:      strings.ToLower(r.VmType),
+		Contact:     r.Contact,
+	}, nil
+}
+
+// DecodeRollupId - decodes base64 or base64url encoded rollup identity
+func DecodeRollupId(id string) ([]byte, error) {
+	for _, encoding := range []*base64.Encoding{
+		base64.URLEncoding,
+		base64.RawURLEncoding,
+		base64.StdEncoding,
+		base64.RawStdEncoding,
+	} {
+		if rollupId, err := encoding.DecodeString(id); err == nil {
+			if len(rollupId) != rollupIdLength {
+				return nil, errors.Errorf("invalid rollup id length: %s", id)
+			}
+			return rollupId, nil
+		}
+	}
+	return nil, errors.Errorf("invalid rollup id: %s", id)
+}
+
+// Slug - converts name to URL-friendly form
+func Slug(name string) string {
+	return strings.Trim(notSlugSymbol.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		registry, err := Load("testdata/registry.yml")
+		require.NoError(t, err)
+		require.EqualValues(t, 2, registry.Version)
+		require.Len(t, registry.Rollups, 2)
+
+		now := time.Now().UTC()
+		metadata, err := registry.Metadata(now)
+		require.NoError(t, err)
+		require.Len(t, metadata, 2)
+
+		require.Equal(t, "19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", hex.EncodeToString(metadata[0].RollupId))
+		require.Equal(t, "Flame", metadata[0].Name)
+		require.Equal(t, "flame", metadata[0].Slug)
+		require.Equal(t, "evm", metadata[0].VmType)
+		require.Equal(t, "https://example.com", metadata[0].Website)
+		require.EqualValues(t, 2, metadata[0].Version)
+		require.Equal(t, now, metadata[0].CreatedAt)
+
+		require.Equal(t, "f69ac0156da05bc30d82e516641be86c8fbee5ad8f38ca2b1c4c145249dde6a3", hex.EncodeToString(metadata[1].RollupId))
+		require.Equal(t, "second", metadata[1].Slug)
+		require.Equal(t, "team@example.com", metadata[1].Contact)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		registry, err := Load("testdata/registry.json")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, registry.Version)
+		require.Len(t, registry.Rollups, 1)
+		require.Equal(t, "Flame", registry.Rollups[0].Name)
+	})
+
+	t.Run("unknown file", func(t *testing.T) {
+		_, err := Load("testdata/unknown.yml")
+		require.Error(t, err)
+	})
+}
+
+func TestRegistryMetadataErrors(t *testing.T) {
+	const id = "GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk="
+
+	tests := []struct {
+		name     string
+		registry Registry
+	}{
+		{
+			name: "zero version",
+			registry: Registry{
+				Rollups: []Rollup{{Id: id, Name: "name"}},
+			},
+		}, {
+			name: "invalid id",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{{Id: "invalid", Name: "name"}},
+			},
+		}, {
+			name: "short id",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{{Id: "AAAA", Name: "name"}},
+			},
+		}, {
+			name: "empty name",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{{Id: id}},
+			},
+		}, {
+			name: "invalid slug",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{{Id: id, Name: "name", Slug: "Invalid Slug"}},
+			},
+		}, {
+			name: "duplicate id",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{{Id: id, Name: "first"}, {Id: id, Name: "second"}},
+			},
+		}, {
+			name: "duplicate slug",
+			registry: Registry{
+				Version: 1,
+				Rollups: []Rollup{
+					{Id: id, Name: "first"},
+					{Id: "9prAFW2gW8MNguUWZBvobI--5a2POMorHEwUUknd5qM=", Name: "First"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.registry.Metadata(time.Now())
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Flame", want: "flame"},
+		{name: "  Second   Rollup! ", want: "second-rollup"},
+		{name: "rollup_v2.0", want: "rollup-v2-0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Slug(tt.name))
+		})
+	}
+}
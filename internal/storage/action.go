@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
@@ -21,14 +22,28 @@ type IAction interface {
 	ByTxId(ctx context.Context, txId uint64, limit, offset int) ([]Action, error)
 	ByBlock(ctx context.Context, height pkgTypes.Level, limit, offset int) ([]ActionWithTx, error)
 	ByAddress(ctx context.Context, addressId uint64, filters AddressActionsFilter) ([]AddressAction, error)
-	ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort storage.SortOrder) ([]RollupAction, error)
+	ByRollup(ctx context.Context, rollupId uint64, fltrs RollupActionsFilter) ([]RollupAction, error)
+	StreamByAddress(ctx context.Context, addressId uint64, filters AddressActionsFilter, fn func(AddressAction) error) error
+	StreamByRollup(ctx context.Context, rollupId uint64, fltrs RollupActionsFilter, fn func(RollupAction) error) error
 }
 
 type AddressActionsFilter struct {
 	Limit       int
 	Offset      int
 	Sort        storage.SortOrder
+	Cursor      Cursor
 	ActionTypes types.ActionTypeMask
+	TimeFrom    time.Time
+	TimeTo      time.Time
+}
+
+type RollupActionsFilter struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	Cursor   Cursor
+	TimeFrom time.Time
+	TimeTo   time.Time
 }
 
 type ActionWithTx struct {
@@ -60,3 +75,23 @@ type Action struct {
 func (Action) TableName() string {
 	return "action"
 }
+
+// Columns - list of columns used by COPY
+func (Action) Columns() []string {
+	return []string{
+		"id", "height", "time", "position", "type", "tx_id", "data",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (a Action) Flat() []any {
+	var data any
+	if a.Data != nil {
+		if raw, err := json.Marshal(a.Data); err == nil {
+			data = string(raw)
+		}
+	}
+	return []any{
+		a.Id, a.Height, a.Time, a.Position, a.Type, a.TxId, data,
+	}
+}
@@ -25,6 +25,7 @@ type IAddress interface {
 
 	ByHash(ctx context.Context, hash []byte) (Address, error)
 	ListWithBalance(ctx context.Context, fltrs AddressListFilter) ([]Address, error)
+	ByIds(ctx context.Context, ids ...uint64) ([]Address, error)
 }
 
 // Address -
@@ -29,3 +29,17 @@ type AddressAction struct {
 func (AddressAction) TableName() string {
 	return "address_action"
 }
+
+// Columns - list of columns used by COPY
+func (AddressAction) Columns() []string {
+	return []string{
+		"address_id", "action_id", "tx_id", "action_type", "time", "height",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (aa AddressAction) Flat() []any {
+	return []any{
+		aa.AddressId, aa.ActionId, aa.TxId, aa.ActionType, aa.Time, aa.Height,
+	}
+}
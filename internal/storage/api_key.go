@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IApiKey interface {
+	storage.Table[*ApiKey]
+
+	ByHash(ctx context.Context, hash []byte) (ApiKey, error)
+	Revoke(ctx context.Context, id uint64) error
+	Usage(ctx context.Context, keyId uint64, day time.Time) (int64, error)
+	SaveUsage(ctx context.Context, usage ...ApiKeyUsage) error
+}
+
+// ApiKey - key which identifies API consumer and describes its limits. Only hash of the key is stored.
+type ApiKey struct {
+	bun.BaseModel `bun:"api_key" comment:"Table with API keys"`
+
+	Id            uint64     `bun:"id,pk,notnull,autoincrement"      comment:"Unique internal identity"`
+	CreatedAt     time.Time  `bun:"created_at,notnull"               comment:"Creation time"`
+	RevokedAt     *time.Time `bun:"revoked_at"                       comment:"Revocation time. Key is active if it's empty"`
+	LastUsedAt    *time.Time `bun:"last_used_at"                     comment:"Time of the last accounted request"`
+	Name          string     `bun:"name,type:text"                   comment:"Human-readable name of key owner"`
+	Prefix        string     `bun:"prefix,notnull"                   comment:"First symbols of the key which identify it for humans"`
+	Hash          []byte     `bun:"hash,unique,notnull"              comment:"SHA-256 hash of the key"`
+	Rps           float64    `bun:"rps,notnull"                      comment:"Allowed count of requests per second"`
+	DailyQuota    int64      `bun:"daily_quota,notnull"              comment:"Allowed count of requests per day. Zero means unlimited"`
+	Groups        []string   `bun:"groups,array"                     comment:"Allowed endpoint groups. Empty means all groups"`
+	TotalRequests int64      `bun:"total_requests,notnull,default:0" comment:"Total count of accounted requests"`
+}
+
+// TableName -
+func (ApiKey) TableName() string {
+	return "api_key"
+}
+
+// IsRevoked -
+func (k ApiKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// HasGroup - returns true if key allows requests to the endpoint group
+func (k ApiKey) HasGroup(group string) bool {
+	if len(k.Groups) == 0 {
+		return true
+	}
+	for i := range k.Groups {
+		if k.Groups[i] == group {
+			return true
+		}
+	}
+	return false
+}
+
+// HashApiKey - returns hash of the key which is stored in database
+func HashApiKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+// ApiKeyUsage - count of requests made with API key during a day
+type ApiKeyUsage struct {
+	bun.BaseModel `bun:"api_key_usage" comment:"Table with daily usage of API keys"`
+
+	KeyId    uint64    `bun:"key_id,pk"        comment:"API key internal identity"`
+	Day      time.Time `bun:"day,pk"           comment:"Day of usage"`
+	Requests int64     `bun:"requests,notnull" comment:"Count of requests"`
+}
+
+// TableName -
+func (ApiKeyUsage) TableName() string {
+	return "api_key_usage"
+}
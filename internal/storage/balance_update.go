@@ -4,6 +4,9 @@
 package storage
 
 import (
+	"context"
+	"time"
+
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/shopspring/decimal"
@@ -13,6 +16,10 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IBalanceUpdate interface {
 	storage.Table[*BalanceUpdate]
+
+	BalancesAtHeight(ctx context.Context, addressId uint64, height pkgTypes.Level) ([]Balance, error)
+	BalancesAtTime(ctx context.Context, addressId uint64, t time.Time) ([]Balance, error)
+	Series(ctx context.Context, addressId uint64, currency string, timeframe Timeframe, req SeriesRequest) ([]BalanceSeriesItem, error)
 }
 
 type BalanceUpdate struct {
@@ -20,6 +27,7 @@ type BalanceUpdate struct {
 
 	Id        uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
 	Height    pkgTypes.Level  `bun:",notnull"                    comment:"The number (height) of this block"`
+	Time      time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
 	AddressId uint64          `bun:"address_id"                  comment:"Address internal identity"`
 	Update    decimal.Decimal `bun:"update,type:numeric"         comment:"Balance update"`
 	Currency  string          `bun:"currency"                    comment:"Currency"`
@@ -30,3 +38,25 @@ type BalanceUpdate struct {
 func (BalanceUpdate) TableName() string {
 	return "balance_update"
 }
+
+// Columns - list of columns used by COPY
+func (BalanceUpdate) Columns() []string {
+	return []string{
+		"height", "time", "address_id", "update", "currency",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (bu BalanceUpdate) Flat() []any {
+	return []any{
+		bu.Height, bu.Time, bu.AddressId, bu.Update, bu.Currency,
+	}
+}
+
+// BalanceSeriesItem - balance of address at the end of time bucket and its change during the bucket
+type BalanceSeriesItem struct {
+	Time    time.Time       `bun:"ts"`
+	Change  decimal.Decimal `bun:"change"`
+	Balance decimal.Decimal `bun:"balance"`
+	Count   int64           `bun:"updates_count"`
+}
@@ -22,10 +22,18 @@ type IBlock interface {
 	ByHeight(ctx context.Context, height pkgTypes.Level, withStats bool) (Block, error)
 	ByHash(ctx context.Context, hash []byte) (Block, error)
 	ByProposer(ctx context.Context, proposerId uint64, limit, offset int, order storage.SortOrder) ([]Block, error)
-	ListWithStats(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*Block, error)
+	Filter(ctx context.Context, fltrs BlockListFilter) ([]*Block, error)
 	ByIdWithRelations(ctx context.Context, id uint64) (Block, error)
 }
 
+type BlockListFilter struct {
+	Limit     int
+	Offset    int
+	Sort      storage.SortOrder
+	Cursor    Cursor
+	WithStats bool
+}
+
 // Block -
 type Block struct {
 	bun.BaseModel `bun:"table:block" comment:"Table with blocks"`
@@ -55,6 +63,7 @@ type Block struct {
 	Rollups         map[string]*Rollup        `bun:"-"` // internal field for saving rollups
 	RollupAddress   map[string]*RollupAddress `bun:"-"` // internal field for saving rollup address
 	BlockSignatures []BlockSignature          `bun:"-"` // internal field for saving block signatures
+	Raw             *RawBlock                 `bun:"-"` // internal field for saving raw block
 
 	Txs      []*Tx       `bun:"rel:has-many"`
 	Stats    *BlockStats `bun:"rel:has-one,join:height=height"`
@@ -65,3 +74,29 @@ type Block struct {
 func (Block) TableName() string {
 	return "block"
 }
+
+// Columns - list of columns used by COPY
+func (Block) Columns() []string {
+	return []string{
+		"id", "height", "time", "version_block", "version_app",
+		"hash", "parent_hash", "last_commit_hash", "data_hash",
+		"validators_hash", "next_validators_hash", "consensus_hash",
+		"app_hash", "last_results_hash", "evidence_hash", "proposer_id",
+		"action_types",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (b Block) Flat() []any {
+	var proposerId any
+	if b.ProposerId > 0 {
+		proposerId = b.ProposerId
+	}
+	return []any{
+		b.Id, b.Height, b.Time, b.VersionBlock, b.VersionApp,
+		b.Hash, b.ParentHash, b.LastCommitHash, b.DataHash,
+		b.ValidatorsHash, b.NextValidatorsHash, b.ConsensusHash,
+		b.AppHash, b.LastResultsHash, b.EvidenceHash, proposerId,
+		b.ActionTypes,
+	}
+}
@@ -33,3 +33,17 @@ type BlockSignature struct {
 func (BlockSignature) TableName() string {
 	return "block_signature"
 }
+
+// Columns - list of columns used by COPY
+func (BlockSignature) Columns() []string {
+	return []string{
+		"height", "time", "validator_id",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (bs BlockSignature) Flat() []any {
+	return []any{
+		bs.Height, bs.Time, bs.ValidatorId,
+	}
+}
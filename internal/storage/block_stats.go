@@ -15,6 +15,7 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IBlockStats interface {
 	ByHeight(ctx context.Context, height pkgTypes.Level) (stats BlockStats, err error)
+	ByHeights(ctx context.Context, heights ...pkgTypes.Level) (stats []BlockStats, err error)
 }
 
 type BlockStats struct {
@@ -37,3 +38,19 @@ type BlockStats struct {
 func (BlockStats) TableName() string {
 	return "block_stats"
 }
+
+// Columns - list of columns used by COPY
+func (BlockStats) Columns() []string {
+	return []string{
+		"height", "time", "tx_count", "block_time", "gas_wanted", "gas_used",
+		"supply_change", "fee", "bytes_in_block", "data_size",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (s BlockStats) Flat() []any {
+	return []any{
+		s.Height, s.Time, s.TxCount, s.BlockTime, s.GasWanted, s.GasUsed,
+		s.SupplyChange, s.Fee, s.BytesInBlock, s.DataSize,
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import "time"
+
+// Cursor - position of the last received entity for keyset pagination over (time, id) pair
+type Cursor struct {
+	Time time.Time
+	Id   uint64
+}
+
+// NewCursor -
+func NewCursor(t time.Time, id uint64) Cursor {
+	return Cursor{
+		Time: t,
+		Id:   id,
+	}
+}
+
+// IsEmpty - returns true if cursor points to the start of the list
+func (c Cursor) IsEmpty() bool {
+	return c.Id == 0 && c.Time.IsZero()
+}
@@ -16,6 +16,7 @@ const (
 	ChannelBlock = "blocks"
 	ChannelHead  = "head"
 	ChannelTx    = "tx"
+	ChannelAlert = "watchlist_alert"
 )
 
 var Models = []any{
@@ -23,17 +24,26 @@ var Models = []any{
 	&Constant{},
 	&Balance{},
 	&BalanceUpdate{},
+	&HolderBucket{},
 	&Address{},
 	&Block{},
 	&BlockStats{},
 	&Tx{},
+	&RawTx{},
+	&RawBlock{},
 	&Action{},
 	&Validator{},
 	&Rollup{},
+	&RollupMetadata{},
 	&RollupAction{},
 	&RollupAddress{},
 	&AddressAction{},
 	&BlockSignature{},
+	&Outbox{},
+	&Watchlist{},
+	&WatchlistAlert{},
+	&ApiKey{},
+	&ApiKeyUsage{},
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -51,8 +61,11 @@ type Transaction interface {
 	SaveRollupAddresses(ctx context.Context, addresses ...*RollupAddress) error
 	SaveRollups(ctx context.Context, rollups ...*Rollup) (int64, error)
 	SaveTransactions(ctx context.Context, txs ...*Tx) error
+	SaveRawTxs(ctx context.Context, txs ...RawTx) error
+	SaveRawBlocks(ctx context.Context, blocks ...RawBlock) error
 	SaveValidators(ctx context.Context, validators ...*Validator) error
 	RetentionBlockSignatures(ctx context.Context, height types.Level) error
+	ReserveIds(ctx context.Context, table string, count int) ([]uint64, error)
 
 	RollbackActions(ctx context.Context, height types.Level) (actions []Action, err error)
 	RollbackAddressActions(ctx context.Context, height types.Level) (addrActions []AddressAction, err error)
@@ -66,6 +79,8 @@ type Transaction interface {
 	RollbackRollupAddresses(ctx context.Context, height types.Level) (err error)
 	RollbackRollups(ctx context.Context, height types.Level) ([]Rollup, error)
 	RollbackTxs(ctx context.Context, height types.Level) (txs []Tx, err error)
+	RollbackRawTxs(ctx context.Context, height types.Level) error
+	RollbackRawBlock(ctx context.Context, height types.Level) error
 	RollbackValidators(ctx context.Context, height types.Level) (err error)
 	UpdateAddresses(ctx context.Context, address ...*Address) error
 	UpdateRollups(ctx context.Context, rollups ...*Rollup) error
@@ -81,11 +96,12 @@ type SearchResult struct {
 	Id    uint64 `bun:"id"`
 	Value string `bun:"value"`
 	Type  string `bun:"type"`
+	Rank  int    `bun:"rank"`
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type ISearch interface {
-	Search(ctx context.Context, query string) ([]SearchResult, error)
+	Search(ctx context.Context, query string, limit int) ([]SearchResult, error)
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
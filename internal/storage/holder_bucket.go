@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// HolderBucket - count of holders and amount held by them in range of balances. Bucket N contains positive balances in range [10^N, 10^(N+1)), bucket 0 contains all balances less than 10.
+// Buckets are updated incrementally on every balance change, so distribution of supply is known without scanning of balances.
+type HolderBucket struct {
+	bun.BaseModel `bun:"holder_bucket" comment:"Table with count of holders grouped by order of balance"`
+
+	Currency string          `bun:"currency,pk,notnull"  comment:"Balance currency"`
+	Bucket   int             `bun:"bucket,pk,notnull"    comment:"Decimal order of balance"`
+	Count    int64           `bun:"count,notnull"        comment:"Count of holders"`
+	Total    decimal.Decimal `bun:"total,type:numeric"   comment:"Amount held by holders of the bucket"`
+}
+
+// TableName -
+func (HolderBucket) TableName() string {
+	return "holder_bucket"
+}
+
+// HolderBucketOf - returns bucket of balance. False is returned for non-positive balance which doesn't make address a holder.
+func HolderBucketOf(total decimal.Decimal) (int, bool) {
+	if !total.IsPositive() {
+		return 0, false
+	}
+	return len(total.Truncate(0).BigInt().String()) - 1, true
+}
+
+// HolderBucketRange - returns range of balances [from, to) of the bucket
+func HolderBucketRange(bucket int) (from decimal.Decimal, to decimal.Decimal) {
+	if bucket > 0 {
+		from = decimal.New(1, int32(bucket))
+	}
+	return from, decimal.New(1, int32(bucket+1))
+}
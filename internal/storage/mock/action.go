@@ -123,18 +123,18 @@ func (c *IActionByBlockCall) DoAndReturn(f func(context.Context, types.Level, in
 }
 
 // ByRollup mocks base method.
-func (m *MockIAction) ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.RollupAction, error) {
+func (m *MockIAction) ByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter) ([]storage.RollupAction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ByRollup", ctx, rollupId, limit, offset, sort)
+	ret := m.ctrl.Call(m, "ByRollup", ctx, rollupId, fltrs)
 	ret0, _ := ret[0].([]storage.RollupAction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ByRollup indicates an expected call of ByRollup.
-func (mr *MockIActionMockRecorder) ByRollup(ctx, rollupId, limit, offset, sort any) *IActionByRollupCall {
+func (mr *MockIActionMockRecorder) ByRollup(ctx, rollupId, fltrs any) *IActionByRollupCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollup", reflect.TypeOf((*MockIAction)(nil).ByRollup), ctx, rollupId, limit, offset, sort)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollup", reflect.TypeOf((*MockIAction)(nil).ByRollup), ctx, rollupId, fltrs)
 	return &IActionByRollupCall{Call: call}
 }
 
@@ -150,13 +150,13 @@ func (c *IActionByRollupCall) Return(arg0 []storage.RollupAction, arg1 error) *I
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *IActionByRollupCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.RollupAction, error)) *IActionByRollupCall {
+func (c *IActionByRollupCall) Do(f func(context.Context, uint64, storage.RollupActionsFilter) ([]storage.RollupAction, error)) *IActionByRollupCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *IActionByRollupCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.RollupAction, error)) *IActionByRollupCall {
+func (c *IActionByRollupCall) DoAndReturn(f func(context.Context, uint64, storage.RollupActionsFilter) ([]storage.RollupAction, error)) *IActionByRollupCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -432,6 +432,82 @@ func (c *IActionSaveCall) DoAndReturn(f func(context.Context, *storage.Action) e
 	return c
 }
 
+// StreamByAddress mocks base method.
+func (m *MockIAction) StreamByAddress(ctx context.Context, addressId uint64, filters storage.AddressActionsFilter, fn func(storage.AddressAction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamByAddress", ctx, addressId, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamByAddress indicates an expected call of StreamByAddress.
+func (mr *MockIActionMockRecorder) StreamByAddress(ctx, addressId, filters, fn any) *IActionStreamByAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamByAddress", reflect.TypeOf((*MockIAction)(nil).StreamByAddress), ctx, addressId, filters, fn)
+	return &IActionStreamByAddressCall{Call: call}
+}
+
+// IActionStreamByAddressCall wrap *gomock.Call
+type IActionStreamByAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IActionStreamByAddressCall) Return(arg0 error) *IActionStreamByAddressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IActionStreamByAddressCall) Do(f func(context.Context, uint64, storage.AddressActionsFilter, func(storage.AddressAction) error) error) *IActionStreamByAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IActionStreamByAddressCall) DoAndReturn(f func(context.Context, uint64, storage.AddressActionsFilter, func(storage.AddressAction) error) error) *IActionStreamByAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StreamByRollup mocks base method.
+func (m *MockIAction) StreamByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter, fn func(storage.RollupAction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamByRollup", ctx, rollupId, fltrs, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamByRollup indicates an expected call of StreamByRollup.
+func (mr *MockIActionMockRecorder) StreamByRollup(ctx, rollupId, fltrs, fn any) *IActionStreamByRollupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamByRollup", reflect.TypeOf((*MockIAction)(nil).StreamByRollup), ctx, rollupId, fltrs, fn)
+	return &IActionStreamByRollupCall{Call: call}
+}
+
+// IActionStreamByRollupCall wrap *gomock.Call
+type IActionStreamByRollupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IActionStreamByRollupCall) Return(arg0 error) *IActionStreamByRollupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IActionStreamByRollupCall) Do(f func(context.Context, uint64, storage.RollupActionsFilter, func(storage.RollupAction) error) error) *IActionStreamByRollupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IActionStreamByRollupCall) DoAndReturn(f func(context.Context, uint64, storage.RollupActionsFilter, func(storage.RollupAction) error) error) *IActionStreamByRollupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIAction) Update(ctx context.Context, m *storage.Action) error {
 	m_2.ctrl.T.Helper()
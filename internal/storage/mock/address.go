@@ -82,6 +82,50 @@ func (c *IAddressByHashCall) DoAndReturn(f func(context.Context, []byte) (storag
 	return c
 }
 
+// ByIds mocks base method.
+func (m *MockIAddress) ByIds(ctx context.Context, ids ...uint64) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIds indicates an expected call of ByIds.
+func (mr *MockIAddressMockRecorder) ByIds(ctx any, ids ...any) *IAddressByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIds", reflect.TypeOf((*MockIAddress)(nil).ByIds), varargs...)
+	return &IAddressByIdsCall{Call: call}
+}
+
+// IAddressByIdsCall wrap *gomock.Call
+type IAddressByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IAddressByIdsCall) Return(arg0 []storage.Address, arg1 error) *IAddressByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IAddressByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Address, error)) *IAddressByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IAddressByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Address, error)) *IAddressByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIAddress) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Address, error) {
 	m.ctrl.T.Helper()
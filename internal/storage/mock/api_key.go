@@ -0,0 +1,474 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=api_key.go -destination=mock/api_key.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiKey is a mock of IApiKey interface.
+type MockIApiKey struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyMockRecorder
+}
+
+// MockIApiKeyMockRecorder is the mock recorder for MockIApiKey.
+type MockIApiKeyMockRecorder struct {
+	mock *MockIApiKey
+}
+
+// NewMockIApiKey creates a new mock instance.
+func NewMockIApiKey(ctrl *gomock.Controller) *MockIApiKey {
+	mock := &MockIApiKey{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKey) EXPECT() *MockIApiKeyMockRecorder {
+	return m.recorder
+}
+
+// ByHash mocks base method.
+func (m *MockIApiKey) ByHash(ctx context.Context, hash []byte) (storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockIApiKeyMockRecorder) ByHash(ctx, hash any) *IApiKeyByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockIApiKey)(nil).ByHash), ctx, hash)
+	return &IApiKeyByHashCall{Call: call}
+}
+
+// IApiKeyByHashCall wrap *gomock.Call
+type IApiKeyByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyByHashCall) Return(arg0 storage.ApiKey, arg1 error) *IApiKeyByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyByHashCall) Do(f func(context.Context, []byte) (storage.ApiKey, error)) *IApiKeyByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyByHashCall) DoAndReturn(f func(context.Context, []byte) (storage.ApiKey, error)) *IApiKeyByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIApiKey) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIApiKeyMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IApiKeyCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIApiKey)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IApiKeyCursorListCall{Call: call}
+}
+
+// IApiKeyCursorListCall wrap *gomock.Call
+type IApiKeyCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyCursorListCall) Return(arg0 []*storage.ApiKey, arg1 error) *IApiKeyCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiKey, error)) *IApiKeyCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiKey, error)) *IApiKeyCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIApiKey) GetByID(ctx context.Context, id uint64) (*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIApiKeyMockRecorder) GetByID(ctx, id any) *IApiKeyGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIApiKey)(nil).GetByID), ctx, id)
+	return &IApiKeyGetByIDCall{Call: call}
+}
+
+// IApiKeyGetByIDCall wrap *gomock.Call
+type IApiKeyGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyGetByIDCall) Return(arg0 *storage.ApiKey, arg1 error) *IApiKeyGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyGetByIDCall) Do(f func(context.Context, uint64) (*storage.ApiKey, error)) *IApiKeyGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ApiKey, error)) *IApiKeyGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIApiKey) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIApiKeyMockRecorder) IsNoRows(err any) *IApiKeyIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIApiKey)(nil).IsNoRows), err)
+	return &IApiKeyIsNoRowsCall{Call: call}
+}
+
+// IApiKeyIsNoRowsCall wrap *gomock.Call
+type IApiKeyIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyIsNoRowsCall) Return(arg0 bool) *IApiKeyIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyIsNoRowsCall) Do(f func(error) bool) *IApiKeyIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyIsNoRowsCall) DoAndReturn(f func(error) bool) *IApiKeyIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIApiKey) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIApiKeyMockRecorder) LastID(ctx any) *IApiKeyLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIApiKey)(nil).LastID), ctx)
+	return &IApiKeyLastIDCall{Call: call}
+}
+
+// IApiKeyLastIDCall wrap *gomock.Call
+type IApiKeyLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyLastIDCall) Return(arg0 uint64, arg1 error) *IApiKeyLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyLastIDCall) Do(f func(context.Context) (uint64, error)) *IApiKeyLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IApiKeyLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIApiKey) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIApiKeyMockRecorder) List(ctx, limit, offset, order any) *IApiKeyListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIApiKey)(nil).List), ctx, limit, offset, order)
+	return &IApiKeyListCall{Call: call}
+}
+
+// IApiKeyListCall wrap *gomock.Call
+type IApiKeyListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyListCall) Return(arg0 []*storage.ApiKey, arg1 error) *IApiKeyListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiKey, error)) *IApiKeyListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiKey, error)) *IApiKeyListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Revoke mocks base method.
+func (m *MockIApiKey) Revoke(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIApiKeyMockRecorder) Revoke(ctx, id any) *IApiKeyRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIApiKey)(nil).Revoke), ctx, id)
+	return &IApiKeyRevokeCall{Call: call}
+}
+
+// IApiKeyRevokeCall wrap *gomock.Call
+type IApiKeyRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyRevokeCall) Return(arg0 error) *IApiKeyRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyRevokeCall) Do(f func(context.Context, uint64) error) *IApiKeyRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyRevokeCall) DoAndReturn(f func(context.Context, uint64) error) *IApiKeyRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIApiKey) Save(ctx context.Context, m *storage.ApiKey) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIApiKeyMockRecorder) Save(ctx, m any) *IApiKeySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIApiKey)(nil).Save), ctx, m)
+	return &IApiKeySaveCall{Call: call}
+}
+
+// IApiKeySaveCall wrap *gomock.Call
+type IApiKeySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeySaveCall) Return(arg0 error) *IApiKeySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeySaveCall) Do(f func(context.Context, *storage.ApiKey) error) *IApiKeySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeySaveCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *IApiKeySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveUsage mocks base method.
+func (m *MockIApiKey) SaveUsage(ctx context.Context, usage ...storage.ApiKeyUsage) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range usage {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveUsage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUsage indicates an expected call of SaveUsage.
+func (mr *MockIApiKeyMockRecorder) SaveUsage(ctx any, usage ...any) *IApiKeySaveUsageCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, usage...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUsage", reflect.TypeOf((*MockIApiKey)(nil).SaveUsage), varargs...)
+	return &IApiKeySaveUsageCall{Call: call}
+}
+
+// IApiKeySaveUsageCall wrap *gomock.Call
+type IApiKeySaveUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeySaveUsageCall) Return(arg0 error) *IApiKeySaveUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeySaveUsageCall) Do(f func(context.Context, ...storage.ApiKeyUsage) error) *IApiKeySaveUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeySaveUsageCall) DoAndReturn(f func(context.Context, ...storage.ApiKeyUsage) error) *IApiKeySaveUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIApiKey) Update(ctx context.Context, m *storage.ApiKey) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIApiKeyMockRecorder) Update(ctx, m any) *IApiKeyUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIApiKey)(nil).Update), ctx, m)
+	return &IApiKeyUpdateCall{Call: call}
+}
+
+// IApiKeyUpdateCall wrap *gomock.Call
+type IApiKeyUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyUpdateCall) Return(arg0 error) *IApiKeyUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyUpdateCall) Do(f func(context.Context, *storage.ApiKey) error) *IApiKeyUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyUpdateCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *IApiKeyUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Usage mocks base method.
+func (m *MockIApiKey) Usage(ctx context.Context, keyId uint64, day time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", ctx, keyId, day)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockIApiKeyMockRecorder) Usage(ctx, keyId, day any) *IApiKeyUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockIApiKey)(nil).Usage), ctx, keyId, day)
+	return &IApiKeyUsageCall{Call: call}
+}
+
+// IApiKeyUsageCall wrap *gomock.Call
+type IApiKeyUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IApiKeyUsageCall) Return(arg0 int64, arg1 error) *IApiKeyUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IApiKeyUsageCall) Do(f func(context.Context, uint64, time.Time) (int64, error)) *IApiKeyUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IApiKeyUsageCall) DoAndReturn(f func(context.Context, uint64, time.Time) (int64, error)) *IApiKeyUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
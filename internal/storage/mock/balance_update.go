@@ -14,8 +14,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +45,84 @@ func (m *MockIBalanceUpdate) EXPECT() *MockIBalanceUpdateMockRecorder {
 	return m.recorder
 }
 
+// BalancesAtHeight mocks base method.
+func (m *MockIBalanceUpdate) BalancesAtHeight(ctx context.Context, addressId uint64, height types.Level) ([]storage.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalancesAtHeight", ctx, addressId, height)
+	ret0, _ := ret[0].([]storage.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalancesAtHeight indicates an expected call of BalancesAtHeight.
+func (mr *MockIBalanceUpdateMockRecorder) BalancesAtHeight(ctx, addressId, height any) *IBalanceUpdateBalancesAtHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesAtHeight", reflect.TypeOf((*MockIBalanceUpdate)(nil).BalancesAtHeight), ctx, addressId, height)
+	return &IBalanceUpdateBalancesAtHeightCall{Call: call}
+}
+
+// IBalanceUpdateBalancesAtHeightCall wrap *gomock.Call
+type IBalanceUpdateBalancesAtHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceUpdateBalancesAtHeightCall) Return(arg0 []storage.Balance, arg1 error) *IBalanceUpdateBalancesAtHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceUpdateBalancesAtHeightCall) Do(f func(context.Context, uint64, types.Level) ([]storage.Balance, error)) *IBalanceUpdateBalancesAtHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceUpdateBalancesAtHeightCall) DoAndReturn(f func(context.Context, uint64, types.Level) ([]storage.Balance, error)) *IBalanceUpdateBalancesAtHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BalancesAtTime mocks base method.
+func (m *MockIBalanceUpdate) BalancesAtTime(ctx context.Context, addressId uint64, t time.Time) ([]storage.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalancesAtTime", ctx, addressId, t)
+	ret0, _ := ret[0].([]storage.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalancesAtTime indicates an expected call of BalancesAtTime.
+func (mr *MockIBalanceUpdateMockRecorder) BalancesAtTime(ctx, addressId, t any) *IBalanceUpdateBalancesAtTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesAtTime", reflect.TypeOf((*MockIBalanceUpdate)(nil).BalancesAtTime), ctx, addressId, t)
+	return &IBalanceUpdateBalancesAtTimeCall{Call: call}
+}
+
+// IBalanceUpdateBalancesAtTimeCall wrap *gomock.Call
+type IBalanceUpdateBalancesAtTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceUpdateBalancesAtTimeCall) Return(arg0 []storage.Balance, arg1 error) *IBalanceUpdateBalancesAtTimeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceUpdateBalancesAtTimeCall) Do(f func(context.Context, uint64, time.Time) ([]storage.Balance, error)) *IBalanceUpdateBalancesAtTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceUpdateBalancesAtTimeCall) DoAndReturn(f func(context.Context, uint64, time.Time) ([]storage.Balance, error)) *IBalanceUpdateBalancesAtTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIBalanceUpdate) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BalanceUpdate, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +355,45 @@ func (c *IBalanceUpdateSaveCall) DoAndReturn(f func(context.Context, *storage.Ba
 	return c
 }
 
+// Series mocks base method.
+func (m *MockIBalanceUpdate) Series(ctx context.Context, addressId uint64, currency string, timeframe storage.Timeframe, req storage.SeriesRequest) ([]storage.BalanceSeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Series", ctx, addressId, currency, timeframe, req)
+	ret0, _ := ret[0].([]storage.BalanceSeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Series indicates an expected call of Series.
+func (mr *MockIBalanceUpdateMockRecorder) Series(ctx, addressId, currency, timeframe, req any) *IBalanceUpdateSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockIBalanceUpdate)(nil).Series), ctx, addressId, currency, timeframe, req)
+	return &IBalanceUpdateSeriesCall{Call: call}
+}
+
+// IBalanceUpdateSeriesCall wrap *gomock.Call
+type IBalanceUpdateSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBalanceUpdateSeriesCall) Return(arg0 []storage.BalanceSeriesItem, arg1 error) *IBalanceUpdateSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBalanceUpdateSeriesCall) Do(f func(context.Context, uint64, string, storage.Timeframe, storage.SeriesRequest) ([]storage.BalanceSeriesItem, error)) *IBalanceUpdateSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBalanceUpdateSeriesCall) DoAndReturn(f func(context.Context, uint64, string, storage.Timeframe, storage.SeriesRequest) ([]storage.BalanceSeriesItem, error)) *IBalanceUpdateSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIBalanceUpdate) Update(ctx context.Context, m *storage.BalanceUpdate) error {
 	m_2.ctrl.T.Helper()
@@ -239,6 +239,45 @@ func (c *IBlockCursorListCall) DoAndReturn(f func(context.Context, uint64, uint6
 	return c
 }
 
+// Filter mocks base method.
+func (m *MockIBlock) Filter(ctx context.Context, fltrs storage.BlockListFilter) ([]*storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, fltrs)
+	ret0, _ := ret[0].([]*storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIBlockMockRecorder) Filter(ctx, fltrs any) *IBlockFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIBlock)(nil).Filter), ctx, fltrs)
+	return &IBlockFilterCall{Call: call}
+}
+
+// IBlockFilterCall wrap *gomock.Call
+type IBlockFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockFilterCall) Return(arg0 []*storage.Block, arg1 error) *IBlockFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockFilterCall) Do(f func(context.Context, storage.BlockListFilter) ([]*storage.Block, error)) *IBlockFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockFilterCall) DoAndReturn(f func(context.Context, storage.BlockListFilter) ([]*storage.Block, error)) *IBlockFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByID mocks base method.
 func (m *MockIBlock) GetByID(ctx context.Context, id uint64) (*storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -433,45 +472,6 @@ func (c *IBlockListCall) DoAndReturn(f func(context.Context, uint64, uint64, sto
 	return c
 }
 
-// ListWithStats mocks base method.
-func (m *MockIBlock) ListWithStats(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Block, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWithStats", ctx, limit, offset, order)
-	ret0, _ := ret[0].([]*storage.Block)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ListWithStats indicates an expected call of ListWithStats.
-func (mr *MockIBlockMockRecorder) ListWithStats(ctx, limit, offset, order any) *IBlockListWithStatsCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithStats", reflect.TypeOf((*MockIBlock)(nil).ListWithStats), ctx, limit, offset, order)
-	return &IBlockListWithStatsCall{Call: call}
-}
-
-// IBlockListWithStatsCall wrap *gomock.Call
-type IBlockListWithStatsCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *IBlockListWithStatsCall) Return(arg0 []*storage.Block, arg1 error) *IBlockListWithStatsCall {
-	c.Call = c.Call.Return(arg0, arg1)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *IBlockListWithStatsCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Block, error)) *IBlockListWithStatsCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *IBlockListWithStatsCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Block, error)) *IBlockListWithStatsCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
 // Save mocks base method.
 func (m_2 *MockIBlock) Save(ctx context.Context, m *storage.Block) error {
 	m_2.ctrl.T.Helper()
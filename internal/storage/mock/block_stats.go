@@ -81,3 +81,47 @@ func (c *IBlockStatsByHeightCall) DoAndReturn(f func(context.Context, types.Leve
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ByHeights mocks base method.
+func (m *MockIBlockStats) ByHeights(ctx context.Context, heights ...types.Level) ([]storage.BlockStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range heights {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByHeights", varargs...)
+	ret0, _ := ret[0].([]storage.BlockStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeights indicates an expected call of ByHeights.
+func (mr *MockIBlockStatsMockRecorder) ByHeights(ctx any, heights ...any) *IBlockStatsByHeightsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, heights...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeights", reflect.TypeOf((*MockIBlockStats)(nil).ByHeights), varargs...)
+	return &IBlockStatsByHeightsCall{Call: call}
+}
+
+// IBlockStatsByHeightsCall wrap *gomock.Call
+type IBlockStatsByHeightsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockStatsByHeightsCall) Return(stats []storage.BlockStats, err error) *IBlockStatsByHeightsCall {
+	c.Call = c.Call.Return(stats, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockStatsByHeightsCall) Do(f func(context.Context, ...types.Level) ([]storage.BlockStats, error)) *IBlockStatsByHeightsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockStatsByHeightsCall) DoAndReturn(f func(context.Context, ...types.Level) ([]storage.BlockStats, error)) *IBlockStatsByHeightsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -435,6 +435,45 @@ func (c *TransactionLastNonceCall) DoAndReturn(f func(context.Context, uint64) (
 	return c
 }
 
+// ReserveIds mocks base method.
+func (m *MockTransaction) ReserveIds(ctx context.Context, table string, count int) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIds", ctx, table, count)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIds indicates an expected call of ReserveIds.
+func (mr *MockTransactionMockRecorder) ReserveIds(ctx, table, count any) *TransactionReserveIdsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIds", reflect.TypeOf((*MockTransaction)(nil).ReserveIds), ctx, table, count)
+	return &TransactionReserveIdsCall{Call: call}
+}
+
+// TransactionReserveIdsCall wrap *gomock.Call
+type TransactionReserveIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionReserveIdsCall) Return(arg0 []uint64, arg1 error) *TransactionReserveIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionReserveIdsCall) Do(f func(context.Context, string, int) ([]uint64, error)) *TransactionReserveIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionReserveIdsCall) DoAndReturn(f func(context.Context, string, int) ([]uint64, error)) *TransactionReserveIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionBlockSignatures mocks base method.
 func (m *MockTransaction) RetentionBlockSignatures(ctx context.Context, height types.Level) error {
 	m.ctrl.T.Helper()
@@ -820,6 +859,82 @@ func (c *TransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// RollbackRawBlock mocks base method.
+func (m *MockTransaction) RollbackRawBlock(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRawBlock", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRawBlock indicates an expected call of RollbackRawBlock.
+func (mr *MockTransactionMockRecorder) RollbackRawBlock(ctx, height any) *TransactionRollbackRawBlockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRawBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackRawBlock), ctx, height)
+	return &TransactionRollbackRawBlockCall{Call: call}
+}
+
+// TransactionRollbackRawBlockCall wrap *gomock.Call
+type TransactionRollbackRawBlockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackRawBlockCall) Return(arg0 error) *TransactionRollbackRawBlockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackRawBlockCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackRawBlockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackRawBlockCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackRawBlockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackRawTxs mocks base method.
+func (m *MockTransaction) RollbackRawTxs(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRawTxs", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRawTxs indicates an expected call of RollbackRawTxs.
+func (mr *MockTransactionMockRecorder) RollbackRawTxs(ctx, height any) *TransactionRollbackRawTxsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRawTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackRawTxs), ctx, height)
+	return &TransactionRollbackRawTxsCall{Call: call}
+}
+
+// TransactionRollbackRawTxsCall wrap *gomock.Call
+type TransactionRollbackRawTxsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackRawTxsCall) Return(arg0 error) *TransactionRollbackRawTxsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackRawTxsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackRawTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackRawTxsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackRawTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackRollupActions mocks base method.
 func (m *MockTransaction) RollbackRollupActions(ctx context.Context, height types.Level) ([]storage.RollupAction, error) {
 	m.ctrl.T.Helper()
@@ -1315,6 +1430,92 @@ func (c *TransactionSaveConstantsCall) DoAndReturn(f func(context.Context, ...st
 	return c
 }
 
+// SaveRawBlocks mocks base method.
+func (m *MockTransaction) SaveRawBlocks(ctx context.Context, blocks ...storage.RawBlock) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range blocks {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveRawBlocks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRawBlocks indicates an expected call of SaveRawBlocks.
+func (mr *MockTransactionMockRecorder) SaveRawBlocks(ctx any, blocks ...any) *TransactionSaveRawBlocksCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, blocks...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRawBlocks", reflect.TypeOf((*MockTransaction)(nil).SaveRawBlocks), varargs...)
+	return &TransactionSaveRawBlocksCall{Call: call}
+}
+
+// TransactionSaveRawBlocksCall wrap *gomock.Call
+type TransactionSaveRawBlocksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveRawBlocksCall) Return(arg0 error) *TransactionSaveRawBlocksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveRawBlocksCall) Do(f func(context.Context, ...storage.RawBlock) error) *TransactionSaveRawBlocksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveRawBlocksCall) DoAndReturn(f func(context.Context, ...storage.RawBlock) error) *TransactionSaveRawBlocksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveRawTxs mocks base method.
+func (m *MockTransaction) SaveRawTxs(ctx context.Context, txs ...storage.RawTx) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range txs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveRawTxs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRawTxs indicates an expected call of SaveRawTxs.
+func (mr *MockTransactionMockRecorder) SaveRawTxs(ctx any, txs ...any) *TransactionSaveRawTxsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, txs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRawTxs", reflect.TypeOf((*MockTransaction)(nil).SaveRawTxs), varargs...)
+	return &TransactionSaveRawTxsCall{Call: call}
+}
+
+// TransactionSaveRawTxsCall wrap *gomock.Call
+type TransactionSaveRawTxsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveRawTxsCall) Return(arg0 error) *TransactionSaveRawTxsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveRawTxsCall) Do(f func(context.Context, ...storage.RawTx) error) *TransactionSaveRawTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveRawTxsCall) DoAndReturn(f func(context.Context, ...storage.RawTx) error) *TransactionSaveRawTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveRollupActions mocks base method.
 func (m *MockTransaction) SaveRollupActions(ctx context.Context, actions ...*storage.RollupAction) error {
 	m.ctrl.T.Helper()
@@ -1795,18 +1996,18 @@ func (m *MockISearch) EXPECT() *MockISearchMockRecorder {
 }
 
 // Search mocks base method.
-func (m *MockISearch) Search(ctx context.Context, query string) ([]storage.SearchResult, error) {
+func (m *MockISearch) Search(ctx context.Context, query string, limit int) ([]storage.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
 	ret0, _ := ret[0].([]storage.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search.
-func (mr *MockISearchMockRecorder) Search(ctx, query any) *ISearchSearchCall {
+func (mr *MockISearchMockRecorder) Search(ctx, query, limit any) *ISearchSearchCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockISearch)(nil).Search), ctx, query)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockISearch)(nil).Search), ctx, query, limit)
 	return &ISearchSearchCall{Call: call}
 }
 
@@ -1822,13 +2023,13 @@ func (c *ISearchSearchCall) Return(arg0 []storage.SearchResult, arg1 error) *ISe
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *ISearchSearchCall) Do(f func(context.Context, string) ([]storage.SearchResult, error)) *ISearchSearchCall {
+func (c *ISearchSearchCall) Do(f func(context.Context, string, int) ([]storage.SearchResult, error)) *ISearchSearchCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *ISearchSearchCall) DoAndReturn(f func(context.Context, string) ([]storage.SearchResult, error)) *ISearchSearchCall {
+func (c *ISearchSearchCall) DoAndReturn(f func(context.Context, string, int) ([]storage.SearchResult, error)) *ISearchSearchCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
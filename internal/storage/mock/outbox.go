@@ -0,0 +1,391 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outbox.go -destination=mock/outbox.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOutbox is a mock of IOutbox interface.
+type MockIOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOutboxMockRecorder
+}
+
+// MockIOutboxMockRecorder is the mock recorder for MockIOutbox.
+type MockIOutboxMockRecorder struct {
+	mock *MockIOutbox
+}
+
+// NewMockIOutbox creates a new mock instance.
+func NewMockIOutbox(ctrl *gomock.Controller) *MockIOutbox {
+	mock := &MockIOutbox{ctrl: ctrl}
+	mock.recorder = &MockIOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOutbox) EXPECT() *MockIOutboxMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIOutbox) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIOutboxMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IOutboxCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIOutbox)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IOutboxCursorListCall{Call: call}
+}
+
+// IOutboxCursorListCall wrap *gomock.Call
+type IOutboxCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxCursorListCall) Return(arg0 []*storage.Outbox, arg1 error) *IOutboxCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Outbox, error)) *IOutboxCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Outbox, error)) *IOutboxCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIOutbox) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIOutboxMockRecorder) Delete(ctx, id any) *IOutboxDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIOutbox)(nil).Delete), ctx, id)
+	return &IOutboxDeleteCall{Call: call}
+}
+
+// IOutboxDeleteCall wrap *gomock.Call
+type IOutboxDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxDeleteCall) Return(arg0 error) *IOutboxDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxDeleteCall) Do(f func(context.Context, uint64) error) *IOutboxDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *IOutboxDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIOutbox) GetByID(ctx context.Context, id uint64) (*storage.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOutboxMockRecorder) GetByID(ctx, id any) *IOutboxGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOutbox)(nil).GetByID), ctx, id)
+	return &IOutboxGetByIDCall{Call: call}
+}
+
+// IOutboxGetByIDCall wrap *gomock.Call
+type IOutboxGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxGetByIDCall) Return(arg0 *storage.Outbox, arg1 error) *IOutboxGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxGetByIDCall) Do(f func(context.Context, uint64) (*storage.Outbox, error)) *IOutboxGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Outbox, error)) *IOutboxGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIOutbox) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIOutboxMockRecorder) IsNoRows(err any) *IOutboxIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIOutbox)(nil).IsNoRows), err)
+	return &IOutboxIsNoRowsCall{Call: call}
+}
+
+// IOutboxIsNoRowsCall wrap *gomock.Call
+type IOutboxIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxIsNoRowsCall) Return(arg0 bool) *IOutboxIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxIsNoRowsCall) Do(f func(error) bool) *IOutboxIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxIsNoRowsCall) DoAndReturn(f func(error) bool) *IOutboxIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIOutbox) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIOutboxMockRecorder) LastID(ctx any) *IOutboxLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIOutbox)(nil).LastID), ctx)
+	return &IOutboxLastIDCall{Call: call}
+}
+
+// IOutboxLastIDCall wrap *gomock.Call
+type IOutboxLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxLastIDCall) Return(arg0 uint64, arg1 error) *IOutboxLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxLastIDCall) Do(f func(context.Context) (uint64, error)) *IOutboxLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IOutboxLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIOutbox) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIOutboxMockRecorder) List(ctx, limit, offset, order any) *IOutboxListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIOutbox)(nil).List), ctx, limit, offset, order)
+	return &IOutboxListCall{Call: call}
+}
+
+// IOutboxListCall wrap *gomock.Call
+type IOutboxListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxListCall) Return(arg0 []*storage.Outbox, arg1 error) *IOutboxListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Outbox, error)) *IOutboxListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Outbox, error)) *IOutboxListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pending mocks base method.
+func (m *MockIOutbox) Pending(ctx context.Context, limit int) ([]storage.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, limit)
+	ret0, _ := ret[0].([]storage.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockIOutboxMockRecorder) Pending(ctx, limit any) *IOutboxPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockIOutbox)(nil).Pending), ctx, limit)
+	return &IOutboxPendingCall{Call: call}
+}
+
+// IOutboxPendingCall wrap *gomock.Call
+type IOutboxPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxPendingCall) Return(arg0 []storage.Outbox, arg1 error) *IOutboxPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxPendingCall) Do(f func(context.Context, int) ([]storage.Outbox, error)) *IOutboxPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxPendingCall) DoAndReturn(f func(context.Context, int) ([]storage.Outbox, error)) *IOutboxPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIOutbox) Save(ctx context.Context, m *storage.Outbox) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIOutboxMockRecorder) Save(ctx, m any) *IOutboxSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIOutbox)(nil).Save), ctx, m)
+	return &IOutboxSaveCall{Call: call}
+}
+
+// IOutboxSaveCall wrap *gomock.Call
+type IOutboxSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxSaveCall) Return(arg0 error) *IOutboxSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxSaveCall) Do(f func(context.Context, *storage.Outbox) error) *IOutboxSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxSaveCall) DoAndReturn(f func(context.Context, *storage.Outbox) error) *IOutboxSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIOutbox) Update(ctx context.Context, m *storage.Outbox) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIOutboxMockRecorder) Update(ctx, m any) *IOutboxUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIOutbox)(nil).Update), ctx, m)
+	return &IOutboxUpdateCall{Call: call}
+}
+
+// IOutboxUpdateCall wrap *gomock.Call
+type IOutboxUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IOutboxUpdateCall) Return(arg0 error) *IOutboxUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IOutboxUpdateCall) Do(f func(context.Context, *storage.Outbox) error) *IOutboxUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IOutboxUpdateCall) DoAndReturn(f func(context.Context, *storage.Outbox) error) *IOutboxUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: raw_block.go
+//
+// Generated by this command:
+//
+//	mockgen -source=raw_block.go -destination=mock/raw_block.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRawBlock is a mock of IRawBlock interface.
+type MockIRawBlock struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRawBlockMockRecorder
+}
+
+// MockIRawBlockMockRecorder is the mock recorder for MockIRawBlock.
+type MockIRawBlockMockRecorder struct {
+	mock *MockIRawBlock
+}
+
+// NewMockIRawBlock creates a new mock instance.
+func NewMockIRawBlock(ctrl *gomock.Controller) *MockIRawBlock {
+	mock := &MockIRawBlock{ctrl: ctrl}
+	mock.recorder = &MockIRawBlockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRawBlock) EXPECT() *MockIRawBlockMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIRawBlock) ByHeight(ctx context.Context, height types.Level) (storage.RawBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].(storage.RawBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIRawBlockMockRecorder) ByHeight(ctx, height any) *IRawBlockByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIRawBlock)(nil).ByHeight), ctx, height)
+	return &IRawBlockByHeightCall{Call: call}
+}
+
+// IRawBlockByHeightCall wrap *gomock.Call
+type IRawBlockByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockByHeightCall) Return(arg0 storage.RawBlock, arg1 error) *IRawBlockByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockByHeightCall) Do(f func(context.Context, types.Level) (storage.RawBlock, error)) *IRawBlockByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockByHeightCall) DoAndReturn(f func(context.Context, types.Level) (storage.RawBlock, error)) *IRawBlockByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIRawBlock) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RawBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RawBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRawBlockMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IRawBlockCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRawBlock)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IRawBlockCursorListCall{Call: call}
+}
+
+// IRawBlockCursorListCall wrap *gomock.Call
+type IRawBlockCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockCursorListCall) Return(arg0 []*storage.RawBlock, arg1 error) *IRawBlockCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RawBlock, error)) *IRawBlockCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RawBlock, error)) *IRawBlockCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRawBlock) GetByID(ctx context.Context, id uint64) (*storage.RawBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RawBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRawBlockMockRecorder) GetByID(ctx, id any) *IRawBlockGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRawBlock)(nil).GetByID), ctx, id)
+	return &IRawBlockGetByIDCall{Call: call}
+}
+
+// IRawBlockGetByIDCall wrap *gomock.Call
+type IRawBlockGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockGetByIDCall) Return(arg0 *storage.RawBlock, arg1 error) *IRawBlockGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockGetByIDCall) Do(f func(context.Context, uint64) (*storage.RawBlock, error)) *IRawBlockGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RawBlock, error)) *IRawBlockGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRawBlock) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRawBlockMockRecorder) IsNoRows(err any) *IRawBlockIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRawBlock)(nil).IsNoRows), err)
+	return &IRawBlockIsNoRowsCall{Call: call}
+}
+
+// IRawBlockIsNoRowsCall wrap *gomock.Call
+type IRawBlockIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockIsNoRowsCall) Return(arg0 bool) *IRawBlockIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockIsNoRowsCall) Do(f func(error) bool) *IRawBlockIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockIsNoRowsCall) DoAndReturn(f func(error) bool) *IRawBlockIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRawBlock) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRawBlockMockRecorder) LastID(ctx any) *IRawBlockLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRawBlock)(nil).LastID), ctx)
+	return &IRawBlockLastIDCall{Call: call}
+}
+
+// IRawBlockLastIDCall wrap *gomock.Call
+type IRawBlockLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockLastIDCall) Return(arg0 uint64, arg1 error) *IRawBlockLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockLastIDCall) Do(f func(context.Context) (uint64, error)) *IRawBlockLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IRawBlockLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRawBlock) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RawBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RawBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRawBlockMockRecorder) List(ctx, limit, offset, order any) *IRawBlockListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRawBlock)(nil).List), ctx, limit, offset, order)
+	return &IRawBlockListCall{Call: call}
+}
+
+// IRawBlockListCall wrap *gomock.Call
+type IRawBlockListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockListCall) Return(arg0 []*storage.RawBlock, arg1 error) *IRawBlockListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RawBlock, error)) *IRawBlockListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RawBlock, error)) *IRawBlockListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRawBlock) Save(ctx context.Context, m *storage.RawBlock) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRawBlockMockRecorder) Save(ctx, m any) *IRawBlockSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRawBlock)(nil).Save), ctx, m)
+	return &IRawBlockSaveCall{Call: call}
+}
+
+// IRawBlockSaveCall wrap *gomock.Call
+type IRawBlockSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockSaveCall) Return(arg0 error) *IRawBlockSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockSaveCall) Do(f func(context.Context, *storage.RawBlock) error) *IRawBlockSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockSaveCall) DoAndReturn(f func(context.Context, *storage.RawBlock) error) *IRawBlockSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRawBlock) Update(ctx context.Context, m *storage.RawBlock) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRawBlockMockRecorder) Update(ctx, m any) *IRawBlockUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRawBlock)(nil).Update), ctx, m)
+	return &IRawBlockUpdateCall{Call: call}
+}
+
+// IRawBlockUpdateCall wrap *gomock.Call
+type IRawBlockUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawBlockUpdateCall) Return(arg0 error) *IRawBlockUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawBlockUpdateCall) Do(f func(context.Context, *storage.RawBlock) error) *IRawBlockUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawBlockUpdateCall) DoAndReturn(f func(context.Context, *storage.RawBlock) error) *IRawBlockUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: raw_tx.go
+//
+// Generated by this command:
+//
+//	mockgen -source=raw_tx.go -destination=mock/raw_tx.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRawTx is a mock of IRawTx interface.
+type MockIRawTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRawTxMockRecorder
+}
+
+// MockIRawTxMockRecorder is the mock recorder for MockIRawTx.
+type MockIRawTxMockRecorder struct {
+	mock *MockIRawTx
+}
+
+// NewMockIRawTx creates a new mock instance.
+func NewMockIRawTx(ctrl *gomock.Controller) *MockIRawTx {
+	mock := &MockIRawTx{ctrl: ctrl}
+	mock.recorder = &MockIRawTxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRawTx) EXPECT() *MockIRawTxMockRecorder {
+	return m.recorder
+}
+
+// ByTxId mocks base method.
+func (m *MockIRawTx) ByTxId(ctx context.Context, txId uint64) (storage.RawTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByTxId", ctx, txId)
+	ret0, _ := ret[0].(storage.RawTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByTxId indicates an expected call of ByTxId.
+func (mr *MockIRawTxMockRecorder) ByTxId(ctx, txId any) *IRawTxByTxIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByTxId", reflect.TypeOf((*MockIRawTx)(nil).ByTxId), ctx, txId)
+	return &IRawTxByTxIdCall{Call: call}
+}
+
+// IRawTxByTxIdCall wrap *gomock.Call
+type IRawTxByTxIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxByTxIdCall) Return(arg0 storage.RawTx, arg1 error) *IRawTxByTxIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxByTxIdCall) Do(f func(context.Context, uint64) (storage.RawTx, error)) *IRawTxByTxIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxByTxIdCall) DoAndReturn(f func(context.Context, uint64) (storage.RawTx, error)) *IRawTxByTxIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIRawTx) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RawTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RawTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRawTxMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IRawTxCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRawTx)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IRawTxCursorListCall{Call: call}
+}
+
+// IRawTxCursorListCall wrap *gomock.Call
+type IRawTxCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxCursorListCall) Return(arg0 []*storage.RawTx, arg1 error) *IRawTxCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RawTx, error)) *IRawTxCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RawTx, error)) *IRawTxCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRawTx) GetByID(ctx context.Context, id uint64) (*storage.RawTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RawTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRawTxMockRecorder) GetByID(ctx, id any) *IRawTxGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRawTx)(nil).GetByID), ctx, id)
+	return &IRawTxGetByIDCall{Call: call}
+}
+
+// IRawTxGetByIDCall wrap *gomock.Call
+type IRawTxGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxGetByIDCall) Return(arg0 *storage.RawTx, arg1 error) *IRawTxGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxGetByIDCall) Do(f func(context.Context, uint64) (*storage.RawTx, error)) *IRawTxGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RawTx, error)) *IRawTxGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRawTx) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRawTxMockRecorder) IsNoRows(err any) *IRawTxIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRawTx)(nil).IsNoRows), err)
+	return &IRawTxIsNoRowsCall{Call: call}
+}
+
+// IRawTxIsNoRowsCall wrap *gomock.Call
+type IRawTxIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxIsNoRowsCall) Return(arg0 bool) *IRawTxIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxIsNoRowsCall) Do(f func(error) bool) *IRawTxIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxIsNoRowsCall) DoAndReturn(f func(error) bool) *IRawTxIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRawTx) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRawTxMockRecorder) LastID(ctx any) *IRawTxLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRawTx)(nil).LastID), ctx)
+	return &IRawTxLastIDCall{Call: call}
+}
+
+// IRawTxLastIDCall wrap *gomock.Call
+type IRawTxLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxLastIDCall) Return(arg0 uint64, arg1 error) *IRawTxLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxLastIDCall) Do(f func(context.Context) (uint64, error)) *IRawTxLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IRawTxLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRawTx) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RawTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RawTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRawTxMockRecorder) List(ctx, limit, offset, order any) *IRawTxListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRawTx)(nil).List), ctx, limit, offset, order)
+	return &IRawTxListCall{Call: call}
+}
+
+// IRawTxListCall wrap *gomock.Call
+type IRawTxListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxListCall) Return(arg0 []*storage.RawTx, arg1 error) *IRawTxListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RawTx, error)) *IRawTxListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RawTx, error)) *IRawTxListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRawTx) Save(ctx context.Context, m *storage.RawTx) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRawTxMockRecorder) Save(ctx, m any) *IRawTxSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRawTx)(nil).Save), ctx, m)
+	return &IRawTxSaveCall{Call: call}
+}
+
+// IRawTxSaveCall wrap *gomock.Call
+type IRawTxSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxSaveCall) Return(arg0 error) *IRawTxSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxSaveCall) Do(f func(context.Context, *storage.RawTx) error) *IRawTxSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxSaveCall) DoAndReturn(f func(context.Context, *storage.RawTx) error) *IRawTxSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRawTx) Update(ctx context.Context, m *storage.RawTx) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRawTxMockRecorder) Update(ctx, m any) *IRawTxUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRawTx)(nil).Update), ctx, m)
+	return &IRawTxUpdateCall{Call: call}
+}
+
+// IRawTxUpdateCall wrap *gomock.Call
+type IRawTxUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRawTxUpdateCall) Return(arg0 error) *IRawTxUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRawTxUpdateCall) Do(f func(context.Context, *storage.RawTx) error) *IRawTxUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRawTxUpdateCall) DoAndReturn(f func(context.Context, *storage.RawTx) error) *IRawTxUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rollup_metadata.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rollup_metadata.go -destination=mock/rollup_metadata.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRollupMetadata is a mock of IRollupMetadata interface.
+type MockIRollupMetadata struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRollupMetadataMockRecorder
+}
+
+// MockIRollupMetadataMockRecorder is the mock recorder for MockIRollupMetadata.
+type MockIRollupMetadataMockRecorder struct {
+	mock *MockIRollupMetadata
+}
+
+// NewMockIRollupMetadata creates a new mock instance.
+func NewMockIRollupMetadata(ctrl *gomock.Controller) *MockIRollupMetadata {
+	mock := &MockIRollupMetadata{ctrl: ctrl}
+	mock.recorder = &MockIRollupMetadataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRollupMetadata) EXPECT() *MockIRollupMetadataMockRecorder {
+	return m.recorder
+}
+
+// ApplyRegistry mocks base method.
+func (m *MockIRollupMetadata) ApplyRegistry(ctx context.Context, metadata ...*storage.RollupMetadata) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range metadata {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyRegistry", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyRegistry indicates an expected call of ApplyRegistry.
+func (mr *MockIRollupMetadataMockRecorder) ApplyRegistry(ctx any, metadata ...any) *IRollupMetadataApplyRegistryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, metadata...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRegistry", reflect.TypeOf((*MockIRollupMetadata)(nil).ApplyRegistry), varargs...)
+	return &IRollupMetadataApplyRegistryCall{Call: call}
+}
+
+// IRollupMetadataApplyRegistryCall wrap *gomock.Call
+type IRollupMetadataApplyRegistryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataApplyRegistryCall) Return(arg0 int64, arg1 error) *IRollupMetadataApplyRegistryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataApplyRegistryCall) Do(f func(context.Context, ...*storage.RollupMetadata) (int64, error)) *IRollupMetadataApplyRegistryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataApplyRegistryCall) DoAndReturn(f func(context.Context, ...*storage.RollupMetadata) (int64, error)) *IRollupMetadataApplyRegistryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByRollupId mocks base method.
+func (m *MockIRollupMetadata) ByRollupId(ctx context.Context, rollupId []byte) (storage.RollupMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByRollupId", ctx, rollupId)
+	ret0, _ := ret[0].(storage.RollupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByRollupId indicates an expected call of ByRollupId.
+func (mr *MockIRollupMetadataMockRecorder) ByRollupId(ctx, rollupId any) *IRollupMetadataByRollupIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollupId", reflect.TypeOf((*MockIRollupMetadata)(nil).ByRollupId), ctx, rollupId)
+	return &IRollupMetadataByRollupIdCall{Call: call}
+}
+
+// IRollupMetadataByRollupIdCall wrap *gomock.Call
+type IRollupMetadataByRollupIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataByRollupIdCall) Return(arg0 storage.RollupMetadata, arg1 error) *IRollupMetadataByRollupIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataByRollupIdCall) Do(f func(context.Context, []byte) (storage.RollupMetadata, error)) *IRollupMetadataByRollupIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataByRollupIdCall) DoAndReturn(f func(context.Context, []byte) (storage.RollupMetadata, error)) *IRollupMetadataByRollupIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIRollupMetadata) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RollupMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RollupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRollupMetadataMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IRollupMetadataCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRollupMetadata)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IRollupMetadataCursorListCall{Call: call}
+}
+
+// IRollupMetadataCursorListCall wrap *gomock.Call
+type IRollupMetadataCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataCursorListCall) Return(arg0 []*storage.RollupMetadata, arg1 error) *IRollupMetadataCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupMetadata, error)) *IRollupMetadataCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollupMetadata, error)) *IRollupMetadataCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteByRollupId mocks base method.
+func (m *MockIRollupMetadata) DeleteByRollupId(ctx context.Context, rollupId []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByRollupId", ctx, rollupId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByRollupId indicates an expected call of DeleteByRollupId.
+func (mr *MockIRollupMetadataMockRecorder) DeleteByRollupId(ctx, rollupId any) *IRollupMetadataDeleteByRollupIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByRollupId", reflect.TypeOf((*MockIRollupMetadata)(nil).DeleteByRollupId), ctx, rollupId)
+	return &IRollupMetadataDeleteByRollupIdCall{Call: call}
+}
+
+// IRollupMetadataDeleteByRollupIdCall wrap *gomock.Call
+type IRollupMetadataDeleteByRollupIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataDeleteByRollupIdCall) Return(arg0 error) *IRollupMetadataDeleteByRollupIdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataDeleteByRollupIdCall) Do(f func(context.Context, []byte) error) *IRollupMetadataDeleteByRollupIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataDeleteByRollupIdCall) DoAndReturn(f func(context.Context, []byte) error) *IRollupMetadataDeleteByRollupIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRollupMetadata) GetByID(ctx context.Context, id uint64) (*storage.RollupMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RollupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRollupMetadataMockRecorder) GetByID(ctx, id any) *IRollupMetadataGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRollupMetadata)(nil).GetByID), ctx, id)
+	return &IRollupMetadataGetByIDCall{Call: call}
+}
+
+// IRollupMetadataGetByIDCall wrap *gomock.Call
+type IRollupMetadataGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataGetByIDCall) Return(arg0 *storage.RollupMetadata, arg1 error) *IRollupMetadataGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataGetByIDCall) Do(f func(context.Context, uint64) (*storage.RollupMetadata, error)) *IRollupMetadataGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RollupMetadata, error)) *IRollupMetadataGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRollupMetadata) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRollupMetadataMockRecorder) IsNoRows(err any) *IRollupMetadataIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRollupMetadata)(nil).IsNoRows), err)
+	return &IRollupMetadataIsNoRowsCall{Call: call}
+}
+
+// IRollupMetadataIsNoRowsCall wrap *gomock.Call
+type IRollupMetadataIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataIsNoRowsCall) Return(arg0 bool) *IRollupMetadataIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataIsNoRowsCall) Do(f func(error) bool) *IRollupMetadataIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataIsNoRowsCall) DoAndReturn(f func(error) bool) *IRollupMetadataIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRollupMetadata) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRollupMetadataMockRecorder) LastID(ctx any) *IRollupMetadataLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRollupMetadata)(nil).LastID), ctx)
+	return &IRollupMetadataLastIDCall{Call: call}
+}
+
+// IRollupMetadataLastIDCall wrap *gomock.Call
+type IRollupMetadataLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataLastIDCall) Return(arg0 uint64, arg1 error) *IRollupMetadataLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataLastIDCall) Do(f func(context.Context) (uint64, error)) *IRollupMetadataLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IRollupMetadataLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRollupMetadata) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RollupMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RollupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRollupMetadataMockRecorder) List(ctx, limit, offset, order any) *IRollupMetadataListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRollupMetadata)(nil).List), ctx, limit, offset, order)
+	return &IRollupMetadataListCall{Call: call}
+}
+
+// IRollupMetadataListCall wrap *gomock.Call
+type IRollupMetadataListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataListCall) Return(arg0 []*storage.RollupMetadata, arg1 error) *IRollupMetadataListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupMetadata, error)) *IRollupMetadataListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollupMetadata, error)) *IRollupMetadataListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRollupMetadata) Save(ctx context.Context, m *storage.RollupMetadata) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRollupMetadataMockRecorder) Save(ctx, m any) *IRollupMetadataSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRollupMetadata)(nil).Save), ctx, m)
+	return &IRollupMetadataSaveCall{Call: call}
+}
+
+// IRollupMetadataSaveCall wrap *gomock.Call
+type IRollupMetadataSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataSaveCall) Return(arg0 error) *IRollupMetadataSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataSaveCall) Do(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataSaveCall) DoAndReturn(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRollupMetadata) Update(ctx context.Context, m *storage.RollupMetadata) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRollupMetadataMockRecorder) Update(ctx, m any) *IRollupMetadataUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRollupMetadata)(nil).Update), ctx, m)
+	return &IRollupMetadataUpdateCall{Call: call}
+}
+
+// IRollupMetadataUpdateCall wrap *gomock.Call
+type IRollupMetadataUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataUpdateCall) Return(arg0 error) *IRollupMetadataUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataUpdateCall) Do(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataUpdateCall) DoAndReturn(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Upsert mocks base method.
+func (m *MockIRollupMetadata) Upsert(ctx context.Context, metadata *storage.RollupMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockIRollupMetadataMockRecorder) Upsert(ctx, metadata any) *IRollupMetadataUpsertCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockIRollupMetadata)(nil).Upsert), ctx, metadata)
+	return &IRollupMetadataUpsertCall{Call: call}
+}
+
+// IRollupMetadataUpsertCall wrap *gomock.Call
+type IRollupMetadataUpsertCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IRollupMetadataUpsertCall) Return(arg0 error) *IRollupMetadataUpsertCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IRollupMetadataUpsertCall) Do(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataUpsertCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IRollupMetadataUpsertCall) DoAndReturn(f func(context.Context, *storage.RollupMetadata) error) *IRollupMetadataUpsertCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -16,6 +16,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/internal/storage/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,123 @@ func (m *MockIStats) EXPECT() *MockIStatsMockRecorder {
 	return m.recorder
 }
 
+// ActionSeries mocks base method.
+func (m *MockIStats) ActionSeries(ctx context.Context, timeframe storage.Timeframe, actionType types.ActionType, req storage.SeriesRequest) ([]storage.ActionSeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionSeries", ctx, timeframe, actionType, req)
+	ret0, _ := ret[0].([]storage.ActionSeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActionSeries indicates an expected call of ActionSeries.
+func (mr *MockIStatsMockRecorder) ActionSeries(ctx, timeframe, actionType, req any) *IStatsActionSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionSeries", reflect.TypeOf((*MockIStats)(nil).ActionSeries), ctx, timeframe, actionType, req)
+	return &IStatsActionSeriesCall{Call: call}
+}
+
+// IStatsActionSeriesCall wrap *gomock.Call
+type IStatsActionSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IStatsActionSeriesCall) Return(arg0 []storage.ActionSeriesItem, arg1 error) *IStatsActionSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IStatsActionSeriesCall) Do(f func(context.Context, storage.Timeframe, types.ActionType, storage.SeriesRequest) ([]storage.ActionSeriesItem, error)) *IStatsActionSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IStatsActionSeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, types.ActionType, storage.SeriesRequest) ([]storage.ActionSeriesItem, error)) *IStatsActionSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ActiveAddresses mocks base method.
+func (m *MockIStats) ActiveAddresses(ctx context.Context, req storage.SeriesRequest) ([]storage.ActiveAddressesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveAddresses", ctx, req)
+	ret0, _ := ret[0].([]storage.ActiveAddressesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveAddresses indicates an expected call of ActiveAddresses.
+func (mr *MockIStatsMockRecorder) ActiveAddresses(ctx, req any) *IStatsActiveAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveAddresses", reflect.TypeOf((*MockIStats)(nil).ActiveAddresses), ctx, req)
+	return &IStatsActiveAddressesCall{Call: call}
+}
+
+// IStatsActiveAddressesCall wrap *gomock.Call
+type IStatsActiveAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IStatsActiveAddressesCall) Return(arg0 []storage.ActiveAddressesItem, arg1 error) *IStatsActiveAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IStatsActiveAddressesCall) Do(f func(context.Context, storage.SeriesRequest) ([]storage.ActiveAddressesItem, error)) *IStatsActiveAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IStatsActiveAddressesCall) DoAndReturn(f func(context.Context, storage.SeriesRequest) ([]storage.ActiveAddressesItem, error)) *IStatsActiveAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Holders mocks base method.
+func (m *MockIStats) Holders(ctx context.Context, currency string, limit int) (storage.HolderStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holders", ctx, currency, limit)
+	ret0, _ := ret[0].(storage.HolderStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Holders indicates an expected call of Holders.
+func (mr *MockIStatsMockRecorder) Holders(ctx, currency, limit any) *IStatsHoldersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holders", reflect.TypeOf((*MockIStats)(nil).Holders), ctx, currency, limit)
+	return &IStatsHoldersCall{Call: call}
+}
+
+// IStatsHoldersCall wrap *gomock.Call
+type IStatsHoldersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IStatsHoldersCall) Return(arg0 storage.HolderStats, arg1 error) *IStatsHoldersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IStatsHoldersCall) Do(f func(context.Context, string, int) (storage.HolderStats, error)) *IStatsHoldersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IStatsHoldersCall) DoAndReturn(f func(context.Context, string, int) (storage.HolderStats, error)) *IStatsHoldersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollupSeries mocks base method.
 func (m *MockIStats) RollupSeries(ctx context.Context, rollupId uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) ([]storage.SeriesItem, error) {
 	m.ctrl.T.Helper()
@@ -158,3 +276,42 @@ func (c *IStatsSummaryCall) DoAndReturn(f func(context.Context) (storage.Network
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// TopRollups mocks base method.
+func (m *MockIStats) TopRollups(ctx context.Context, req storage.TopRollupsRequest) ([]storage.TopRollupItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopRollups", ctx, req)
+	ret0, _ := ret[0].([]storage.TopRollupItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopRollups indicates an expected call of TopRollups.
+func (mr *MockIStatsMockRecorder) TopRollups(ctx, req any) *IStatsTopRollupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopRollups", reflect.TypeOf((*MockIStats)(nil).TopRollups), ctx, req)
+	return &IStatsTopRollupsCall{Call: call}
+}
+
+// IStatsTopRollupsCall wrap *gomock.Call
+type IStatsTopRollupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IStatsTopRollupsCall) Return(arg0 []storage.TopRollupItem, arg1 error) *IStatsTopRollupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IStatsTopRollupsCall) Do(f func(context.Context, storage.TopRollupsRequest) ([]storage.TopRollupItem, error)) *IStatsTopRollupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IStatsTopRollupsCall) DoAndReturn(f func(context.Context, storage.TopRollupsRequest) ([]storage.TopRollupItem, error)) *IStatsTopRollupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -161,6 +161,128 @@ func (c *ITxByHeightCall) DoAndReturn(f func(context.Context, types.Level, int,
 	return c
 }
 
+// ByHeights mocks base method.
+func (m *MockITx) ByHeights(ctx context.Context, heights []types.Level, limit, offset int) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeights", ctx, heights, limit, offset)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeights indicates an expected call of ByHeights.
+func (mr *MockITxMockRecorder) ByHeights(ctx, heights, limit, offset any) *ITxByHeightsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeights", reflect.TypeOf((*MockITx)(nil).ByHeights), ctx, heights, limit, offset)
+	return &ITxByHeightsCall{Call: call}
+}
+
+// ITxByHeightsCall wrap *gomock.Call
+type ITxByHeightsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ITxByHeightsCall) Return(arg0 []storage.Tx, arg1 error) *ITxByHeightsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ITxByHeightsCall) Do(f func(context.Context, []types.Level, int, int) ([]storage.Tx, error)) *ITxByHeightsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ITxByHeightsCall) DoAndReturn(f func(context.Context, []types.Level, int, int) ([]storage.Tx, error)) *ITxByHeightsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByIdWithRelations mocks base method.
+func (m *MockITx) ByIdWithRelations(ctx context.Context, id uint64) (storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByIdWithRelations", ctx, id)
+	ret0, _ := ret[0].(storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIdWithRelations indicates an expected call of ByIdWithRelations.
+func (mr *MockITxMockRecorder) ByIdWithRelations(ctx, id any) *ITxByIdWithRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIdWithRelations", reflect.TypeOf((*MockITx)(nil).ByIdWithRelations), ctx, id)
+	return &ITxByIdWithRelationsCall{Call: call}
+}
+
+// ITxByIdWithRelationsCall wrap *gomock.Call
+type ITxByIdWithRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ITxByIdWithRelationsCall) Return(arg0 storage.Tx, arg1 error) *ITxByIdWithRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ITxByIdWithRelationsCall) Do(f func(context.Context, uint64) (storage.Tx, error)) *ITxByIdWithRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ITxByIdWithRelationsCall) DoAndReturn(f func(context.Context, uint64) (storage.Tx, error)) *ITxByIdWithRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByIds mocks base method.
+func (m *MockITx) ByIds(ctx context.Context, ids ...uint64) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIds indicates an expected call of ByIds.
+func (mr *MockITxMockRecorder) ByIds(ctx any, ids ...any) *ITxByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIds", reflect.TypeOf((*MockITx)(nil).ByIds), varargs...)
+	return &ITxByIdsCall{Call: call}
+}
+
+// ITxByIdsCall wrap *gomock.Call
+type ITxByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ITxByIdsCall) Return(arg0 []storage.Tx, arg1 error) *ITxByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ITxByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Tx, error)) *ITxByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ITxByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Tx, error)) *ITxByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockITx) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Tx, error) {
 	m.ctrl.T.Helper()
@@ -432,6 +554,44 @@ func (c *ITxSaveCall) DoAndReturn(f func(context.Context, *storage.Tx) error) *I
 	return c
 }
 
+// Stream mocks base method.
+func (m *MockITx) Stream(ctx context.Context, fltrs storage.TxFilter, fn func(storage.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, fltrs, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockITxMockRecorder) Stream(ctx, fltrs, fn any) *ITxStreamCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockITx)(nil).Stream), ctx, fltrs, fn)
+	return &ITxStreamCall{Call: call}
+}
+
+// ITxStreamCall wrap *gomock.Call
+type ITxStreamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ITxStreamCall) Return(arg0 error) *ITxStreamCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ITxStreamCall) Do(f func(context.Context, storage.TxFilter, func(storage.Tx) error) error) *ITxStreamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ITxStreamCall) DoAndReturn(f func(context.Context, storage.TxFilter, func(storage.Tx) error) error) *ITxStreamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockITx) Update(ctx context.Context, m *storage.Tx) error {
 	m_2.ctrl.T.Helper()
@@ -43,6 +43,50 @@ func (m *MockIValidator) EXPECT() *MockIValidatorMockRecorder {
 	return m.recorder
 }
 
+// ByIds mocks base method.
+func (m *MockIValidator) ByIds(ctx context.Context, ids ...uint64) ([]storage.Validator, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ByIds", varargs...)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByIds indicates an expected call of ByIds.
+func (mr *MockIValidatorMockRecorder) ByIds(ctx any, ids ...any) *IValidatorByIdsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByIds", reflect.TypeOf((*MockIValidator)(nil).ByIds), varargs...)
+	return &IValidatorByIdsCall{Call: call}
+}
+
+// IValidatorByIdsCall wrap *gomock.Call
+type IValidatorByIdsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorByIdsCall) Return(arg0 []storage.Validator, arg1 error) *IValidatorByIdsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorByIdsCall) Do(f func(context.Context, ...uint64) ([]storage.Validator, error)) *IValidatorByIdsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorByIdsCall) DoAndReturn(f func(context.Context, ...uint64) ([]storage.Validator, error)) *IValidatorByIdsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIValidator) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Validator, error) {
 	m.ctrl.T.Helper()
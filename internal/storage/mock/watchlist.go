@@ -0,0 +1,594 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watchlist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watchlist.go -destination=mock/watchlist.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWatchlist is a mock of IWatchlist interface.
+type MockIWatchlist struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWatchlistMockRecorder
+}
+
+// MockIWatchlistMockRecorder is the mock recorder for MockIWatchlist.
+type MockIWatchlistMockRecorder struct {
+	mock *MockIWatchlist
+}
+
+// NewMockIWatchlist creates a new mock instance.
+func NewMockIWatchlist(ctrl *gomock.Controller) *MockIWatchlist {
+	mock := &MockIWatchlist{ctrl: ctrl}
+	mock.recorder = &MockIWatchlistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWatchlist) EXPECT() *MockIWatchlistMockRecorder {
+	return m.recorder
+}
+
+// Active mocks base method.
+func (m *MockIWatchlist) Active(ctx context.Context) ([]storage.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", ctx)
+	ret0, _ := ret[0].([]storage.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockIWatchlistMockRecorder) Active(ctx any) *IWatchlistActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockIWatchlist)(nil).Active), ctx)
+	return &IWatchlistActiveCall{Call: call}
+}
+
+// IWatchlistActiveCall wrap *gomock.Call
+type IWatchlistActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistActiveCall) Return(arg0 []storage.Watchlist, arg1 error) *IWatchlistActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistActiveCall) Do(f func(context.Context) ([]storage.Watchlist, error)) *IWatchlistActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistActiveCall) DoAndReturn(f func(context.Context) ([]storage.Watchlist, error)) *IWatchlistActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Alert mocks base method.
+func (m *MockIWatchlist) Alert(ctx context.Context, id uint64) (storage.WatchlistAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alert", ctx, id)
+	ret0, _ := ret[0].(storage.WatchlistAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Alert indicates an expected call of Alert.
+func (mr *MockIWatchlistMockRecorder) Alert(ctx, id any) *IWatchlistAlertCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alert", reflect.TypeOf((*MockIWatchlist)(nil).Alert), ctx, id)
+	return &IWatchlistAlertCall{Call: call}
+}
+
+// IWatchlistAlertCall wrap *gomock.Call
+type IWatchlistAlertCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistAlertCall) Return(arg0 storage.WatchlistAlert, arg1 error) *IWatchlistAlertCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistAlertCall) Do(f func(context.Context, uint64) (storage.WatchlistAlert, error)) *IWatchlistAlertCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistAlertCall) DoAndReturn(f func(context.Context, uint64) (storage.WatchlistAlert, error)) *IWatchlistAlertCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Alerts mocks base method.
+func (m *MockIWatchlist) Alerts(ctx context.Context, watchlistId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.WatchlistAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alerts", ctx, watchlistId, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.WatchlistAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Alerts indicates an expected call of Alerts.
+func (mr *MockIWatchlistMockRecorder) Alerts(ctx, watchlistId, limit, offset, sort any) *IWatchlistAlertsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alerts", reflect.TypeOf((*MockIWatchlist)(nil).Alerts), ctx, watchlistId, limit, offset, sort)
+	return &IWatchlistAlertsCall{Call: call}
+}
+
+// IWatchlistAlertsCall wrap *gomock.Call
+type IWatchlistAlertsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistAlertsCall) Return(arg0 []storage.WatchlistAlert, arg1 error) *IWatchlistAlertsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistAlertsCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.WatchlistAlert, error)) *IWatchlistAlertsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistAlertsCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.WatchlistAlert, error)) *IWatchlistAlertsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWatchlist) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWatchlistMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IWatchlistCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWatchlist)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IWatchlistCursorListCall{Call: call}
+}
+
+// IWatchlistCursorListCall wrap *gomock.Call
+type IWatchlistCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistCursorListCall) Return(arg0 []*storage.Watchlist, arg1 error) *IWatchlistCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Watchlist, error)) *IWatchlistCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Watchlist, error)) *IWatchlistCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockIWatchlist) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIWatchlistMockRecorder) Delete(ctx, id any) *IWatchlistDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIWatchlist)(nil).Delete), ctx, id)
+	return &IWatchlistDeleteCall{Call: call}
+}
+
+// IWatchlistDeleteCall wrap *gomock.Call
+type IWatchlistDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistDeleteCall) Return(arg0 error) *IWatchlistDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistDeleteCall) Do(f func(context.Context, uint64) error) *IWatchlistDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistDeleteCall) DoAndReturn(f func(context.Context, uint64) error) *IWatchlistDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWatchlist) GetByID(ctx context.Context, id uint64) (*storage.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWatchlistMockRecorder) GetByID(ctx, id any) *IWatchlistGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWatchlist)(nil).GetByID), ctx, id)
+	return &IWatchlistGetByIDCall{Call: call}
+}
+
+// IWatchlistGetByIDCall wrap *gomock.Call
+type IWatchlistGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistGetByIDCall) Return(arg0 *storage.Watchlist, arg1 error) *IWatchlistGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistGetByIDCall) Do(f func(context.Context, uint64) (*storage.Watchlist, error)) *IWatchlistGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Watchlist, error)) *IWatchlistGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWatchlist) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWatchlistMockRecorder) IsNoRows(err any) *IWatchlistIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWatchlist)(nil).IsNoRows), err)
+	return &IWatchlistIsNoRowsCall{Call: call}
+}
+
+// IWatchlistIsNoRowsCall wrap *gomock.Call
+type IWatchlistIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistIsNoRowsCall) Return(arg0 bool) *IWatchlistIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistIsNoRowsCall) Do(f func(error) bool) *IWatchlistIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistIsNoRowsCall) DoAndReturn(f func(error) bool) *IWatchlistIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWatchlist) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWatchlistMockRecorder) LastID(ctx any) *IWatchlistLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWatchlist)(nil).LastID), ctx)
+	return &IWatchlistLastIDCall{Call: call}
+}
+
+// IWatchlistLastIDCall wrap *gomock.Call
+type IWatchlistLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistLastIDCall) Return(arg0 uint64, arg1 error) *IWatchlistLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistLastIDCall) Do(f func(context.Context) (uint64, error)) *IWatchlistLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IWatchlistLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWatchlist) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWatchlistMockRecorder) List(ctx, limit, offset, order any) *IWatchlistListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWatchlist)(nil).List), ctx, limit, offset, order)
+	return &IWatchlistListCall{Call: call}
+}
+
+// IWatchlistListCall wrap *gomock.Call
+type IWatchlistListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistListCall) Return(arg0 []*storage.Watchlist, arg1 error) *IWatchlistListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Watchlist, error)) *IWatchlistListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Watchlist, error)) *IWatchlistListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWatchlist) Save(ctx context.Context, m *storage.Watchlist) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWatchlistMockRecorder) Save(ctx, m any) *IWatchlistSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWatchlist)(nil).Save), ctx, m)
+	return &IWatchlistSaveCall{Call: call}
+}
+
+// IWatchlistSaveCall wrap *gomock.Call
+type IWatchlistSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistSaveCall) Return(arg0 error) *IWatchlistSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistSaveCall) Do(f func(context.Context, *storage.Watchlist) error) *IWatchlistSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistSaveCall) DoAndReturn(f func(context.Context, *storage.Watchlist) error) *IWatchlistSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAlerts mocks base method.
+func (m *MockIWatchlist) SaveAlerts(ctx context.Context, alerts ...*storage.WatchlistAlert) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range alerts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAlerts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAlerts indicates an expected call of SaveAlerts.
+func (mr *MockIWatchlistMockRecorder) SaveAlerts(ctx any, alerts ...any) *IWatchlistSaveAlertsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, alerts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAlerts", reflect.TypeOf((*MockIWatchlist)(nil).SaveAlerts), varargs...)
+	return &IWatchlistSaveAlertsCall{Call: call}
+}
+
+// IWatchlistSaveAlertsCall wrap *gomock.Call
+type IWatchlistSaveAlertsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistSaveAlertsCall) Return(arg0 error) *IWatchlistSaveAlertsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistSaveAlertsCall) Do(f func(context.Context, ...*storage.WatchlistAlert) error) *IWatchlistSaveAlertsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistSaveAlertsCall) DoAndReturn(f func(context.Context, ...*storage.WatchlistAlert) error) *IWatchlistSaveAlertsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetDelivered mocks base method.
+func (m *MockIWatchlist) SetDelivered(ctx context.Context, ids ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDelivered", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDelivered indicates an expected call of SetDelivered.
+func (mr *MockIWatchlistMockRecorder) SetDelivered(ctx any, ids ...any) *IWatchlistSetDeliveredCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelivered", reflect.TypeOf((*MockIWatchlist)(nil).SetDelivered), varargs...)
+	return &IWatchlistSetDeliveredCall{Call: call}
+}
+
+// IWatchlistSetDeliveredCall wrap *gomock.Call
+type IWatchlistSetDeliveredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistSetDeliveredCall) Return(arg0 error) *IWatchlistSetDeliveredCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistSetDeliveredCall) Do(f func(context.Context, ...uint64) error) *IWatchlistSetDeliveredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistSetDeliveredCall) DoAndReturn(f func(context.Context, ...uint64) error) *IWatchlistSetDeliveredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Undelivered mocks base method.
+func (m *MockIWatchlist) Undelivered(ctx context.Context, afterId uint64, limit int) ([]storage.WatchlistAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undelivered", ctx, afterId, limit)
+	ret0, _ := ret[0].([]storage.WatchlistAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undelivered indicates an expected call of Undelivered.
+func (mr *MockIWatchlistMockRecorder) Undelivered(ctx, afterId, limit any) *IWatchlistUndeliveredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelivered", reflect.TypeOf((*MockIWatchlist)(nil).Undelivered), ctx, afterId, limit)
+	return &IWatchlistUndeliveredCall{Call: call}
+}
+
+// IWatchlistUndeliveredCall wrap *gomock.Call
+type IWatchlistUndeliveredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistUndeliveredCall) Return(arg0 []storage.WatchlistAlert, arg1 error) *IWatchlistUndeliveredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistUndeliveredCall) Do(f func(context.Context, uint64, int) ([]storage.WatchlistAlert, error)) *IWatchlistUndeliveredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistUndeliveredCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.WatchlistAlert, error)) *IWatchlistUndeliveredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWatchlist) Update(ctx context.Context, m *storage.Watchlist) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWatchlistMockRecorder) Update(ctx, m any) *IWatchlistUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWatchlist)(nil).Update), ctx, m)
+	return &IWatchlistUpdateCall{Call: call}
+}
+
+// IWatchlistUpdateCall wrap *gomock.Call
+type IWatchlistUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IWatchlistUpdateCall) Return(arg0 error) *IWatchlistUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IWatchlistUpdateCall) Do(f func(context.Context, *storage.Watchlist) error) *IWatchlistUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IWatchlistUpdateCall) DoAndReturn(f func(context.Context, *storage.Watchlist) error) *IWatchlistUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IOutbox interface {
+	storage.Table[*Outbox]
+
+	Pending(ctx context.Context, limit int) ([]Outbox, error)
+	Delete(ctx context.Context, id uint64) error
+}
+
+// Outbox - batch of events waiting for delivery to external consumer
+type Outbox struct {
+	bun.BaseModel `bun:"outbox" comment:"Table with events waiting for delivery"`
+
+	Id            uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	CreatedAt     time.Time      `bun:"created_at,notnull"          comment:"Time when events were added to outbox"`
+	Height        pkgTypes.Level `bun:"height"                      comment:"Block height of events"`
+	Payload       string         `bun:"payload,type:jsonb"          comment:"Serialized events"`
+	Attempts      int            `bun:"attempts"                    comment:"Count of delivery attempts"`
+	NextAttemptAt time.Time      `bun:"next_attempt_at,notnull"     comment:"Time of the next delivery attempt"`
+	Error         string         `bun:"error,type:text"             comment:"Error of the last delivery attempt"`
+	Failed        bool           `bun:"failed"                      comment:"Delivery was failed after all attempts"`
+}
+
+// TableName -
+func (Outbox) TableName() string {
+	return "outbox"
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
-	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/uptrace/bun"
 )
@@ -29,7 +28,8 @@ func NewAction(db *database.Bun) *Action {
 func (a *Action) ByBlock(ctx context.Context, height types.Level, limit, offset int) (actions []storage.ActionWithTx, err error) {
 	query := a.DB().NewSelect().
 		Model((*storage.Action)(nil)).
-		Where("height = ?", height)
+		Where("height = ?", height).
+		Order("id asc")
 
 	query = limitScope(query, limit)
 	query = offsetScope(query, offset)
@@ -39,6 +39,7 @@ func (a *Action) ByBlock(ctx context.Context, height types.Level, limit, offset
 		ColumnExpr("action.*").
 		ColumnExpr("tx.hash as tx__hash").
 		Join("left join tx on tx.id = action.tx_id").
+		Order("action.id asc").
 		Scan(ctx, &actions)
 	return
 }
@@ -64,37 +65,80 @@ func (a *Action) ByAddress(ctx context.Context, addressId uint64, filters storag
 		query = query.Where("action_type IN (?)", bun.In(filters.ActionTypes.Strings()))
 	}
 
-	query = sortScope(query, "action_id", filters.Sort)
+	query = timeRangeScope(query, "time", filters.TimeFrom, filters.TimeTo)
+	query = cursorScope(query, "time", "action_id", filters.Cursor, filters.Sort)
 	query = limitScope(query, filters.Limit)
 	query = offsetScope(query, filters.Offset)
 
-	err = a.DB().NewSelect().
+	outer := a.DB().NewSelect().
 		TableExpr("(?) as address_action", query).
 		ColumnExpr("address_action.*").
 		ColumnExpr("action.id as action__id, action.height as action__height, action.time as action__time, action.position as action__position, action.type as action__type, action.tx_id as action__tx_id, action.data as action__data").
 		ColumnExpr("tx.hash as tx__hash").
 		Join("left join tx on tx.id = address_action.tx_id").
-		Join("left join action on action.id = address_action.action_id").
-		Scan(ctx, &actions)
+		Join("left join action on action.id = address_action.action_id")
+	outer = timeSortScope(outer, "address_action.time", "address_action.action_id", filters.Sort)
+
+	err = outer.Scan(ctx, &actions)
 	return
 }
 
-func (a *Action) ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort sdk.SortOrder) (actions []storage.RollupAction, err error) {
+func (a *Action) ByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter) (actions []storage.RollupAction, err error) {
 	query := a.DB().NewSelect().
 		Model((*storage.RollupAction)(nil)).
 		Where("rollup_id = ?", rollupId)
 
-	query = sortScope(query, "action_id", sort)
-	query = limitScope(query, limit)
-	query = offsetScope(query, offset)
+	query = timeRangeScope(query, "time", fltrs.TimeFrom, fltrs.TimeTo)
+	query = cursorScope(query, "time", "action_id", fltrs.Cursor, fltrs.Sort)
+	query = limitScope(query, fltrs.Limit)
+	query = offsetScope(query, fltrs.Offset)
 
-	err = a.DB().NewSelect().
+	outer := a.DB().NewSelect().
 		TableExpr("(?) as rollup_action", query).
 		ColumnExpr("rollup_action.*").
 		ColumnExpr("action.id as action__id, action.height as action__height, action.time as action__time, action.position as action__position, action.type as action__type, action.tx_id as action__tx_id, action.data as action__data").
 		ColumnExpr("tx.hash as tx__hash").
 		Join("left join tx on tx.id = rollup_action.tx_id").
-		Join("left join action on action.id = rollup_action.action_id").
-		Scan(ctx, &actions)
+		Join("left join action on action.id = rollup_action.action_id")
+	outer = timeSortScope(outer, "rollup_action.time", "rollup_action.action_id", fltrs.Sort)
+
+	err = outer.Scan(ctx, &actions)
 	return
 }
+
+// StreamByAddress - reads address actions matched the filter row by row without limit. Limit, offset and cursor are ignored.
+func (a *Action) StreamByAddress(ctx context.Context, addressId uint64, filters storage.AddressActionsFilter, fn func(storage.AddressAction) error) error {
+	query := a.DB().NewSelect().
+		TableExpr("address_action").
+		ColumnExpr("address_action.*").
+		ColumnExpr("action.id as action__id, action.height as action__height, action.time as action__time, action.position as action__position, action.type as action__type, action.tx_id as action__tx_id, action.data as action__data").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join tx on tx.id = address_action.tx_id").
+		Join("left join action on action.id = address_action.action_id").
+		Where("address_action.address_id = ?", addressId)
+
+	if filters.ActionTypes.Bits > 0 {
+		query = query.Where("address_action.action_type IN (?)", bun.In(filters.ActionTypes.Strings()))
+	}
+	query = timeRangeScope(query, "address_action.time", filters.TimeFrom, filters.TimeTo)
+	query = timeSortScope(query, "address_action.time", "address_action.action_id", filters.Sort)
+
+	return stream(ctx, a.DB(), query, fn)
+}
+
+// StreamByRollup - reads rollup actions matched the filter row by row without limit. Limit, offset and cursor are ignored.
+func (a *Action) StreamByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter, fn func(storage.RollupAction) error) error {
+	query := a.DB().NewSelect().
+		TableExpr("rollup_action").
+		ColumnExpr("rollup_action.*").
+		ColumnExpr("action.id as action__id, action.height as action__height, action.time as action__time, action.position as action__position, action.type as action__type, action.tx_id as action__tx_id, action.data as action__data").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join tx on tx.id = rollup_action.tx_id").
+		Join("left join action on action.id = rollup_action.action_id").
+		Where("rollup_action.rollup_id = ?", rollupId)
+
+	query = timeRangeScope(query, "rollup_action.time", fltrs.TimeFrom, fltrs.TimeTo)
+	query = timeSortScope(query, "rollup_action.time", "rollup_action.action_id", fltrs.Sort)
+
+	return stream(ctx, a.DB(), query, fn)
+}
@@ -75,7 +75,10 @@ func (s *StorageTestSuite) TestActionByRollup() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	actions, err := s.storage.Action.ByRollup(ctx, 1, 1, 0, sdk.SortOrderDesc)
+	actions, err := s.storage.Action.ByRollup(ctx, 1, storage.RollupActionsFilter{
+		Limit: 1,
+		Sort:  sdk.SortOrderDesc,
+	})
 	s.Require().NoError(err)
 	s.Require().Len(actions, 1)
 
@@ -89,3 +92,25 @@ func (s *StorageTestSuite) TestActionByRollup() {
 	s.Require().EqualValues(types.ActionTypeSequence, action.Action.Type)
 	s.Require().NotNil(action.Action.Data)
 }
+
+func (s *StorageTestSuite) TestActionStreamByRollup() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	var actions []storage.RollupAction
+	err := s.storage.Action.StreamByRollup(ctx, 1, storage.RollupActionsFilter{
+		Sort: sdk.SortOrderDesc,
+	}, func(action storage.RollupAction) error {
+		actions = append(actions, action)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(actions)
+
+	action := actions[0]
+	s.Require().EqualValues(7316, action.Height)
+	s.Require().EqualValues(1, action.ActionId)
+	s.Require().NotNil(action.Tx)
+	s.Require().NotNil(action.Action)
+	s.Require().EqualValues(types.ActionTypeSequence, action.Action.Type)
+}
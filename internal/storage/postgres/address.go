@@ -9,6 +9,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Address -
@@ -42,3 +43,15 @@ func (a *Address) ListWithBalance(ctx context.Context, fltrs storage.AddressList
 	err = query.Scan(ctx)
 	return
 }
+
+// ByIds - returns addresses with balances by internal identities
+func (a *Address) ByIds(ctx context.Context, ids ...uint64) (address []storage.Address, err error) {
+	if len(ids) == 0 {
+		return
+	}
+	err = a.DB().NewSelect().Model(&address).
+		Where("address.id IN (?)", bun.In(ids)).
+		Relation("Balance").
+		Scan(ctx)
+	return
+}
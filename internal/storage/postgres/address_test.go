@@ -52,3 +52,17 @@ func (s *StorageTestSuite) TestAddressListWithBalances() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(hash, address.Hash)
 }
+
+func (s *StorageTestSuite) TestAddressByIds() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.storage.Address.ByIds(ctx, 1, 2, 100)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+
+	for i := range addresses {
+		s.Require().Contains([]uint64{1, 2}, addresses[i].Id)
+		s.Require().NotNil(addresses[i].Balance)
+	}
+}
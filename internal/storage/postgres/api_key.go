@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// ApiKey -
+type ApiKey struct {
+	*postgres.Table[*storage.ApiKey]
+}
+
+// NewApiKey -
+func NewApiKey(db *database.Bun) *ApiKey {
+	return &ApiKey{
+		Table: postgres.NewTable[*storage.ApiKey](db),
+	}
+}
+
+// ByHash - returns key by hash of its value
+func (k *ApiKey) ByHash(ctx context.Context, hash []byte) (key storage.ApiKey, err error) {
+	err = k.DB().NewSelect().
+		Model(&key).
+		Where("hash = ?", hash).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Revoke - marks key as revoked. Revoked key can't be used anymore.
+func (k *ApiKey) Revoke(ctx context.Context, id uint64) error {
+	_, err := k.DB().NewUpdate().
+		Model((*storage.ApiKey)(nil)).
+		Set("revoked_at = now()").
+		Where("id = ?", id).
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+	return err
+}
+
+// Usage - returns count of requests made with the key during the day
+func (k *ApiKey) Usage(ctx context.Context, keyId uint64, day time.Time) (requests int64, err error) {
+	err = k.DB().NewSelect().
+		Model((*storage.ApiKeyUsage)(nil)).
+		ColumnExpr("coalesce(sum(requests), 0)").
+		Where("key_id = ?", keyId).
+		Where("day = ?", day).
+		Scan(ctx, &requests)
+	return
+}
+
+// SaveUsage - adds counts of requests to daily usage and total counters of keys
+func (k *ApiKey) SaveUsage(ctx context.Context, usage ...storage.ApiKeyUsage) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	return k.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(&usage).
+			On("CONFLICT (key_id, day) DO UPDATE").
+			Set("requests = api_key_usage.requests + EXCLUDED.requests").
+			Exec(ctx); err != nil {
+			return err
+		}
+		for i := range usage {
+			if _, err := tx.NewUpdate().
+				Model((*storage.ApiKey)(nil)).
+				Set("total_requests = total_requests + ?", usage[i].Requests).
+				Set("last_used_at = now()").
+				Where("id = ?", usage[i].KeyId).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+func (s *StorageTestSuite) TestApiKeyByHash() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	key, err := s.storage.ApiKey.ByHash(ctx, storage.HashApiKey("test-key"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, key.Id)
+	s.Require().Equal("explorer", key.Name)
+	s.Require().EqualValues(10, key.Rps)
+	s.Require().EqualValues(1000, key.DailyQuota)
+	s.Require().Equal([]string{"block", "tx"}, key.Groups)
+	s.Require().False(key.IsRevoked())
+
+	_, err = s.storage.ApiKey.ByHash(ctx, storage.HashApiKey("unknown"))
+	s.Require().Error(err)
+	s.Require().True(s.storage.ApiKey.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestApiKeyUsage() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	requests, err := s.storage.ApiKey.Usage(ctx, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, requests)
+
+	requests, err = s.storage.ApiKey.Usage(ctx, 1, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, requests)
+}
+
+func (s *StorageTestSuite) TestApiKeySaveUsage() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	day := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		err := s.storage.ApiKey.SaveUsage(ctx, storage.ApiKeyUsage{
+			KeyId:    2,
+			Day:      day,
+			Requests: 5,
+		})
+		s.Require().NoError(err)
+	}
+
+	requests, err := s.storage.ApiKey.Usage(ctx, 2, day)
+	s.Require().NoError(err)
+	s.Require().EqualValues(10, requests)
+
+	key, err := s.storage.ApiKey.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(10, key.TotalRequests)
+	s.Require().NotNil(key.LastUsedAt)
+}
+
+func (s *StorageTestSuite) TestApiKeyRevoke() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	key := storage.ApiKey{
+		CreatedAt: time.Now(),
+		Name:      "temporary",
+		Prefix:    "temp",
+		Hash:      storage.HashApiKey("temporary"),
+		Rps:       1,
+	}
+	s.Require().NoError(s.storage.ApiKey.Save(ctx, &key))
+	s.Require().Positive(key.Id)
+
+	s.Require().NoError(s.storage.ApiKey.Revoke(ctx, key.Id))
+
+	revoked, err := s.storage.ApiKey.GetByID(ctx, key.Id)
+	s.Require().NoError(err)
+	s.Require().True(revoked.IsRevoked())
+}
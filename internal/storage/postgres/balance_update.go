@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+// BalanceUpdate -
+type BalanceUpdate struct {
+	*postgres.Table[*storage.BalanceUpdate]
+}
+
+// NewBalanceUpdate -
+func NewBalanceUpdate(db *database.Bun) *BalanceUpdate {
+	return &BalanceUpdate{
+		Table: postgres.NewTable[*storage.BalanceUpdate](db),
+	}
+}
+
+// BalancesAtHeight - returns balances of address in every currency after the block with the height
+func (bu *BalanceUpdate) BalancesAtHeight(ctx context.Context, addressId uint64, height types.Level) (balances []storage.Balance, err error) {
+	err = bu.balancesQuery(addressId).
+		Where("height <= ?", height).
+		Scan(ctx, &balances)
+	return
+}
+
+// BalancesAtTime - returns balances of address in every currency at the moment
+func (bu *BalanceUpdate) BalancesAtTime(ctx context.Context, addressId uint64, t time.Time) (balances []storage.Balance, err error) {
+	err = bu.balancesQuery(addressId).
+		Where("time <= ?", t).
+		Scan(ctx, &balances)
+	return
+}
+
+func (bu *BalanceUpdate) balancesQuery(addressId uint64) *bun.SelectQuery {
+	return bu.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		ColumnExpr("address_id as id, currency, sum(update) as total").
+		Where("address_id = ?", addressId).
+		Group("address_id", "currency").
+		Order("currency asc")
+}
+
+// Series - returns balance of address at the end of every time bucket. Balance is a running total of all changes from the first update.
+// Range and merging of buckets are the same as in Stats.Series. Minute timeframe is not supported: minute buckets of every address
+// would be comparable with the balance_update table by size.
+func (bu *BalanceUpdate) Series(ctx context.Context, addressId uint64, currency string, timeframe storage.Timeframe, req storage.SeriesRequest) (items []storage.BalanceSeriesItem, err error) {
+	var view string
+	switch timeframe {
+	case storage.TimeframeHour:
+		view = storage.ViewBalanceUpdateByHour
+	case storage.TimeframeDay, storage.TimeframeWeek:
+		view = storage.ViewBalanceUpdateByDay
+	case storage.TimeframeMonth:
+		view = storage.ViewBalanceUpdateByMonth
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	first := bu.DB().NewSelect().
+		Table(view).
+		Where("address_id = ?", addressId).
+		Where("currency = ?", currency)
+	req, err = seriesRange(ctx, first, req)
+	if err != nil {
+		return
+	}
+
+	series := bu.DB().NewSelect().
+		Table(view).
+		ColumnExpr("ts, change, updates_count").
+		ColumnExpr("sum(change) over (order by ts asc) as balance").
+		Where("address_id = ?", addressId).
+		Where("currency = ?", currency)
+
+	query := bu.DB().NewSelect().
+		TableExpr("(?) as series", series).
+		ColumnExpr("time_bucket(?::interval, ts) as ts", seriesInterval(timeframe, req, time.Now().UTC())).
+		ColumnExpr("sum(change) as change, last(balance, ts) as balance, sum(updates_count) as updates_count")
+
+	err = seriesScope(query, req).
+		GroupExpr("1").
+		OrderExpr("1 desc").
+		Scan(ctx, &items)
+	return
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+func (s *StorageTestSuite) TestBalancesAtHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tt := range []struct {
+		height types.Level
+		want   string
+	}{
+		{height: 0, want: "500000000000000000000"},
+		{height: 7965, want: "499999999999999999999"},
+	} {
+		balances, err := s.storage.BalanceUpdate.BalancesAtHeight(ctx, 1, tt.height)
+		s.Require().NoError(err)
+		s.Require().Len(balances, 1)
+		s.Require().EqualValues(1, balances[0].Id)
+		s.Require().Equal("nria", balances[0].Currency)
+		s.Require().Equal(tt.want, balances[0].Total.String())
+	}
+}
+
+func (s *StorageTestSuite) TestBalancesAtTime() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	balances, err := s.storage.BalanceUpdate.BalancesAtTime(ctx, 1, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal("500000000000000000000", balances[0].Total.String())
+
+	balances, err = s.storage.BalanceUpdate.BalancesAtTime(ctx, 1, time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Len(balances, 0)
+}
+
+func (s *StorageTestSuite) TestBalanceSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		series, err := s.storage.BalanceUpdate.Series(ctx, 1, "nria", tf, storage.SeriesRequest{})
+		s.Require().NoError(err, tf)
+		s.Require().NotEmpty(series, tf)
+
+		last := series[0]
+		s.Require().Equal("499999999999999999999", last.Balance.String(), tf)
+	}
+
+	series, err := s.storage.BalanceUpdate.Series(ctx, 1, "nria", storage.TimeframeHour, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(series, 2)
+	s.Require().Equal("-1", series[0].Change.String())
+	s.Require().EqualValues(1, series[0].Count)
+	s.Require().Equal("500000000000000000000", series[1].Balance.String())
+
+	series, err = s.storage.BalanceUpdate.Series(ctx, 1, "nria", storage.TimeframeHour, storage.NewSeriesRequest(1701388800, 0))
+	s.Require().NoError(err)
+	s.Require().Len(series, 1)
+	s.Require().Equal("499999999999999999999", series[0].Balance.String())
+}
@@ -83,10 +83,17 @@ func (b *Blocks) ByHash(ctx context.Context, hash []byte) (block storage.Block,
 	return
 }
 
-// ListWithStats -
-func (b *Blocks) ListWithStats(ctx context.Context, limit, offset uint64, order sdk.SortOrder) (blocks []*storage.Block, err error) {
+// Filter - returns list of blocks. Stats and proposer are joined if WithStats is set.
+func (b *Blocks) Filter(ctx context.Context, fltrs storage.BlockListFilter) (blocks []*storage.Block, err error) {
 	subQuery := b.DB().NewSelect().Model(&blocks)
-	subQuery = postgres.Pagination(subQuery, limit, offset, order)
+	subQuery = limitScope(subQuery, fltrs.Limit)
+	subQuery = cursorScope(subQuery, "time", "id", fltrs.Cursor, fltrs.Sort)
+	subQuery = offsetScope(subQuery, fltrs.Offset)
+
+	if !fltrs.WithStats {
+		err = subQuery.Scan(ctx)
+		return
+	}
 
 	query := b.DB().NewSelect().
 		ColumnExpr("block.*").
@@ -97,7 +104,7 @@ func (b *Blocks) ListWithStats(ctx context.Context, limit, offset uint64, order
 		TableExpr("(?) as block", subQuery).
 		Join("LEFT JOIN block_stats as stats ON stats.height = block.height").
 		Join("LEFT JOIN validator as v ON v.id = block.proposer_id")
-	query = sortScope(query, "block.id", order)
+	query = timeSortScope(query, "block.time", "block.id", fltrs.Sort)
 	err = query.Scan(ctx, &blocks)
 
 	return
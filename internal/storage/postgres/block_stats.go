@@ -9,6 +9,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
+	"github.com/uptrace/bun"
 )
 
 // BlockStats -
@@ -32,3 +33,14 @@ func (b *BlockStats) ByHeight(ctx context.Context, height types.Level) (stats st
 
 	return
 }
+
+// ByHeights - returns stats of blocks with passed heights
+func (b *BlockStats) ByHeights(ctx context.Context, heights ...types.Level) (stats []storage.BlockStats, err error) {
+	if len(heights) == 0 {
+		return
+	}
+	err = b.db.DB().NewSelect().Model(&stats).
+		Where("height IN (?)", bun.In(heights)).
+		Scan(ctx)
+	return
+}
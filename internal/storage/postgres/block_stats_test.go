@@ -6,6 +6,8 @@ package postgres
 import (
 	"context"
 	"time"
+
+	"github.com/celenium-io/astria-indexer/pkg/types"
 )
 
 func (s *StorageTestSuite) TestBlockStatsByHeight() {
@@ -24,3 +26,15 @@ func (s *StorageTestSuite) TestBlockStatsByHeight() {
 	s.Require().EqualValues(0, stats.GasWanted)
 	s.Require().EqualValues("0", stats.SupplyChange.String())
 }
+
+func (s *StorageTestSuite) TestBlockStatsByHeights() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	stats, err := s.storage.BlockStats.ByHeights(ctx, 7964, 7965, 1)
+	s.Require().NoError(err)
+	s.Require().Len(stats, 2)
+
+	heights := []types.Level{stats[0].Height, stats[1].Height}
+	s.Require().ElementsMatch([]types.Level{7964, 7965}, heights)
+}
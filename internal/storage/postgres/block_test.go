@@ -8,7 +8,8 @@ import (
 	"encoding/hex"
 	"time"
 
-	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
 func (s *StorageTestSuite) TestBlockByHeight() {
@@ -95,7 +96,11 @@ func (s *StorageTestSuite) TestBlockListWithStats() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	blocks, err := s.storage.Blocks.ListWithStats(ctx, 1, 0, storage.SortOrderDesc)
+	blocks, err := s.storage.Blocks.Filter(ctx, storage.BlockListFilter{
+		Limit:     1,
+		Sort:      sdk.SortOrderDesc,
+		WithStats: true,
+	})
 	s.Require().NoError(err)
 	s.Require().Len(blocks, 1)
 
@@ -117,7 +122,7 @@ func (s *StorageTestSuite) TestBlockByProposer() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	blocks, err := s.storage.Blocks.ByProposer(ctx, 2, 1, 0, storage.SortOrderDesc)
+	blocks, err := s.storage.Blocks.ByProposer(ctx, 2, 1, 0, sdk.SortOrderDesc)
 	s.Require().NoError(err)
 	s.Require().Len(blocks, 1)
 
@@ -5,6 +5,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	models "github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/config"
@@ -27,14 +28,21 @@ type Storage struct {
 	BlockStats      models.IBlockStats
 	Constants       models.IConstant
 	Tx              models.ITx
+	RawTx           models.IRawTx
+	RawBlock        models.IRawBlock
 	Action          models.IAction
 	Address         models.IAddress
+	BalanceUpdate   models.IBalanceUpdate
 	Rollup          models.IRollup
+	RollupMetadata  models.IRollupMetadata
 	BlockSignatures models.IBlockSignature
 	Validator       models.IValidator
 	State           models.IState
 	Search          models.ISearch
 	Stats           models.IStats
+	Outbox          models.IOutbox
+	Watchlist       models.IWatchlist
+	ApiKey          models.IApiKey
 	Notificator     *Notificator
 }
 
@@ -54,13 +62,20 @@ func Create(ctx context.Context, cfg config.Database, scriptsDir string) (Storag
 		Constants:       NewConstant(strg.Connection()),
 		Action:          NewAction(strg.Connection()),
 		Address:         NewAddress(strg.Connection()),
+		BalanceUpdate:   NewBalanceUpdate(strg.Connection()),
 		BlockSignatures: NewBlockSignature(strg.Connection()),
 		Rollup:          NewRollup(strg.Connection()),
+		RollupMetadata:  NewRollupMetadata(strg.Connection()),
 		Tx:              NewTx(strg.Connection()),
+		RawTx:           NewRawTx(strg.Connection()),
+		RawBlock:        NewRawBlock(strg.Connection()),
 		Validator:       NewValidator(strg.Connection()),
 		State:           NewState(strg.Connection()),
 		Search:          NewSearch(strg.Connection()),
 		Stats:           NewStats(strg.Connection()),
+		Outbox:          NewOutbox(strg.Connection()),
+		Watchlist:       NewWatchlist(strg.Connection()),
+		ApiKey:          NewApiKey(strg.Connection()),
 		Notificator:     NewNotificator(cfg, strg.Connection().DB()),
 	}
 
@@ -97,6 +112,27 @@ func initDatabase(ctx context.Context, conn *database.Bun) error {
 		return err
 	}
 
+	if err := migrateBalanceUpdateTime(ctx, conn); err != nil {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+		return errors.Wrap(err, "migrate balance updates")
+	}
+
+	if err := migrateRollupActionSender(ctx, conn); err != nil {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+		return errors.Wrap(err, "migrate rollup actions")
+	}
+
+	if err := migrateRawTxCompression(ctx, conn); err != nil {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+		return errors.Wrap(err, "migrate raw transactions")
+	}
+
 	if err := database.MakeComments(ctx, conn, models.Models...); err != nil {
 		if err := conn.Close(); err != nil {
 			return err
@@ -111,19 +147,52 @@ func initDatabase(ctx context.Context, conn *database.Bun) error {
 		return errors.Wrap(err, "create hypertables")
 	}
 
+	if err := seedHolderBuckets(ctx, conn); err != nil {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+		return errors.Wrap(err, "seed holder buckets")
+	}
+
 	return createIndices(ctx, conn)
 }
 
+// hypertables - tables partitioned by block time
+var hypertables = []storage.Model{
+	&models.Block{},
+	&models.BlockStats{},
+	&models.Tx{},
+	&models.RawTx{},
+	&models.RawBlock{},
+	&models.Action{},
+	&models.BlockSignature{},
+	&models.RollupAction{},
+	&models.BalanceUpdate{},
+}
+
+// createHypertables - converts empty tables to hypertables. Tables with data aren't converted on start:
+// rows are copied to chunks under exclusive lock of the table, so it's done by explicit migration (see MigrateHypertables).
 func createHypertables(ctx context.Context, conn *database.Bun) error {
+	for _, model := range hypertables {
+		table := model.TableName()
+		ok, err := isHypertable(ctx, conn, table)
+		if err != nil {
+			return err
+		}
+		if ok {
+			continue
+		}
+		hasRows, err := conn.DB().NewSelect().TableExpr("?", bun.Ident(table)).Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if hasRows {
+			return errors.Errorf("table %s contains data and isn't a hypertable: stop indexer and API and run `admin migrate hypertables`", table)
+		}
+	}
+
 	return conn.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		for _, model := range []storage.Model{
-			&models.Block{},
-			&models.BlockStats{},
-			&models.Tx{},
-			&models.Action{},
-			&models.BlockSignature{},
-			&models.RollupAction{},
-		} {
+		for _, model := range hypertables {
 			if _, err := tx.ExecContext(ctx,
 				`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE);`,
 				model.TableName(),
@@ -135,6 +204,179 @@ func createHypertables(ctx context.Context, conn *database.Bun) error {
 	})
 }
 
+// MigrateHypertables - converts tables with data to hypertables for databases created before the tables became hypertables.
+// Existing rows are copied to chunks while the table is locked exclusively: indexer and API must be stopped.
+// Downtime is proportional to the count of rows in the table.
+// Returns names of converted tables.
+func MigrateHypertables(ctx context.Context, cfg config.Database) ([]string, error) {
+	conn := database.NewBun()
+	if err := conn.Connect(ctx, cfg); err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	migrated := make([]string, 0)
+	for _, model := range hypertables {
+		table := model.TableName()
+		exists, err := tableExists(ctx, conn, table)
+		if err != nil {
+			return migrated, err
+		}
+		if !exists {
+			continue
+		}
+		ok, err := isHypertable(ctx, conn, table)
+		if err != nil {
+			return migrated, err
+		}
+		if ok {
+			continue
+		}
+
+		if table == (models.BalanceUpdate{}).TableName() {
+			if err := migrateBalanceUpdateTime(ctx, conn); err != nil {
+				return migrated, errors.Wrap(err, "migrate balance updates")
+			}
+		}
+
+		if _, err := conn.DB().ExecContext(ctx,
+			`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE, migrate_data => TRUE);`,
+			table,
+		); err != nil {
+			return migrated, errors.Wrapf(err, "migrate %s", table)
+		}
+		migrated = append(migrated, table)
+	}
+	return migrated, nil
+}
+
+func isHypertable(ctx context.Context, conn *database.Bun, table string) (bool, error) {
+	return conn.DB().NewSelect().
+		TableExpr("timescaledb_information.hypertables").
+		Where("hypertable_schema = current_schema()").
+		Where("hypertable_name = ?", table).
+		Exists(ctx)
+}
+
+func tableExists(ctx context.Context, conn *database.Bun, table string) (bool, error) {
+	return conn.DB().NewSelect().
+		TableExpr("information_schema.tables").
+		Where("table_schema = current_schema()").
+		Where("table_name = ?", table).
+		Exists(ctx)
+}
+
+// migrateBalanceUpdateTime - adds time column to balance updates of databases created before it was introduced.
+// Time is filled from blocks, so the table can be converted to hypertable without reindexing.
+func migrateBalanceUpdateTime(ctx context.Context, conn *database.Bun) error {
+	exists, err := columnExists(ctx, conn, models.BalanceUpdate{}.TableName(), "time")
+	if err != nil || exists {
+		return err
+	}
+
+	return conn.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, query := range []string{
+			`ALTER TABLE balance_update ADD COLUMN time timestamptz`,
+			`UPDATE balance_update SET time = block.time FROM block WHERE block.height = balance_update.height`,
+			`ALTER TABLE balance_update ALTER COLUMN time SET NOT NULL`,
+			`ALTER TABLE balance_update DROP CONSTRAINT IF EXISTS balance_update_pkey`,
+			`ALTER TABLE balance_update ADD PRIMARY KEY (id, time)`,
+		} {
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// migrateRollupActionSender - adds sender column to rollup actions of databases created before it was introduced.
+// Senders of existing actions are filled by explicit migration (see MigrateRollupActionSenders), because it updates the whole table.
+func migrateRollupActionSender(ctx context.Context, conn *database.Bun) error {
+	exists, err := columnExists(ctx, conn, models.RollupAction{}.TableName(), "sender_id")
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = conn.DB().ExecContext(ctx, `ALTER TABLE rollup_action ADD COLUMN sender_id bigint`)
+	return err
+}
+
+// rollupActionSendersStep - time range of rollup actions updated by one query of MigrateRollupActionSenders
+const rollupActionSendersStep = 24 * time.Hour
+
+// MigrateRollupActionSenders - fills senders of rollup actions indexed before the sender column was introduced
+// by signers of their transactions, so signers of rollups are counted for the whole history.
+// Actions are updated day by day, so every query locks only rows of one day and the indexer can keep running.
+// Returns count of updated actions.
+func MigrateRollupActionSenders(ctx context.Context, cfg config.Database) (int64, error) {
+	conn := database.NewBun()
+	if err := conn.Connect(ctx, cfg); err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if err := migrateRollupActionSender(ctx, conn); err != nil {
+		return 0, err
+	}
+
+	var bounds struct {
+		From bun.NullTime `bun:"min_time"`
+		To   bun.NullTime `bun:"max_time"`
+	}
+	if err := conn.DB().NewSelect().
+		Table(models.RollupAction{}.TableName()).
+		ColumnExpr("min(time) as min_time, max(time) as max_time").
+		Where("sender_id IS NULL").
+		Scan(ctx, &bounds); err != nil {
+		return 0, err
+	}
+	if bounds.From.IsZero() {
+		return 0, nil
+	}
+
+	var updated int64
+	for from := bounds.From.UTC().Truncate(rollupActionSendersStep); !from.After(bounds.To.Time); from = from.Add(rollupActionSendersStep) {
+		// transaction time is equal to time of its actions, so only chunks of the day are joined
+		result, err := conn.DB().ExecContext(ctx,
+			`UPDATE rollup_action SET sender_id = tx.signer_id FROM tx
+			WHERE tx.id = rollup_action.tx_id AND tx.time = rollup_action.time
+				AND rollup_action.sender_id IS NULL AND rollup_action.time >= ? AND rollup_action.time < ?`,
+			from, from.Add(rollupActionSendersStep),
+		)
+		if err != nil {
+			return updated, errors.Wrapf(err, "migrate rollup actions from %s", from)
+		}
+		count, err := result.RowsAffected()
+		if err != nil {
+			return updated, err
+		}
+		updated += count
+	}
+	return updated, nil
+}
+
+// migrateRawTxCompression - adds compression column to raw transactions of databases created before it was introduced.
+// Existing rows are stored without compression.
+func migrateRawTxCompression(ctx context.Context, conn *database.Bun) error {
+	exists, err := columnExists(ctx, conn, models.RawTx{}.TableName(), "compression")
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = conn.DB().ExecContext(ctx, `ALTER TABLE raw_tx ADD COLUMN compression compression NOT NULL DEFAULT 'none'`)
+	return err
+}
+
+func columnExists(ctx context.Context, conn *database.Bun, table, column string) (bool, error) {
+	return conn.DB().NewSelect().
+		TableExpr("information_schema.columns").
+		Where("table_schema = current_schema()").
+		Where("table_name = ?", table).
+		Where("column_name = ?", column).
+		Exists(ctx)
+}
+
 func createExtensions(ctx context.Context, conn *database.Bun) error {
 	return conn.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		_, err := tx.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pg_trgm;")
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/config"
+)
+
+func (s *StorageTestSuite) testDatabaseConfig() config.Database {
+	return config.Database{
+		Kind:     config.DBKindPostgres,
+		User:     s.psqlContainer.Config.User,
+		Database: s.psqlContainer.Config.Database,
+		Password: s.psqlContainer.Config.Password,
+		Host:     s.psqlContainer.Config.Host,
+		Port:     s.psqlContainer.MappedPort().Int(),
+	}
+}
+
+func (s *StorageTestSuite) TestMigrateHypertables() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	// tables of the database created by the current version are hypertables already
+	tables, err := MigrateHypertables(ctx, s.testDatabaseConfig())
+	s.Require().NoError(err)
+	s.Require().Empty(tables)
+
+	for _, model := range hypertables {
+		ok, err := isHypertable(ctx, s.storage.Connection(), model.TableName())
+		s.Require().NoError(err)
+		s.Require().True(ok, model.TableName())
+	}
+}
+
+func (s *StorageTestSuite) TestMigrateRollupActionSenders() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().DB().NewUpdate().
+		Table(storage.RollupAction{}.TableName()).
+		Set("sender_id = NULL").
+		Where("action_id = 1").
+		Exec(ctx)
+	s.Require().NoError(err)
+
+	updated, err := MigrateRollupActionSenders(ctx, s.testDatabaseConfig())
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, updated)
+
+	var senderId uint64
+	err = s.storage.Connection().DB().NewSelect().
+		Table(storage.RollupAction{}.TableName()).
+		Column("sender_id").
+		Where("action_id = 1").
+		Scan(ctx, &senderId)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, senderId)
+
+	updated, err = MigrateRollupActionSenders(ctx, s.testDatabaseConfig())
+	s.Require().NoError(err)
+	s.Require().Zero(updated)
+}
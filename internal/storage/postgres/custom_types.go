@@ -54,6 +54,26 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 		); err != nil {
 			return err
 		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"direction",
+			bun.Safe("direction"),
+			bun.In(types.DirectionValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"compression",
+			bun.Safe("compression"),
+			bun.In(types.CompressionValues()),
+		); err != nil {
+			return err
+		}
 		return nil
 	})
 }
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	models "github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/shopspring/decimal"
+)
+
+type holderBucketKey struct {
+	currency string
+	bucket   int
+}
+
+// holderBucketsDiff - accumulates changes of holder buckets caused by balance changes
+type holderBucketsDiff map[holderBucketKey]*models.HolderBucket
+
+func (diff holderBucketsDiff) add(currency string, prev, next decimal.Decimal) {
+	if bucket, ok := models.HolderBucketOf(prev); ok {
+		diff.change(currency, bucket, -1, prev.Neg())
+	}
+	if bucket, ok := models.HolderBucketOf(next); ok {
+		diff.change(currency, bucket, 1, next)
+	}
+}
+
+func (diff holderBucketsDiff) change(currency string, bucket int, count int64, total decimal.Decimal) {
+	key := holderBucketKey{currency, bucket}
+	b, ok := diff[key]
+	if !ok {
+		b = &models.HolderBucket{
+			Currency: currency,
+			Bucket:   bucket,
+			Total:    decimal.Zero,
+		}
+		diff[key] = b
+	}
+	b.Count += count
+	b.Total = b.Total.Add(total)
+}
+
+// buckets - returns non-empty changes sorted by currency and bucket to lock rows in the same order in concurrent transactions
+func (diff holderBucketsDiff) buckets() []*models.HolderBucket {
+	buckets := make([]*models.HolderBucket, 0, len(diff))
+	for _, b := range diff {
+		if b.Count == 0 && b.Total.IsZero() {
+			continue
+		}
+		buckets = append(buckets, b)
+	}
+	slices.SortFunc(buckets, func(a, b *models.HolderBucket) int {
+		if c := cmp.Compare(a.Currency, b.Currency); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Bucket, b.Bucket)
+	})
+	return buckets
+}
+
+func (tx Transaction) saveHolderBuckets(ctx context.Context, diff holderBucketsDiff) error {
+	buckets := diff.buckets()
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&buckets).
+		On("CONFLICT (currency, bucket) DO UPDATE").
+		Set("count = holder_bucket.count + EXCLUDED.count").
+		Set("total = holder_bucket.total + EXCLUDED.total").
+		Exec(ctx)
+	return err
+}
+
+// seedHolderBuckets - fills empty holder buckets by current balances. Buckets are maintained incrementally,
+// so databases indexed before buckets were introduced have to be seeded once. Bucket is computed in the same way as in HolderBucketOf.
+func seedHolderBuckets(ctx context.Context, conn *database.Bun) error {
+	_, err := conn.DB().ExecContext(ctx, `
+		INSERT INTO holder_bucket (currency, bucket, count, total)
+		SELECT currency, length(trunc(total)::text) - 1, count(*), sum(total)
+		FROM balance
+		WHERE total > 0 AND NOT EXISTS (SELECT 1 FROM holder_bucket)
+		GROUP BY 1, 2
+		ON CONFLICT (currency, bucket) DO NOTHING`,
+	)
+	return err
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_holderBucketsDiff(t *testing.T) {
+	diff := make(holderBucketsDiff)
+	// new holder
+	diff.add("nria", decimal.Zero, decimal.RequireFromString("1500"))
+	// holder moves to the upper bucket
+	diff.add("nria", decimal.RequireFromString("9999"), decimal.RequireFromString("10001"))
+	// holder stays in the bucket
+	diff.add("nria", decimal.RequireFromString("2000"), decimal.RequireFromString("1000"))
+	// holder spends everything
+	diff.add("nria", decimal.RequireFromString("5"), decimal.Zero)
+	// not a holder
+	diff.add("nria", decimal.Zero, decimal.RequireFromString("-1"))
+	// another currency
+	diff.add("other", decimal.Zero, decimal.RequireFromString("1"))
+
+	buckets := diff.buckets()
+	require.Len(t, buckets, 4)
+
+	require.Equal(t, "nria", buckets[0].Currency)
+	require.Equal(t, 0, buckets[0].Bucket)
+	require.EqualValues(t, -1, buckets[0].Count)
+	require.Equal(t, "-5", buckets[0].Total.String())
+
+	require.Equal(t, 3, buckets[1].Bucket)
+	require.EqualValues(t, 0, buckets[1].Count)
+	require.Equal(t, "-9499", buckets[1].Total.String())
+
+	require.Equal(t, 4, buckets[2].Bucket)
+	require.EqualValues(t, 1, buckets[2].Count)
+	require.Equal(t, "10001", buckets[2].Total.String())
+
+	require.Equal(t, "other", buckets[3].Currency)
+	require.Equal(t, 0, buckets[3].Bucket)
+	require.EqualValues(t, 1, buckets[3].Count)
+}
+
+func Test_giniByBuckets(t *testing.T) {
+	tests := []struct {
+		name    string
+		buckets []storage.HolderBucket
+		want    float64
+	}{
+		{
+			name: "empty",
+		}, {
+			name: "equal holders",
+			buckets: []storage.HolderBucket{
+				{Bucket: 3, Count: 10, Total: decimal.RequireFromString("10000")},
+			},
+			want: 0,
+		}, {
+			name: "one rich holder",
+			buckets: []storage.HolderBucket{
+				{Bucket: 0, Count: 1, Total: decimal.RequireFromString("1")},
+				{Bucket: 20, Count: 2, Total: decimal.RequireFromString("1000000000000000000000")},
+			},
+			want: 1.0 / 3,
+		}, {
+			name: "two buckets",
+			buckets: []storage.HolderBucket{
+				{Bucket: 1, Count: 3, Total: decimal.RequireFromString("30")},
+				{Bucket: 1, Count: 1, Total: decimal.RequireFromString("70")},
+			},
+			want: 0.45,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				holders int64
+				supply  = decimal.Zero
+			)
+			for i := range tt.buckets {
+				holders += tt.buckets[i].Count
+				supply = supply.Add(tt.buckets[i].Total)
+			}
+			require.InDelta(t, tt.want, giniByBuckets(tt.buckets, holders, supply), 1e-9)
+		})
+	}
+}
+
+func TestHolderBucketOf(t *testing.T) {
+	tests := []struct {
+		total  string
+		bucket int
+		ok     bool
+	}{
+		{total: "-10"},
+		{total: "0"},
+		{total: "0.5", ok: true},
+		{total: "9", ok: true},
+		{total: "10", bucket: 1, ok: true},
+		{total: "999", bucket: 2, ok: true},
+		{total: "500000000000000000000", bucket: 20, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.total, func(t *testing.T) {
+			bucket, ok := storage.HolderBucketOf(decimal.RequireFromString(tt.total))
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.bucket, bucket)
+		})
+	}
+}
@@ -27,6 +27,34 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Balance
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Balance)(nil)).
+			Index("balance_currency_total_idx").
+			ColumnExpr("currency, total desc").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// BalanceUpdate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_address_id_height_idx").
+			Column("address_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_address_id_time_idx").
+			Column("address_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Block
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -54,6 +82,22 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Block)(nil)).
+			Index("block_hash_prefix_idx").
+			Column("hash").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Block)(nil)).
+			Index("block_time_id_idx").
+			Column("time", "id").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// BlockStats
 		if _, err := tx.NewCreateIndex().
@@ -85,6 +129,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Tx)(nil)).
+			Index("tx_hash_prefix_idx").
+			Column("hash").
+			Exec(ctx); err != nil {
+			return err
+		}
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
 			Model((*storage.Tx)(nil)).
@@ -101,6 +153,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Tx)(nil)).
+			Index("tx_time_id_idx").
+			Column("time", "id").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Action
 		if _, err := tx.NewCreateIndex().
@@ -178,6 +238,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.RollupAction)(nil)).
+			Index("rollup_action_rollup_id_time_idx").
+			Column("rollup_id", "time", "action_id").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Address actions
 		if _, err := tx.NewCreateIndex().
@@ -207,6 +275,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AddressAction)(nil)).
+			Index("address_action_address_id_time_idx").
+			Column("address_id", "time", "action_id").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Validators
 		if _, err := tx.NewCreateIndex().
@@ -228,6 +304,44 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Outbox
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Outbox)(nil)).
+			Index("outbox_pending_idx").
+			Column("id").
+			Where("failed = false").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Watchlist
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Watchlist)(nil)).
+			Index("watchlist_enabled_idx").
+			Column("enabled").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WatchlistAlert)(nil)).
+			Index("watchlist_alert_watchlist_id_idx").
+			Column("watchlist_id", "id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WatchlistAlert)(nil)).
+			Index("watchlist_alert_undelivered_idx").
+			Column("id").
+			Where("delivered = false").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
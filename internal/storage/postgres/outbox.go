@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Outbox -
+type Outbox struct {
+	*postgres.Table[*storage.Outbox]
+}
+
+// NewOutbox -
+func NewOutbox(db *database.Bun) *Outbox {
+	return &Outbox{
+		Table: postgres.NewTable[*storage.Outbox](db),
+	}
+}
+
+// Pending - returns not failed events in the order of creation. Events which are not due yet are returned too:
+// delivery must stop on them to keep order of events.
+func (o *Outbox) Pending(ctx context.Context, limit int) (events []storage.Outbox, err error) {
+	err = o.DB().NewSelect().
+		Model(&events).
+		Where("failed = false").
+		Order("id asc").
+		Limit(limit).
+		Scan(ctx)
+	return
+}
+
+// Delete - removes delivered events
+func (o *Outbox) Delete(ctx context.Context, id uint64) error {
+	_, err := o.DB().NewDelete().
+		Model((*storage.Outbox)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+func (s *StorageTestSuite) TestOutboxPendingKeepsOrder() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	now := time.Now().UTC()
+	events := make([]*storage.Outbox, 3)
+	for i := range events {
+		events[i] = &storage.Outbox{
+			CreatedAt:     now,
+			NextAttemptAt: now,
+			Height:        types.Level(100 + i),
+			Payload:       "[]",
+		}
+		s.Require().NoError(s.storage.Outbox.Save(ctx, events[i]))
+	}
+	defer func() {
+		for i := range events {
+			s.Require().NoError(s.storage.Outbox.Delete(ctx, events[i].Id))
+		}
+	}()
+
+	// delivery of the oldest event failed and it waits for retry
+	events[0].Attempts = 1
+	events[0].Error = "unexpected response status: 500"
+	events[0].NextAttemptAt = now.Add(time.Hour)
+	s.Require().NoError(s.storage.Outbox.Update(ctx, events[0]))
+
+	pending, err := s.storage.Outbox.Pending(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 3)
+	s.Require().Equal(events[0].Id, pending[0].Id, "failed event stays at the head")
+	s.Require().Equal(1, pending[0].Attempts)
+	s.Require().True(pending[0].NextAttemptAt.After(now))
+	for i := 1; i < len(pending); i++ {
+		s.Require().Less(pending[i-1].Id, pending[i].Id)
+		s.Require().Equal(events[i].Height, pending[i].Height)
+	}
+
+	// events failed after all attempts don't block delivery
+	events[0].Failed = true
+	s.Require().NoError(s.storage.Outbox.Update(ctx, events[0]))
+
+	pending, err = s.storage.Outbox.Pending(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 2)
+	s.Require().Equal(events[1].Id, pending[0].Id)
+	s.Require().Equal(events[2].Id, pending[1].Id)
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// RawBlock -
+type RawBlock struct {
+	*postgres.Table[*storage.RawBlock]
+}
+
+// NewRawBlock -
+func NewRawBlock(db *database.Bun) *RawBlock {
+	return &RawBlock{
+		Table: postgres.NewTable[*storage.RawBlock](db),
+	}
+}
+
+func (rb *RawBlock) ByHeight(ctx context.Context, height pkgTypes.Level) (raw storage.RawBlock, err error) {
+	err = rb.DB().NewSelect().
+		Model(&raw).
+		Where("height = ?", height).
+		Limit(1).
+		Scan(ctx)
+	return
+}
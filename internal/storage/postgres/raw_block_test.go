@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestRawBlockByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	raw, err := s.storage.RawBlock.ByHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().EqualValues(7965, raw.Height)
+	s.Require().EqualValues("none", raw.Compression)
+
+	block, results, err := raw.Decompressed()
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"block_id":{"hash":"F44BC94BF7D064ADF82618F2691D2353161DE232ECB3091B7E5C89B453C79456"},"block":{"header":{"height":"7965"}}}`, string(block))
+	s.Require().JSONEq(`{"height":"7965","txs_results":null}`, string(results))
+
+	_, err = s.storage.RawBlock.ByHeight(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.RawBlock.IsNoRows(err))
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// RawTx -
+type RawTx struct {
+	*postgres.Table[*storage.RawTx]
+}
+
+// NewRawTx -
+func NewRawTx(db *database.Bun) *RawTx {
+	return &RawTx{
+		Table: postgres.NewTable[*storage.RawTx](db),
+	}
+}
+
+func (rt *RawTx) ByTxId(ctx context.Context, txId uint64) (raw storage.RawTx, err error) {
+	err = rt.DB().NewSelect().
+		Model(&raw).
+		Where("id = ?", txId).
+		Limit(1).
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestRawTxByTxId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	raw, err := s.storage.RawTx.ByTxId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, raw.Id)
+	s.Require().EqualValues(7965, raw.Height)
+	s.Require().Len(raw.Data, 121)
+
+	_, err = s.storage.RawTx.ByTxId(ctx, 1)
+	s.Require().Error(err)
+	s.Require().True(s.storage.RawTx.IsNoRows(err))
+}
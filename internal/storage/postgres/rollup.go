@@ -11,6 +11,7 @@ import (
 	"github.com/dipdup-net/go-lib/database"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Rollup -
@@ -29,7 +30,11 @@ func (r *Rollup) ActionsByHeight(ctx context.Context, height types.Level, limit,
 	query := r.DB().NewSelect().Model(&actions).
 		Where("rollup_action.height = ?", height).
 		Relation("Rollup").
-		Relation("Action")
+		Relation("Action").
+		Relation("Tx", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Column("hash")
+		}).
+		Order("rollup_action.action_id asc")
 
 	query = limitScope(query, limit)
 	query = offsetScope(query, offset)
@@ -66,16 +71,15 @@ func (r *Rollup) CountActionsByTxId(ctx context.Context, txId uint64) (int64, er
 }
 
 func (r *Rollup) ByHash(ctx context.Context, hash []byte) (rollup storage.Rollup, err error) {
-	query := r.DB().NewSelect().Model((*storage.Rollup)(nil)).
-		Where("astria_id = ?", hash).
-		Limit(1)
-
 	err = r.DB().NewSelect().
-		TableExpr("(?) as rollup", query).
-		ColumnExpr("rollup.*").
-		ColumnExpr("address.hash as bridge_address__hash").
-		Join("left join address on address.id = rollup.bridge_address_id").
-		Scan(ctx, &rollup)
+		Model(&rollup).
+		Where("astria_id = ?", hash).
+		Relation("BridgeAddress", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Column("hash")
+		}).
+		Relation("Metadata").
+		Limit(1).
+		Scan(ctx)
 	return
 }
 
@@ -106,14 +110,30 @@ func (r *Rollup) ListRollupsByAddress(ctx context.Context, addressId uint64, lim
 }
 
 func (r *Rollup) ListExt(ctx context.Context, fltrs storage.RollupListFilter) (rollups []storage.Rollup, err error) {
-	query := r.DB().NewSelect().Model(&rollups)
+	query := r.DB().NewSelect().Model(&rollups).
+		Relation("BridgeAddress", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Column("hash")
+		}).
+		Relation("Metadata")
+
+	if len(fltrs.VmType) > 0 {
+		query = query.Where("metadata.vm_type IN (?)", bun.In(fltrs.VmType))
+	}
+	if fltrs.Name != "" {
+		query = query.Where("metadata.name ILIKE ?", "%"+fltrs.Name+"%")
+	}
 
 	query = limitScope(query, fltrs.Limit)
 	switch fltrs.SortField {
 	case "size":
-		query = sortScope(query, "size", fltrs.SortOrder)
+		query = sortScope(query, "rollup.size", fltrs.SortOrder)
+	case "actions_count":
+		query = sortScope(query, "rollup.actions_count", fltrs.SortOrder)
+	case "name":
+		query = sortScope(query, "metadata.name", fltrs.SortOrder)
+		query = sortScope(query, "rollup.id", fltrs.SortOrder)
 	default:
-		query = sortScope(query, "id", fltrs.SortOrder)
+		query = sortScope(query, "rollup.id", fltrs.SortOrder)
 	}
 	query = offsetScope(query, fltrs.Offset)
 
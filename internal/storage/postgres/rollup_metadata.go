@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// RollupMetadata -
+type RollupMetadata struct {
+	*postgres.Table[*storage.RollupMetadata]
+}
+
+// NewRollupMetadata -
+func NewRollupMetadata(db *database.Bun) *RollupMetadata {
+	return &RollupMetadata{
+		Table: postgres.NewTable[*storage.RollupMetadata](db),
+	}
+}
+
+// ByRollupId - returns metadata of rollup by its astria identity
+func (rm *RollupMetadata) ByRollupId(ctx context.Context, rollupId []byte) (metadata storage.RollupMetadata, err error) {
+	err = rm.DB().NewSelect().
+		Model(&metadata).
+		Where("rollup_id = ?", rollupId).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Upsert - creates or replaces metadata of rollup. Version of existing metadata is kept.
+func (rm *RollupMetadata) Upsert(ctx context.Context, metadata *storage.RollupMetadata) error {
+	_, err := setMetadataColumns(
+		rm.DB().NewInsert().
+			Model(metadata).
+			On("CONFLICT ON CONSTRAINT rollup_metadata_id DO UPDATE"),
+	).
+		Returning("id, created_at, version").
+		Exec(ctx)
+	return err
+}
+
+// ApplyRegistry - saves metadata loaded from registry. Existing metadata is replaced only if its version is less than the version of the registry.
+// Returns count of created or updated rows.
+func (rm *RollupMetadata) ApplyRegistry(ctx context.Context, metadata ...*storage.RollupMetadata) (int64, error) {
+	if len(metadata) == 0 {
+		return 0, nil
+	}
+
+	result, err := setMetadataColumns(
+		rm.DB().NewInsert().
+			Model(&metadata).
+			On("CONFLICT ON CONSTRAINT rollup_metadata_id DO UPDATE"),
+	).
+		Set("version = EXCLUDED.version").
+		Where("rollup_metadata.version < EXCLUDED.version").
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// DeleteByRollupId - removes metadata of rollup
+func (rm *RollupMetadata) DeleteByRollupId(ctx context.Context, rollupId []byte) error {
+	_, err := rm.DB().NewDelete().
+		Model((*storage.RollupMetadata)(nil)).
+		Where("rollup_id = ?", rollupId).
+		Exec(ctx)
+	return err
+}
+
+func setMetadataColumns(query *bun.InsertQuery) *bun.InsertQuery {
+	return query.
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("name = EXCLUDED.name").
+		Set("slug = EXCLUDED.slug").
+		Set("website = EXCLUDED.website").
+		Set("logo = EXCLUDED.logo").
+		Set("description = EXCLUDED.description").
+		Set("vm_type = EXCLUDED.vm_type").
+		Set("contact = EXCLUDED.contact")
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+func (s *StorageTestSuite) TestRollupMetadataByRollupId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rollupId, err := hex.DecodeString("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539")
+	s.Require().NoError(err)
+
+	metadata, err := s.storage.RollupMetadata.ByRollupId(ctx, rollupId)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, metadata.Id)
+	s.Require().EqualValues(1, metadata.Version)
+	s.Require().Equal("Flame", metadata.Name)
+	s.Require().Equal("https://example.com", metadata.Website)
+}
+
+func (s *StorageTestSuite) TestRollupMetadataApplyRegistry() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rollupId, err := hex.DecodeString("f69ac0156da05bc30d82e516641be86c8fbee5ad8f38ca2b1c4c145249dde6a3")
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(s.storage.RollupMetadata.DeleteByRollupId(ctx, rollupId))
+	}()
+
+	now := time.Now().UTC()
+	count, err := s.storage.RollupMetadata.ApplyRegistry(ctx, &storage.RollupMetadata{
+		RollupId:  rollupId,
+		Version:   2,
+		Name:      "Registry name",
+		Slug:      "registry-name",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+
+	// manual edit keeps version of the registry
+	err = s.storage.RollupMetadata.Upsert(ctx, &storage.RollupMetadata{
+		RollupId:  rollupId,
+		Name:      "Manual name",
+		Slug:      "manual-name",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	s.Require().NoError(err)
+
+	metadata, err := s.storage.RollupMetadata.ByRollupId(ctx, rollupId)
+	s.Require().NoError(err)
+	s.Require().Equal("Manual name", metadata.Name)
+	s.Require().EqualValues(2, metadata.Version)
+
+	// registry with the same version doesn't replace manual edit
+	count, err = s.storage.RollupMetadata.ApplyRegistry(ctx, &storage.RollupMetadata{
+		RollupId:  rollupId,
+		Version:   2,
+		Name:      "Registry name",
+		Slug:      "registry-name",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+
+	// newer registry replaces it
+	count, err = s.storage.RollupMetadata.ApplyRegistry(ctx, &storage.RollupMetadata{
+		RollupId:  rollupId,
+		Version:   3,
+		Name:      "New registry name",
+		Slug:      "new-registry-name",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+
+	metadata, err = s.storage.RollupMetadata.ByRollupId(ctx, rollupId)
+	s.Require().NoError(err)
+	s.Require().Equal("New registry name", metadata.Name)
+	s.Require().EqualValues(3, metadata.Version)
+}
@@ -26,6 +26,8 @@ func (s *StorageTestSuite) TestRollupActionsByHeight() {
 	s.Require().EqualValues(7316, action.Height)
 	s.Require().NotNil(action.Rollup)
 	s.Require().NotNil(action.Action)
+	s.Require().NotNil(action.Tx)
+	s.Require().Equal("20b0e6310801e7b2a16c69aace7b1a1d550e5c49c80f546941bb1ac747487fe5", hex.EncodeToString(action.Tx.Hash))
 }
 
 func (s *StorageTestSuite) TestRollupCountActionsByHeight() {
@@ -78,6 +80,10 @@ func (s *StorageTestSuite) TestRollupByHash() {
 	s.Require().EqualValues(1, rollup.ActionsCount)
 
 	s.Require().Nil(rollup.BridgeAddress)
+	s.Require().NotNil(rollup.Metadata)
+	s.Require().Equal("Flame", rollup.Metadata.Name)
+	s.Require().Equal("flame", rollup.Metadata.Slug)
+	s.Require().Equal("evm", rollup.Metadata.VmType)
 }
 
 func (s *StorageTestSuite) TestRollupAddresses() {
@@ -136,6 +142,47 @@ func (s *StorageTestSuite) TestListExt() {
 
 }
 
+func (s *StorageTestSuite) TestListExtByMetadata() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rollups, err := s.storage.Rollup.ListExt(ctx, models.RollupListFilter{
+		Limit:     10,
+		SortField: "name",
+		SortOrder: storage.SortOrderAsc,
+		VmType:    []string{"evm"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+	s.Require().EqualValues(1, rollups[0].Id)
+	s.Require().NotNil(rollups[0].Metadata)
+	s.Require().Equal("Flame", rollups[0].Metadata.Name)
+
+	rollups, err = s.storage.Rollup.ListExt(ctx, models.RollupListFilter{
+		Limit: 10,
+		Name:  "lam",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+
+	rollups, err = s.storage.Rollup.ListExt(ctx, models.RollupListFilter{
+		Limit:  10,
+		VmType: []string{"svm"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 0)
+
+	rollups, err = s.storage.Rollup.ListExt(ctx, models.RollupListFilter{
+		Limit:     10,
+		SortOrder: storage.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 2)
+	s.Require().NotNil(rollups[0].Metadata)
+	s.Require().Nil(rollups[1].Metadata)
+	s.Require().NotNil(rollups[1].BridgeAddress)
+}
+
 func (s *StorageTestSuite) TestByBridgedAddress() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -4,6 +4,8 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/uptrace/bun"
@@ -16,6 +18,19 @@ func limitScope(q *bun.SelectQuery, limit int) *bun.SelectQuery {
 	return q.Limit(limit)
 }
 
+// partitionPageScope - applies limit and offset to rows numbered inside their partition by the window function
+func partitionPageScope(q *bun.SelectQuery, rowNumber string, limit, offset int) *bun.SelectQuery {
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return q.
+		Where("? > ?", bun.Ident(rowNumber), offset).
+		Where("? <= ?", bun.Ident(rowNumber), offset+limit)
+}
+
 func offsetScope(q *bun.SelectQuery, offset int) *bun.SelectQuery {
 	if offset > 0 {
 		return q.Offset(offset)
@@ -30,17 +45,60 @@ func sortScope(q *bun.SelectQuery, field string, sort sdk.SortOrder) *bun.Select
 	return q.OrderExpr("? ?", bun.Ident(field), bun.Safe(sort))
 }
 
+// timeSortScope - sorts entities by (time, id) pair
+func timeSortScope(q *bun.SelectQuery, timeField, idField string, sort sdk.SortOrder) *bun.SelectQuery {
+	q = sortScope(q, timeField, sort)
+	return sortScope(q, idField, sort)
+}
+
+// cursorScope - keyset pagination by (time, id) pair. Entities are sorted by time and id,
+// the standalone condition on time column allows to skip hypertable chunks.
+func cursorScope(q *bun.SelectQuery, timeField, idField string, cursor storage.Cursor, sort sdk.SortOrder) *bun.SelectQuery {
+	if !cursor.IsEmpty() {
+		cmp := ">"
+		if sort == sdk.SortOrderDesc {
+			cmp = "<"
+		}
+		q = q.
+			Where("? ?= ?", bun.Ident(timeField), bun.Safe(cmp), cursor.Time).
+			WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+				return sq.
+					Where("? ? ?", bun.Ident(timeField), bun.Safe(cmp), cursor.Time).
+					WhereOr("? ? ?", bun.Ident(idField), bun.Safe(cmp), cursor.Id)
+			})
+	}
+	return timeSortScope(q, timeField, idField, sort)
+}
+
 func addressListFilter(query *bun.SelectQuery, fltrs storage.AddressListFilter) *bun.SelectQuery {
 	query = limitScope(query, fltrs.Limit)
 	query = sortScope(query, "id", fltrs.Sort)
 	return query
 }
 
+func timeRangeScope(q *bun.SelectQuery, field string, from, to time.Time) *bun.SelectQuery {
+	if !from.IsZero() {
+		q = q.Where("? >= ?", bun.Ident(field), from)
+	}
+	if !to.IsZero() {
+		q = q.Where("? < ?", bun.Ident(field), to)
+	}
+	return q
+}
+
 func txFilter(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 	query = limitScope(query, fltrs.Limit)
-	query = sortScope(query, "tx.id", fltrs.Sort)
+	query = cursorScope(query, "tx.time", "tx.id", fltrs.Cursor, fltrs.Sort)
 	query = offsetScope(query, fltrs.Offset)
+	query = txConditionsScope(query, fltrs)
+
+	if fltrs.WithActions {
+		query = query.Relation("Actions")
+	}
+	return query
+}
 
+func txConditionsScope(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 	if !fltrs.ActionTypes.Empty() {
 		query = query.Where("action_types & ? > 0", fltrs.ActionTypes.Bits)
 	}
@@ -57,14 +115,5 @@ func txFilter(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 		query = query.Where("tx.height = ?", fltrs.Height)
 	}
 
-	if !fltrs.TimeFrom.IsZero() {
-		query = query.Where("tx.time >= ?", fltrs.TimeFrom)
-	}
-	if !fltrs.TimeTo.IsZero() {
-		query = query.Where("tx.time < ?", fltrs.TimeTo)
-	}
-	if fltrs.WithActions {
-		query = query.Relation("Actions")
-	}
-	return query
+	return timeRangeScope(query, "tx.time", fltrs.TimeFrom, fltrs.TimeTo)
 }
@@ -6,10 +6,28 @@ package postgres
 import (
 	"context"
 	"encoding/hex"
+	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/celenium-io/astria-indexer/internal/registry"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/database"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// minHashPrefixLength - minimal count of hex symbols for hash prefix matching
+	minHashPrefixLength = 8
+	// addressLength - length of address hash in bytes
+	addressLength = 20
+)
+
+// search result ranks: the less is the better
+const (
+	rankExact = iota + 1
+	rankPrefix
+	rankText
 )
 
 // Search -
@@ -24,48 +42,182 @@ func NewSearch(db *database.Bun) *Search {
 	}
 }
 
-func (s *Search) Search(ctx context.Context, query string) (results []storage.SearchResult, err error) {
-	text := "%" + query + "%"
+// Search - searches validators and rollups by name, blocks by height and entities by hash or its prefix, base64 rollup id and bech32 address. Results are sorted by rank.
+func (s *Search) Search(ctx context.Context, query string, limit int) (results []storage.SearchResult, err error) {
+	query = strings.TrimSpace(query)
+
+	// names are matched by patterns, so wildcards of the query are escaped
+	text := escapeLike(query)
+
 	searchQuery := s.db.DB().NewSelect().
 		Model((*storage.Validator)(nil)).
 		ColumnExpr("id, name as value, 'validator' as type").
-		Where("name ILIKE ?", text)
+		ColumnExpr("CASE WHEN name ILIKE ? THEN ? WHEN name ILIKE ? THEN ? ELSE ? END as rank", text, rankExact, text+"%", rankPrefix, rankText).
+		Where("name ILIKE ?", "%"+text+"%")
+
+	rollupNameQuery := s.db.DB().NewSelect().
+		Model((*storage.RollupMetadata)(nil)).
+		ColumnExpr("rollup.id, rollup_metadata.name as value, 'rollup' as type").
+		ColumnExpr("CASE WHEN rollup_metadata.name ILIKE ? OR rollup_metadata.slug = ? THEN ? WHEN rollup_metadata.name ILIKE ? THEN ? ELSE ? END as rank", text, strings.ToLower(query), rankExact, text+"%", rankPrefix, rankText).
+		Join("JOIN rollup ON rollup.astria_id = rollup_metadata.rollup_id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("rollup_metadata.name ILIKE ?", "%"+text+"%").
+				WhereOr("rollup_metadata.slug = ?", strings.ToLower(query))
+		})
+	searchQuery = searchQuery.UnionAll(rollupNameQuery)
 
-	if hash, err := hex.DecodeString(query); err == nil {
+	if height, err := strconv.ParseUint(query, 10, 64); err == nil {
+		blockQuery := s.db.DB().NewSelect().
+			Model((*storage.Block)(nil)).
+			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type, ? as rank", rankExact).
+			Where("height = ?", height)
+		searchQuery = searchQuery.UnionAll(blockQuery)
+	}
+
+	if prefix, ok := newHashPrefix(query); ok {
 		addressQuery := s.db.DB().NewSelect().
 			Model((*storage.Address)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'address' as type").
-			Where("hash = ?", hash)
+			ColumnExpr("id, encode(hash, 'hex') as value, 'address' as type")
 		blockQuery := s.db.DB().NewSelect().
 			Model((*storage.Block)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type").
-			Where("hash = ?", hash)
+			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type")
 		txQuery := s.db.DB().NewSelect().
 			Model((*storage.Tx)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'tx' as type").
-			Where("hash = ?", hash)
+			ColumnExpr("id, encode(hash, 'hex') as value, 'tx' as type")
 		rollupQuery := s.db.DB().NewSelect().
 			Model((*storage.Rollup)(nil)).
-			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type").
-			Where("astria_id = ?", hash)
+			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type")
 		validatorQuery := s.db.DB().NewSelect().
 			Model((*storage.Validator)(nil)).
-			ColumnExpr("id, name as value, 'validator' as type").
-			Where("address = ?", strings.ToUpper(query))
+			ColumnExpr("id, name as value, 'validator' as type")
 
 		searchQuery = searchQuery.
-			UnionAll(addressQuery).
-			UnionAll(blockQuery).
-			UnionAll(txQuery).
-			UnionAll(rollupQuery).
-			UnionAll(validatorQuery)
+			UnionAll(prefix.scope(addressQuery, "hash")).
+			UnionAll(prefix.scope(blockQuery, "hash")).
+			UnionAll(prefix.scope(txQuery, "hash")).
+			UnionAll(prefix.scope(rollupQuery, "astria_id")).
+			UnionAll(prefix.textScope(validatorQuery, "address"))
 	}
 
-	err = s.db.DB().NewSelect().
-		TableExpr("(?) as search", searchQuery).
-		Limit(10).
-		Offset(0).
-		Scan(ctx, &results)
+	if rollupId, err := registry.DecodeRollupId(query); err == nil {
+		rollupQuery := s.db.DB().NewSelect().
+			Model((*storage.Rollup)(nil)).
+			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type, ? as rank", rankExact).
+			Where("astria_id = ?", rollupId)
+		searchQuery = searchQuery.UnionAll(rollupQuery)
+	}
+
+	if hash, ok := decodeBech32Address(query); ok {
+		addressQuery := s.db.DB().NewSelect().
+			Model((*storage.Address)(nil)).
+			ColumnExpr("id, encode(hash, 'hex') as value, 'address' as type, ? as rank", rankExact).
+			Where("hash = ?", hash)
+		searchQuery = searchQuery.UnionAll(addressQuery)
+	}
 
+	q := s.db.DB().NewSelect().
+		TableExpr("(?) as search", searchQuery).
+		OrderExpr("rank asc, type asc, id desc")
+	err = limitScope(q, limit).Scan(ctx, &results)
 	return
 }
+
+// hashPrefix - byte range which contains all hashes started with requested hex prefix
+type hashPrefix struct {
+	text  string
+	exact []byte
+	from  []byte
+	to    []byte
+}
+
+func newHashPrefix(query string) (hashPrefix, bool) {
+	text := strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(query, "0x"), "0X"))
+	if text == "" {
+		return hashPrefix{}, false
+	}
+
+	from, err := hex.DecodeString(padHex(text))
+	if err != nil {
+		return hashPrefix{}, false
+	}
+
+	prefix := hashPrefix{
+		text: text,
+	}
+	if len(text)%2 == 0 {
+		prefix.exact = from
+	}
+	if len(text) < minHashPrefixLength {
+		return prefix, prefix.exact != nil
+	}
+	prefix.from = from
+
+	// upper bound is the prefix incremented by one. If prefix consists of `f` only there is no upper bound.
+	upper := []byte(text)
+	for i := len(upper) - 1; i >= 0; i-- {
+		if upper[i] == 'f' {
+			continue
+		}
+		if upper[i] == '9' {
+			upper[i] = 'a'
+		} else {
+			upper[i]++
+		}
+		to, err := hex.DecodeString(padHex(string(upper[:i+1])))
+		if err != nil {
+			return hashPrefix{}, false
+		}
+		prefix.to = to
+		break
+	}
+	return prefix, true
+}
+
+func (p hashPrefix) scope(q *bun.SelectQuery, column string) *bun.SelectQuery {
+	q = q.ColumnExpr("CASE WHEN ? = ? THEN ? ELSE ? END as rank", bun.Ident(column), p.exact, rankExact, rankPrefix)
+	if p.from == nil {
+		return q.Where("? = ?", bun.Ident(column), p.exact)
+	}
+
+	q = q.Where("? >= ?", bun.Ident(column), p.from)
+	if p.to != nil {
+		q = q.Where("? < ?", bun.Ident(column), p.to)
+	}
+	return q
+}
+
+func (p hashPrefix) textScope(q *bun.SelectQuery, column string) *bun.SelectQuery {
+	text := strings.ToUpper(p.text)
+	q = q.ColumnExpr("CASE WHEN ? = ? THEN ? ELSE ? END as rank", bun.Ident(column), text, rankExact, rankPrefix)
+	if p.from == nil {
+		return q.Where("? = ?", bun.Ident(column), text)
+	}
+	return q.Where("? LIKE ?", bun.Ident(column), text+"%")
+}
+
+func padHex(text string) string {
+	if len(text)%2 == 1 {
+		return text + "0"
+	}
+	return text
+}
+
+// escapeLike - escapes wildcards of LIKE patterns, so the text is matched literally
+func escapeLike(text string) string {
+	return likeEscaper.Replace(text)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func decodeBech32Address(query string) ([]byte, bool) {
+	_, data, _, err := bech32.DecodeGeneric(query)
+	if err != nil {
+		return nil, false
+	}
+	hash, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil || len(hash) != addressLength {
+		return nil, false
+	}
+	return hash, true
+}
@@ -5,14 +5,17 @@ package postgres
 
 import (
 	"context"
+	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func (s *StorageTestSuite) TestSearchBlock() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "b15d072afc508558b3e962060c701a695af5d6a041d4a25c63240bbff5064b3b")
+	results, err := s.storage.Search.Search(ctx, "b15d072afc508558b3e962060c701a695af5d6a041d4a25c63240bbff5064b3b", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 1)
 
@@ -25,7 +28,7 @@ func (s *StorageTestSuite) TestSearchTx() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "20b0e6310801e7b2a16c69aace7b1a1d550e5c49c80f546941bb1ac747487fe5")
+	results, err := s.storage.Search.Search(ctx, "20b0e6310801e7b2a16c69aace7b1a1d550e5c49c80f546941bb1ac747487fe5", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 1)
 
@@ -38,7 +41,7 @@ func (s *StorageTestSuite) TestSearchRollup() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539")
+	results, err := s.storage.Search.Search(ctx, "19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 1)
 
@@ -51,7 +54,7 @@ func (s *StorageTestSuite) TestSearchAddress() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "b385e68e3a3a2d250c7c4024972576d698b9e748")
+	results, err := s.storage.Search.Search(ctx, "b385e68e3a3a2d250c7c4024972576d698b9e748", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 1)
 
@@ -65,7 +68,7 @@ func (s *StorageTestSuite) TestSearchValidator() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "node0")
+	results, err := s.storage.Search.Search(ctx, "node0", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 1)
 
@@ -79,7 +82,7 @@ func (s *StorageTestSuite) TestSearchValidatorByAddress() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	results, err := s.storage.Search.Search(ctx, "230592632006db2733444bb6de11db3f4b2f9ae4")
+	results, err := s.storage.Search.Search(ctx, "230592632006db2733444bb6de11db3f4b2f9ae4", 10)
 	s.Require().NoError(err)
 	s.Require().Len(results, 2)
 
@@ -93,3 +96,196 @@ func (s *StorageTestSuite) TestSearchValidatorByAddress() {
 	s.Require().EqualValues("validator", result1.Type)
 	s.Require().EqualValues(1, result1.Id)
 }
+
+func (s *StorageTestSuite) TestSearchBlockByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.Search(ctx, "7965", 10)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("b15d072afc508558b3e962060c701a695af5d6a041d4a25c63240bbff5064b3b", result.Value)
+	s.Require().EqualValues("block", result.Type)
+	s.Require().EqualValues(7966, result.Id)
+	s.Require().EqualValues(rankExact, result.Rank)
+}
+
+func (s *StorageTestSuite) TestSearchHashPrefix() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, query := range []string{"b15d072a", "B15D072AF", "0xb15d072afc"} {
+		results, err := s.storage.Search.Search(ctx, query, 10)
+		s.Require().NoError(err, query)
+		s.Require().Len(results, 1, query)
+
+		result := results[0]
+		s.Require().EqualValues("b15d072afc508558b3e962060c701a695af5d6a041d4a25c63240bbff5064b3b", result.Value, query)
+		s.Require().EqualValues("block", result.Type, query)
+		s.Require().EqualValues(rankPrefix, result.Rank, query)
+	}
+}
+
+func (s *StorageTestSuite) TestSearchShortHashPrefix() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.Search(ctx, "b15d072", 10)
+	s.Require().NoError(err)
+	s.Require().Len(results, 0)
+}
+
+func (s *StorageTestSuite) TestSearchRollupBase64() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, query := range []string{
+		"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk=",
+		"GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk",
+	} {
+		results, err := s.storage.Search.Search(ctx, query, 10)
+		s.Require().NoError(err, query)
+		s.Require().Len(results, 1, query)
+
+		result := results[0]
+		s.Require().EqualValues("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", result.Value, query)
+		s.Require().EqualValues("rollup", result.Type, query)
+	}
+}
+
+func (s *StorageTestSuite) TestSearchBech32Address() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.Search(ctx, "astria1kwz7dr368gkj2rrugqjfwftk66vtne6gyvjhkt", 10)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("b385e68e3a3a2d250c7c4024972576d698b9e748", result.Value)
+	s.Require().EqualValues("address", result.Type)
+	s.Require().EqualValues(8, result.Id)
+}
+
+func (s *StorageTestSuite) TestSearchRankAndLimit() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.storage.Search.Search(ctx, "node1", 10)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().EqualValues(rankExact, results[0].Rank)
+
+	results, err = s.storage.Search.Search(ctx, "node", 2)
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	for i := range results {
+		s.Require().EqualValues("validator", results[i].Type)
+		s.Require().EqualValues(rankPrefix, results[i].Rank)
+	}
+}
+
+func Test_newHashPrefix(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		ok    bool
+		exact []byte
+		from  []byte
+		to    []byte
+	}{
+		{
+			name:  "not hex",
+			query: "node0",
+		}, {
+			name:  "short exact",
+			query: "abcd",
+			ok:    true,
+			exact: []byte{0xab, 0xcd},
+		}, {
+			name:  "short odd",
+			query: "abc",
+		}, {
+			name:  "even prefix",
+			query: "0xABCDEF01",
+			ok:    true,
+			exact: []byte{0xab, 0xcd, 0xef, 0x01},
+			from:  []byte{0xab, 0xcd, 0xef, 0x01},
+			to:    []byte{0xab, 0xcd, 0xef, 0x02},
+		}, {
+			name:  "odd prefix",
+			query: "abcdef019",
+			ok:    true,
+			from:  []byte{0xab, 0xcd, 0xef, 0x01, 0x90},
+			to:    []byte{0xab, 0xcd, 0xef, 0x01, 0xa0},
+		}, {
+			name:  "carry",
+			query: "abcdefff",
+			ok:    true,
+			exact: []byte{0xab, 0xcd, 0xef, 0xff},
+			from:  []byte{0xab, 0xcd, 0xef, 0xff},
+			to:    []byte{0xab, 0xcd, 0xf0},
+		}, {
+			name:  "no upper bound",
+			query: "ffffffff",
+			ok:    true,
+			exact: []byte{0xff, 0xff, 0xff, 0xff},
+			from:  []byte{0xff, 0xff, 0xff, 0xff},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := newHashPrefix(tt.query)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.exact, prefix.exact)
+			require.Equal(t, tt.from, prefix.from)
+			require.Equal(t, tt.to, prefix.to)
+		})
+	}
+}
+
+func (s *StorageTestSuite) TestSearchRollupByName() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, query := range []string{"flame", "fla", "lam"} {
+		results, err := s.storage.Search.Search(ctx, query, 10)
+		s.Require().NoError(err, query)
+		s.Require().Len(results, 1, query)
+
+		result := results[0]
+		s.Require().EqualValues("Flame", result.Value, query)
+		s.Require().EqualValues("rollup", result.Type, query)
+		s.Require().EqualValues(1, result.Id, query)
+	}
+}
+
+func (s *StorageTestSuite) TestSearchWildcards() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, query := range []string{"%", "_lame", "fl%e"} {
+		results, err := s.storage.Search.Search(ctx, query, 10)
+		s.Require().NoError(err, query)
+		s.Require().Len(results, 0, query)
+	}
+}
+
+func Test_escapeLike(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "flame", want: "flame"},
+		{text: "100%", want: `100\%`},
+		{text: "a_b", want: `a\_b`},
+		{text: `a\b`, want: `a\\b`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			require.Equal(t, tt.want, escapeLike(tt.text))
+		})
+	}
+}
@@ -5,10 +5,16 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
 )
 
 type Stats struct {
@@ -19,12 +25,35 @@ func NewStats(conn *database.Bun) Stats {
 	return Stats{conn}
 }
 
+// seriesColumns - aggregations merging buckets of the view into a point of the series.
+// Min and max are extremes of merged buckets, so they are equal to the value if a point contains a single bucket.
+// Rates (tps, bps, rbps) keep extremes of per-block rates from the views.
+var seriesColumns = map[string]string{
+	storage.SeriesDataSize:      "sum(data_size) as value, max(data_size) as max, min(data_size) as min",
+	storage.SeriesTPS:           "avg(tps) as value, max(tps_max) as max, min(tps_min) as min",
+	storage.SeriesBPS:           "avg(bps) as value, max(bps_max) as max, min(bps_min) as min",
+	storage.SeriesRBPS:          "avg(rbps) as value, max(rbps_max) as max, min(rbps_min) as min",
+	storage.SeriesFee:           "sum(fee) as value, max(fee) as max, min(fee) as min",
+	storage.SeriesSupplyChange:  "sum(supply_change) as value, max(supply_change) as max, min(supply_change) as min",
+	storage.SeriesBlockTime:     "avg(block_time) as value, max(block_time) as max, min(block_time) as min",
+	storage.SeriesTxCount:       "sum(tx_count) as value, max(tx_count) as max, min(tx_count) as min",
+	storage.SeriesBytesInBlock:  "sum(bytes_in_block) as value, max(bytes_in_block) as max, min(bytes_in_block) as min",
+	storage.SeriesGasPrice:      "(case when sum(gas_wanted) > 0 then sum(fee) / sum(gas_wanted) else 0 end) as value, max(gas_price) as max, min(gas_price) as min",
+	storage.SeriesGasEfficiency: "(case when sum(gas_wanted) > 0 then sum(gas_used) / sum(gas_wanted) else 0 end) as value, max(gas_efficiency) as max, min(gas_efficiency) as min",
+	storage.SeriesGasWanted:     "sum(gas_wanted) as value, max(gas_wanted) as max, min(gas_wanted) as min",
+	storage.SeriesGasUsed:       "sum(gas_used) as value, max(gas_used) as max, min(gas_used) as min",
+}
+
+// Series - returns series of block stats. If `From` or `To` is set, the whole range is returned and buckets are merged
+// when the range contains more of them than requested points. Otherwise the last 100 buckets are returned.
 func (s Stats) Series(ctx context.Context, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
 	var view string
 	switch timeframe {
+	case storage.TimeframeMinute:
+		view = storage.ViewBlockStatsByMinute
 	case storage.TimeframeHour:
 		view = storage.ViewBlockStatsByHour
-	case storage.TimeframeDay:
+	case storage.TimeframeDay, storage.TimeframeWeek:
 		view = storage.ViewBlockStatsByDay
 	case storage.TimeframeMonth:
 		view = storage.ViewBlockStatsByMonth
@@ -32,56 +61,122 @@ func (s Stats) Series(ctx context.Context, timeframe storage.Timeframe, name str
 		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
 	}
 
-	query := s.db.DB().NewSelect().Table(view)
-
-	switch name {
-	case storage.SeriesDataSize:
-		query.ColumnExpr("ts, data_size as value")
-	case storage.SeriesTPS:
-		query.ColumnExpr("ts, tps as value, tps_max as max, tps_min as min")
-	case storage.SeriesBPS:
-		query.ColumnExpr("ts, bps as value, bps_max as max, bps_min as min")
-	case storage.SeriesRBPS:
-		query.ColumnExpr("ts, rbps as value, rbps_max as max, rbps_min as min")
-	case storage.SeriesFee:
-		query.ColumnExpr("ts, fee as value")
-	case storage.SeriesSupplyChange:
-		query.ColumnExpr("ts, supply_change as value")
-	case storage.SeriesBlockTime:
-		query.ColumnExpr("ts, block_time as value")
-	case storage.SeriesTxCount:
-		query.ColumnExpr("ts, tx_count as value")
-	case storage.SeriesBytesInBlock:
-		query.ColumnExpr("ts, bytes_in_block as value")
-	case storage.SeriesGasPrice:
-		query.ColumnExpr("ts, gas_price as value")
-	case storage.SeriesGasEfficiency:
-		query.ColumnExpr("ts, gas_efficiency as value")
-	case storage.SeriesGasWanted:
-		query.ColumnExpr("ts, gas_wanted as value")
-	case storage.SeriesGasUsed:
-		query.ColumnExpr("ts, gas_used as value")
-	default:
+	columns, ok := seriesColumns[name]
+	if !ok {
 		return nil, errors.Errorf("unexpected series name: %s", name)
 	}
 
+	req, err = seriesRange(ctx, s.db.DB().NewSelect().Table(view), req)
+	if err != nil {
+		return
+	}
+
+	query := s.db.DB().NewSelect().Table(view).
+		ColumnExpr("time_bucket(?::interval, ts) as ts", seriesInterval(timeframe, req, time.Now().UTC())).
+		ColumnExpr(columns)
+
+	err = seriesScope(query, req).GroupExpr("1").OrderExpr("1 desc").Scan(ctx, &response)
+	return
+}
+
+// seriesRange - returns the range of the series. If only `To` is set, the range starts from the first bucket
+// matched by the query, so the whole history before `To` is returned.
+func seriesRange(ctx context.Context, first *bun.SelectQuery, req storage.SeriesRequest) (storage.SeriesRequest, error) {
+	if !req.From.IsZero() || req.To.IsZero() {
+		return req, nil
+	}
+
+	var from bun.NullTime
+	if err := first.ColumnExpr("min(ts)").Scan(ctx, &from); err != nil {
+		return req, err
+	}
+	if !from.IsZero() && from.Before(req.To) {
+		req.From = from.Time
+	}
+	return req, nil
+}
+
+// seriesScope - filters the query by the range of the series. The last 100 points are returned if the range is not set.
+func seriesScope(query *bun.SelectQuery, req storage.SeriesRequest) *bun.SelectQuery {
 	if !req.From.IsZero() {
 		query = query.Where("ts >= ?", req.From)
+	} else {
+		query = query.Limit(100)
 	}
 	if !req.To.IsZero() {
 		query = query.Where("ts < ?", req.To)
 	}
+	return query
+}
 
-	err = query.Limit(100).Scan(ctx, &response)
-	return
+// seriesInterval - returns width of the series point. It's a single bucket of the timeframe
+// unless the range contains more buckets than requested points.
+func seriesInterval(timeframe storage.Timeframe, req storage.SeriesRequest, now time.Time) string {
+	var width int64 = 1
+	if !req.From.IsZero() {
+		to := req.To
+		if to.IsZero() {
+			to = now
+		}
+
+		points := int64(req.Points)
+		if points <= 0 || points > storage.MaxSeriesPoints {
+			points = storage.MaxSeriesPoints
+		}
+		if count := bucketsCount(timeframe, req.From, to); count > points {
+			width = (count + points - 1) / points
+		}
+	}
+	return fmt.Sprintf("%d %s", width, timeframe)
+}
+
+// bucketsCount - returns count of timeframe buckets between from and to
+func bucketsCount(timeframe storage.Timeframe, from, to time.Time) int64 {
+	if !to.After(from) {
+		return 0
+	}
+
+	var step time.Duration
+	switch timeframe {
+	case storage.TimeframeMinute:
+		step = time.Minute
+	case storage.TimeframeHour:
+		step = time.Hour
+	case storage.TimeframeDay:
+		step = 24 * time.Hour
+	case storage.TimeframeWeek:
+		step = 7 * 24 * time.Hour
+	case storage.TimeframeMonth:
+		return int64((to.Year()-from.Year())*12+int(to.Month())-int(from.Month())) + 1
+	default:
+		return 0
+	}
+
+	count := int64(to.Sub(from) / step)
+	if to.Sub(from)%step > 0 {
+		count++
+	}
+	return count
+}
+
+// rollupSeriesColumns - aggregations merging buckets of the rollup view into a point of the series.
+// Min and max are extremes of the merged buckets.
+var rollupSeriesColumns = map[string]string{
+	storage.RollupSeriesActionsCount: "sum(actions_count) as value, max(actions_count) as max, min(actions_count) as min",
+	storage.RollupSeriesAvgSize:      "(case when sum(actions_count) > 0 then sum(size) / sum(actions_count) else 0 end) as value, max(avg_size) as max, min(avg_size) as min",
+	storage.RollupSeriesMaxSize:      "max(max_size) as value, max(max_size) as max, min(max_size) as min",
+	storage.RollupSeriesMinSize:      "min(min_size) as value, max(min_size) as max, min(min_size) as min",
+	storage.RollupSeriesSize:         "sum(size) as value, max(size) as max, min(size) as min",
 }
 
+// RollupSeries - returns series of rollup stats in the same way as Series. Minute timeframe is not supported:
+// minute buckets of every rollup would be comparable with the rollup_action table by size.
 func (s Stats) RollupSeries(ctx context.Context, rollupId uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
 	var view string
 	switch timeframe {
 	case storage.TimeframeHour:
 		view = storage.ViewRollupStatsByHour
-	case storage.TimeframeDay:
+	case storage.TimeframeDay, storage.TimeframeWeek:
 		view = storage.ViewRollupStatsByDay
 	case storage.TimeframeMonth:
 		view = storage.ViewRollupStatsByMonth
@@ -89,32 +184,55 @@ func (s Stats) RollupSeries(ctx context.Context, rollupId uint64, timeframe stor
 		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
 	}
 
+	columns, ok := rollupSeriesColumns[name]
+	if !ok {
+		return nil, errors.Errorf("unexpected series name: %s", name)
+	}
+
+	req, err = seriesRange(ctx, s.db.DB().NewSelect().Table(view).Where("rollup_id = ?", rollupId), req)
+	if err != nil {
+		return
+	}
+
 	query := s.db.DB().NewSelect().Table(view).
+		ColumnExpr("time_bucket(?::interval, ts) as ts", seriesInterval(timeframe, req, time.Now().UTC())).
+		ColumnExpr(columns).
 		Where("rollup_id = ?", rollupId)
 
-	switch name {
-	case storage.RollupSeriesActionsCount:
-		query.ColumnExpr("ts, actions_count as value")
-	case storage.RollupSeriesAvgSize:
-		query.ColumnExpr("ts, avg_size as value")
-	case storage.RollupSeriesMaxSize:
-		query.ColumnExpr("ts, max_size as value")
-	case storage.RollupSeriesMinSize:
-		query.ColumnExpr("ts, min_size as value")
-	case storage.RollupSeriesSize:
-		query.ColumnExpr("ts, size as value")
+	err = seriesScope(query, req).GroupExpr("1").OrderExpr("1 desc").Scan(ctx, &response)
+	return
+}
+
+// ActionSeries - returns count and volume of actions of the type by time buckets in the same way as Series.
+// Count and volume of merged buckets are summed, min and max are extremes of merged buckets.
+func (s Stats) ActionSeries(ctx context.Context, timeframe storage.Timeframe, actionType types.ActionType, req storage.SeriesRequest) (response []storage.ActionSeriesItem, err error) {
+	var view string
+	switch timeframe {
+	case storage.TimeframeMinute:
+		view = storage.ViewActionStatsByMinute
+	case storage.TimeframeHour:
+		view = storage.ViewActionStatsByHour
+	case storage.TimeframeDay, storage.TimeframeWeek:
+		view = storage.ViewActionStatsByDay
+	case storage.TimeframeMonth:
+		view = storage.ViewActionStatsByMonth
 	default:
-		return nil, errors.Errorf("unexpected series name: %s", name)
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
 	}
 
-	if !req.From.IsZero() {
-		query = query.Where("ts >= ?", req.From)
-	}
-	if !req.To.IsZero() {
-		query = query.Where("ts < ?", req.To)
+	req, err = seriesRange(ctx, s.db.DB().NewSelect().Table(view).Where("type = ?", actionType), req)
+	if err != nil {
+		return
 	}
 
-	err = query.Limit(100).Scan(ctx, &response)
+	query := s.db.DB().NewSelect().Table(view).
+		ColumnExpr("time_bucket(?::interval, ts) as ts", seriesInterval(timeframe, req, time.Now().UTC())).
+		ColumnExpr("sum(actions_count) as actions_count, coalesce(sum(volume), 0) as volume").
+		ColumnExpr("max(actions_count) as max_count, min(actions_count) as min_count").
+		ColumnExpr("coalesce(max(volume), 0) as max_volume, coalesce(min(volume), 0) as min_volume").
+		Where("type = ?", actionType)
+
+	err = seriesScope(query, req).GroupExpr("1").OrderExpr("1 desc").Scan(ctx, &response)
 	return
 }
 
@@ -125,3 +243,178 @@ func (s Stats) Summary(ctx context.Context) (summary storage.NetworkSummary, err
 		Scan(ctx, &summary)
 	return
 }
+
+// Holders - returns distribution of the currency between holders. Distribution is read from holder buckets which are updated on every balance change,
+// so only top holders are read from balances by index.
+func (s Stats) Holders(ctx context.Context, currency string, limit int) (stats storage.HolderStats, err error) {
+	stats.Currency = currency
+	stats.Supply = decimal.Zero
+
+	if err = s.db.DB().NewSelect().
+		Model(&stats.Buckets).
+		Where("currency = ?", currency).
+		Where("count > 0").
+		Order("bucket asc").
+		Scan(ctx); err != nil {
+		return
+	}
+
+	for i := range stats.Buckets {
+		stats.HoldersCount += stats.Buckets[i].Count
+		stats.Supply = stats.Supply.Add(stats.Buckets[i].Total)
+	}
+	stats.Gini = giniByBuckets(stats.Buckets, stats.HoldersCount, stats.Supply)
+
+	query := s.db.DB().NewSelect().
+		Model(&stats.Top).
+		Relation("Balance").
+		Where("balance.currency = ?", currency).
+		Where("balance.total > 0").
+		OrderExpr("balance.total desc, address.id asc")
+	err = limitScope(query, limit).Scan(ctx)
+	return
+}
+
+// giniByBuckets - estimates Gini coefficient by the Lorenz curve built on buckets sorted in ascending order.
+// Holders inside the bucket are considered equal, so the result is a lower bound of the exact coefficient.
+func giniByBuckets(buckets []storage.HolderBucket, holders int64, supply decimal.Decimal) float64 {
+	if holders == 0 || !supply.IsPositive() {
+		return 0
+	}
+
+	var (
+		gini       = 1.0
+		cumulative = decimal.Zero
+		prevShare  float64
+	)
+	for i := range buckets {
+		cumulative = cumulative.Add(buckets[i].Total)
+		share := cumulative.Div(supply).InexactFloat64()
+		gini -= float64(buckets[i].Count) / float64(holders) * (share + prevShare)
+		prevShare = share
+	}
+	return math.Max(gini, 0)
+}
+
+// ActiveAddresses - returns daily count of addresses which signed transactions or received funds. Range is bounded in the same way as Series.
+func (s Stats) ActiveAddresses(ctx context.Context, req storage.SeriesRequest) (response []storage.ActiveAddressesItem, err error) {
+	active := func() *bun.SelectQuery {
+		signers := s.db.DB().NewSelect().
+			Table(storage.ViewSignerByDay).
+			ColumnExpr("ts, address_id, 1 as is_signer, 0 as is_receiver")
+		receivers := s.db.DB().NewSelect().
+			Table(storage.ViewReceiverByDay).
+			ColumnExpr("ts, address_id, 0 as is_signer, 1 as is_receiver")
+		return s.db.DB().NewSelect().TableExpr("(?) as active", signers.UnionAll(receivers))
+	}
+
+	req, err = seriesRange(ctx, active(), req)
+	if err != nil {
+		return
+	}
+
+	query := active().
+		ColumnExpr("ts, count(distinct address_id) as active, sum(is_signer) as signers, sum(is_receiver) as receivers").
+		Group("ts").
+		Order("ts desc")
+	err = seriesScope(query, req).Scan(ctx, &response)
+	return
+}
+
+// TopRollups - returns rollups with the largest activity in the window and their activity in the previous window of the same length.
+// Hourly aggregates are used for windows up to one day and daily aggregates for longer ones.
+func (s Stats) TopRollups(ctx context.Context, req storage.TopRollupsRequest) (items []storage.TopRollupItem, err error) {
+	if req.Window <= 0 {
+		return nil, errors.New("window should be positive")
+	}
+
+	statsView, sendersView, step := storage.ViewRollupStatsByHour, storage.ViewRollupSenderByHour, time.Hour
+	if req.Window > 24*time.Hour {
+		statsView, sendersView, step = storage.ViewRollupStatsByDay, storage.ViewRollupSenderByDay, 24*time.Hour
+	}
+
+	to := req.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	// the window includes the bucket of its end, so the current bucket is taken into account
+	to = to.UTC().Truncate(step).Add(step)
+	from := to.Add(-req.Window)
+	prevFrom := from.Add(-req.Window)
+
+	query := s.db.DB().NewSelect().
+		With("cur", s.rollupWindowStats(statsView, from, to)).
+		With("prev", s.rollupWindowStats(statsView, prevFrom, from)).
+		With("cur_signers", s.rollupWindowSigners(sendersView, from, to)).
+		With("prev_signers", s.rollupWindowSigners(sendersView, prevFrom, from)).
+		TableExpr("cur").
+		ColumnExpr("cur.rollup_id, cur.size, cur.actions_count").
+		ColumnExpr("coalesce(cur_signers.signers, 0) as signers").
+		ColumnExpr("coalesce(cur.size::float / nullif(sum(cur.size) over (), 0), 0) as size_share").
+		ColumnExpr("coalesce(prev.size, 0) as prev_size").
+		ColumnExpr("coalesce(prev.actions_count, 0) as prev_actions_count").
+		ColumnExpr("coalesce(prev_signers.signers, 0) as prev_signers").
+		Join("LEFT JOIN prev ON prev.rollup_id = cur.rollup_id").
+		Join("LEFT JOIN cur_signers ON cur_signers.rollup_id = cur.rollup_id").
+		Join("LEFT JOIN prev_signers ON prev_signers.rollup_id = cur.rollup_id")
+
+	switch req.By {
+	case storage.TopRollupsBySize:
+		query = query.OrderExpr("cur.size desc")
+	case storage.TopRollupsByActions:
+		query = query.OrderExpr("cur.actions_count desc")
+	case storage.TopRollupsBySigners:
+		query = query.OrderExpr("signers desc")
+	default:
+		return nil, errors.Errorf("unexpected leaderboard metric: %s", req.By)
+	}
+	query = query.OrderExpr("cur.rollup_id asc")
+
+	if err = limitScope(query, req.Limit).Scan(ctx, &items); err != nil || len(items) == 0 {
+		return
+	}
+
+	ids := make([]uint64, len(items))
+	for i := range items {
+		ids[i] = items[i].RollupId
+	}
+
+	var rollups []storage.Rollup
+	if err = s.db.DB().NewSelect().
+		Model(&rollups).
+		Relation("BridgeAddress", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Column("hash")
+		}).
+		Relation("Metadata").
+		Where("rollup.id IN (?)", bun.In(ids)).
+		Scan(ctx); err != nil {
+		return
+	}
+
+	byId := make(map[uint64]*storage.Rollup, len(rollups))
+	for i := range rollups {
+		byId[rollups[i].Id] = &rollups[i]
+	}
+	for i := range items {
+		items[i].Rollup = byId[items[i].RollupId]
+	}
+	return
+}
+
+func (s Stats) rollupWindowStats(view string, from, to time.Time) *bun.SelectQuery {
+	return s.db.DB().NewSelect().
+		Table(view).
+		ColumnExpr("rollup_id, sum(size) as size, sum(actions_count) as actions_count").
+		Where("ts >= ?", from).
+		Where("ts < ?", to).
+		Group("rollup_id")
+}
+
+func (s Stats) rollupWindowSigners(view string, from, to time.Time) *bun.SelectQuery {
+	return s.db.DB().NewSelect().
+		Table(view).
+		ColumnExpr("rollup_id, count(distinct sender_id) as signers").
+		Where("ts >= ?", from).
+		Where("ts < ?", to).
+		Group("rollup_id")
+}
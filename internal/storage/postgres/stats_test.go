@@ -10,9 +10,11 @@ import (
 	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -90,6 +92,42 @@ func (s *StatsTestSuite) TestSeriesWithFrom() {
 	s.Require().Len(items, 0)
 }
 
+func (s *StatsTestSuite) TestSeriesTimeframes() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeMinute,
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeWeek,
+		storage.TimeframeMonth,
+	} {
+		items, err := s.storage.Stats.Series(ctx, tf, storage.SeriesBytesInBlock, storage.NewSeriesRequest(0, 0))
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+		s.Require().Equal("330", items[0].Value, tf)
+		s.Require().Equal("330", items[0].Max, tf)
+		s.Require().Equal("330", items[0].Min, tf)
+	}
+}
+
+func (s *StatsTestSuite) TestSeriesDownsampling() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	req := storage.NewSeriesRequest(1701302400, 1701475200)
+	req.Points = 2
+
+	items, err := s.storage.Stats.Series(ctx, storage.TimeframeHour, storage.SeriesTxCount, req)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Equal("1", items[0].Value)
+	s.Require().Equal("1", items[0].Max)
+	s.Require().Equal("1", items[0].Min)
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), items[0].Time.UTC())
+}
+
 func (s *StatsTestSuite) TestRollupSeries() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -128,3 +166,208 @@ func (s *StatsTestSuite) TestSummary() {
 func TestSuiteStats_Run(t *testing.T) {
 	suite.Run(t, new(StatsTestSuite))
 }
+
+func (s *StatsTestSuite) TestHolders() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	holders, err := s.storage.Stats.Holders(ctx, "nria", 2)
+	s.Require().NoError(err)
+	s.Require().Equal("nria", holders.Currency)
+	s.Require().EqualValues(3, holders.HoldersCount)
+	s.Require().Equal("1000000000000000000001", holders.Supply.String())
+	s.Require().InDelta(1.0/3, holders.Gini, 1e-9)
+
+	s.Require().Len(holders.Buckets, 2)
+	s.Require().EqualValues(0, holders.Buckets[0].Bucket)
+	s.Require().EqualValues(1, holders.Buckets[0].Count)
+	s.Require().EqualValues(20, holders.Buckets[1].Bucket)
+	s.Require().EqualValues(2, holders.Buckets[1].Count)
+
+	s.Require().Len(holders.Top, 2)
+	for i := range holders.Top {
+		s.Require().NotNil(holders.Top[i].Balance)
+		s.Require().Equal("500000000000000000000", holders.Top[i].Balance.Total.String())
+	}
+	s.Require().EqualValues(1, holders.Top[0].Id)
+	s.Require().EqualValues(2, holders.Top[1].Id)
+}
+
+func (s *StatsTestSuite) TestHoldersUnknownCurrency() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	holders, err := s.storage.Stats.Holders(ctx, "unknown", 10)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, holders.HoldersCount)
+	s.Require().Len(holders.Buckets, 0)
+	s.Require().Len(holders.Top, 0)
+	s.Require().Zero(holders.Gini)
+}
+
+func (s *StatsTestSuite) TestActiveAddresses() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Stats.ActiveAddresses(ctx, storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), items[0].Time.UTC())
+	s.Require().EqualValues(2, items[0].Active)
+	s.Require().EqualValues(1, items[0].Signers)
+	s.Require().EqualValues(1, items[0].Receivers)
+
+	s.Require().EqualValues(2, items[1].Active)
+	s.Require().EqualValues(1, items[1].Signers)
+	s.Require().EqualValues(2, items[1].Receivers)
+
+	items, err = s.storage.Stats.ActiveAddresses(ctx, storage.NewSeriesRequest(1701388800, 0))
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	items, err = s.storage.Stats.ActiveAddresses(ctx, storage.NewSeriesRequest(0, 1701388800))
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().True(items[0].Time.Before(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (s *StatsTestSuite) TestTopRollups() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, by := range []string{
+		storage.TopRollupsBySize,
+		storage.TopRollupsByActions,
+		storage.TopRollupsBySigners,
+	} {
+		for _, window := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour} {
+			items, err := s.storage.Stats.TopRollups(ctx, storage.TopRollupsRequest{
+				Window: window,
+				By:     by,
+				Limit:  10,
+				To:     time.Date(2023, 11, 30, 23, 59, 0, 0, time.UTC),
+			})
+			s.Require().NoError(err, by)
+			s.Require().Len(items, 1, by)
+
+			item := items[0]
+			s.Require().EqualValues(1, item.RollupId)
+			s.Require().EqualValues(112, item.Size)
+			s.Require().EqualValues(1, item.ActionsCount)
+			s.Require().EqualValues(1, item.Signers)
+			s.Require().EqualValues(1, item.SizeShare)
+			s.Require().EqualValues(0, item.PrevSize)
+			s.Require().EqualValues(0, item.PrevActionsCount)
+			s.Require().EqualValues(0, item.PrevSigners)
+			s.Require().NotNil(item.Rollup)
+			s.Require().NotNil(item.Rollup.Metadata)
+			s.Require().Equal("Flame", item.Rollup.Metadata.Name)
+		}
+	}
+}
+
+func (s *StatsTestSuite) TestTopRollupsEmptyWindow() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.Stats.TopRollups(ctx, storage.TopRollupsRequest{
+		Window: 24 * time.Hour,
+		By:     storage.TopRollupsBySize,
+		To:     time.Date(2023, 12, 1, 23, 59, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 0)
+}
+
+func (s *StatsTestSuite) TestTopRollupsInvalidMetric() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Stats.TopRollups(ctx, storage.TopRollupsRequest{
+		Window: 24 * time.Hour,
+		By:     "fee",
+	})
+	s.Require().Error(err)
+}
+
+func (s *StatsTestSuite) TestActionSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeMinute,
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeWeek,
+		storage.TimeframeMonth,
+	} {
+		items, err := s.storage.Stats.ActionSeries(ctx, tf, types.ActionTypeTransfer, storage.SeriesRequest{})
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+		s.Require().EqualValues(1, items[0].Count, tf)
+		s.Require().Equal("1", items[0].Volume.String(), tf)
+		s.Require().EqualValues(1, items[0].MaxCount, tf)
+		s.Require().EqualValues(1, items[0].MinCount, tf)
+		s.Require().Equal("1", items[0].MaxVolume.String(), tf)
+		s.Require().Equal("1", items[0].MinVolume.String(), tf)
+
+		items, err = s.storage.Stats.ActionSeries(ctx, tf, types.ActionTypeSequence, storage.SeriesRequest{})
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+		s.Require().EqualValues(1, items[0].Count, tf)
+		s.Require().True(items[0].Volume.IsZero(), tf)
+	}
+
+	items, err := s.storage.Stats.ActionSeries(ctx, storage.TimeframeHour, types.ActionTypeTransfer, storage.NewSeriesRequest(1706018798, 0))
+	s.Require().NoError(err)
+	s.Require().Len(items, 0)
+}
+
+func Test_seriesInterval(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		timeframe storage.Timeframe
+		req       storage.SeriesRequest
+		want      string
+	}{
+		{
+			name:      "without range",
+			timeframe: storage.TimeframeMinute,
+			want:      "1 minute",
+		}, {
+			name:      "30 days by hour",
+			timeframe: storage.TimeframeHour,
+			req:       storage.SeriesRequest{From: from},
+			want:      "1 hour",
+		}, {
+			name:      "30 days by minute",
+			timeframe: storage.TimeframeMinute,
+			req:       storage.SeriesRequest{From: from},
+			want:      "44 minute",
+		}, {
+			name:      "30 days by hour in 100 points",
+			timeframe: storage.TimeframeHour,
+			req:       storage.SeriesRequest{From: from, To: now, Points: 100},
+			want:      "8 hour",
+		}, {
+			name:      "week",
+			timeframe: storage.TimeframeWeek,
+			req:       storage.SeriesRequest{From: from, Points: 2},
+			want:      "3 week",
+		}, {
+			name:      "month",
+			timeframe: storage.TimeframeMonth,
+			req:       storage.SeriesRequest{From: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Points: 5},
+			want:      "5 month",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, seriesInterval(tt.timeframe, tt.req, now))
+		})
+	}
+}
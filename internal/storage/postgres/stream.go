@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+// stream - executes query and passes rows to the callback one by one while result set is read from the connection.
+// Iteration stops on the first error returned by the callback.
+func stream[T any](ctx context.Context, db *bun.DB, query *bun.SelectQuery, fn func(T) error) error {
+	rows, err := query.Rows(ctx)
+	if err != nil {
+		return errors.Wrap(err, "open rows")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item T
+		if err := db.ScanRow(ctx, rows, &item); err != nil {
+			return errors.Wrap(err, "scan row")
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
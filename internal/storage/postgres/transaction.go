@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 
 	models "github.com/celenium-io/astria-indexer/internal/storage"
@@ -40,6 +41,24 @@ func (tx Transaction) SaveTransactions(ctx context.Context, txs ...*models.Tx) e
 	return err
 }
 
+func (tx Transaction) SaveRawTxs(ctx context.Context, txs ...models.RawTx) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&txs).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveRawBlocks(ctx context.Context, blocks ...models.RawBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&blocks).Exec(ctx)
+	return err
+}
+
 type addedAddress struct {
 	bun.BaseModel `bun:"address"`
 	*models.Address
@@ -79,17 +98,30 @@ func (tx Transaction) SaveAddresses(ctx context.Context, addresses ...*models.Ad
 	return count, err
 }
 
+// SaveBalances - adds passed deltas to balances and moves changed balances between holder buckets
 func (tx Transaction) SaveBalances(ctx context.Context, balances ...models.Balance) error {
 	if len(balances) == 0 {
 		return nil
 	}
 
-	_, err := tx.Tx().NewInsert().Model(&balances).
+	saved := make([]models.Balance, len(balances))
+	copy(saved, balances)
+
+	_, err := tx.Tx().NewInsert().Model(&saved).
 		Column("id", "currency", "total").
 		On("CONFLICT (id, currency) DO UPDATE").
 		Set("total = EXCLUDED.total + balance.total").
+		Returning("total").
 		Exec(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+
+	diff := make(holderBucketsDiff)
+	for i := range saved {
+		diff.add(saved[i].Currency, saved[i].Total.Sub(balances[i].Total), saved[i].Total)
+	}
+	return tx.saveHolderBuckets(ctx, diff)
 }
 
 func (tx Transaction) SaveActions(ctx context.Context, actions ...*models.Action) error {
@@ -227,6 +259,16 @@ func (tx Transaction) RollbackTxs(ctx context.Context, height types.Level) (txs
 	return
 }
 
+func (tx Transaction) RollbackRawTxs(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().Model((*models.RawTx)(nil)).Where("height = ?", height).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackRawBlock(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().Model((*models.RawBlock)(nil)).Where("height = ?", height).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) RollbackActions(ctx context.Context, height types.Level) (actions []models.Action, err error) {
 	_, err = tx.Tx().NewDelete().Model(&actions).Where("height = ?", height).Returning("*").Exec(ctx)
 	return
@@ -277,16 +319,27 @@ func (tx Transaction) RollbackRollups(ctx context.Context, height types.Level) (
 	return
 }
 
+// RollbackBalances - removes balances of addresses and excludes them from holder buckets
 func (tx Transaction) RollbackBalances(ctx context.Context, ids []uint64) error {
 	if len(ids) == 0 {
 		return nil
 	}
 
+	var deleted []models.Balance
 	_, err := tx.Tx().NewDelete().
 		Model((*models.Balance)(nil)).
 		Where("id IN (?)", bun.In(ids)).
-		Exec(ctx)
-	return err
+		Returning("id, currency, total").
+		Exec(ctx, &deleted)
+	if err != nil {
+		return err
+	}
+
+	diff := make(holderBucketsDiff)
+	for i := range deleted {
+		diff.add(deleted[i].Currency, deleted[i].Total, decimal.Zero)
+	}
+	return tx.saveHolderBuckets(ctx, diff)
 }
 
 func (tx Transaction) UpdateAddresses(ctx context.Context, addresses ...*models.Address) error {
@@ -360,3 +413,16 @@ func (tx Transaction) RetentionBlockSignatures(ctx context.Context, height types
 		Exec(ctx)
 	return err
 }
+
+func (tx Transaction) ReserveIds(ctx context.Context, table string, count int) ([]uint64, error) {
+	if count <= 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint64, 0, count)
+	err := tx.Tx().NewRaw(
+		"SELECT nextval(pg_get_serial_sequence(?, 'id')) FROM generate_series(1, ?)",
+		table, count,
+	).Scan(ctx, &ids)
+	return ids, err
+}
@@ -178,6 +178,65 @@ func (s *TransactionTestSuite) TestSaveTransactions() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestSaveRawTxs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	raws := make([]storage.RawTx, 5)
+	for i := 0; i < 5; i++ {
+		raws[i] = storage.RawTx{
+			Id:     uint64(i + 10),
+			Height: pkgTypes.Level(10000),
+			Time:   time.Now(),
+			Data:   testsuite.RandomHash(100),
+		}
+	}
+
+	err = tx.SaveRawTxs(ctx, raws...)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	raw, err := s.storage.RawTx.ByTxId(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(raws[0].Data, raw.Data)
+}
+
+func (s *TransactionTestSuite) TestSaveRawBlocks() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	block, err := types.CompressionGzip.Compress([]byte(`{"block":{}}`))
+	s.Require().NoError(err)
+
+	err = tx.SaveRawBlocks(ctx, storage.RawBlock{
+		Height:       pkgTypes.Level(10000),
+		Time:         time.Now(),
+		Compression:  types.CompressionGzip,
+		Block:        block,
+		BlockResults: []byte(`{}`),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	raw, err := s.storage.RawBlock.ByHeight(ctx, 10000)
+	s.Require().NoError(err)
+	s.Require().Equal(types.CompressionGzip, raw.Compression)
+
+	data, _, err := raw.Decompressed()
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"block":{}}`, string(data))
+}
+
 func (s *TransactionTestSuite) TestSaveActions() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -206,6 +265,65 @@ func (s *TransactionTestSuite) TestSaveActions() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestCopyFrom() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	txIds, err := tx.ReserveIds(ctx, storage.Tx{}.TableName(), 1)
+	s.Require().NoError(err)
+	s.Require().Len(txIds, 1)
+
+	actionIds, err := tx.ReserveIds(ctx, storage.Action{}.TableName(), 1)
+	s.Require().NoError(err)
+	s.Require().Len(actionIds, 1)
+
+	blockTime := time.Now().UTC().Truncate(time.Second)
+	copiedTx := storage.Tx{
+		Id:           txIds[0],
+		Height:       pkgTypes.Level(10000),
+		Time:         blockTime,
+		Status:       types.StatusFailed,
+		Error:        "error",
+		SignerId:     1,
+		ActionsCount: 1,
+		ActionTypes:  types.ActionTypeSequenceBits,
+		Hash:         testsuite.RandomHash(32),
+	}
+	copiedAction := storage.Action{
+		Id:     actionIds[0],
+		Height: pkgTypes.Level(10000),
+		Time:   blockTime,
+		Type:   types.ActionTypeSequence,
+		TxId:   txIds[0],
+		Data: map[string]any{
+			"rollup_id": "rollup",
+			"size":      float64(10),
+		},
+	}
+
+	s.Require().NoError(tx.CopyFrom(ctx, storage.Tx{}.TableName(), []sdk.Copiable{copiedTx}))
+	s.Require().NoError(tx.CopyFrom(ctx, storage.Action{}.TableName(), []sdk.Copiable{copiedAction}))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	savedTx, err := s.storage.Tx.GetByID(ctx, txIds[0])
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusFailed, savedTx.Status)
+	s.Require().Equal("error", savedTx.Error)
+	s.Require().Equal(copiedTx.Hash, savedTx.Hash)
+	s.Require().Equal(copiedTx.ActionTypes, savedTx.ActionTypes)
+	s.Require().True(blockTime.Equal(savedTx.Time))
+
+	savedAction, err := s.storage.Action.GetByID(ctx, actionIds[0])
+	s.Require().NoError(err)
+	s.Require().Equal(types.ActionTypeSequence, savedAction.Type)
+	s.Require().Equal(txIds[0], savedAction.TxId)
+	s.Require().Equal(copiedAction.Data, savedAction.Data)
+}
+
 func (s *TransactionTestSuite) TestSaveValidators() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -374,6 +492,18 @@ func (s *TransactionTestSuite) TestSaveBalances() {
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	holders, err := s.storage.Stats.Holders(ctx, string(currency.Nria), 10)
+	s.Require().NoError(err)
+	s.Require().EqualValues(6, holders.HoldersCount)
+	s.Require().Equal("1000000000000000005001", holders.Supply.String())
+	s.Require().Len(holders.Buckets, 3)
+	s.Require().EqualValues(3, holders.Buckets[1].Bucket)
+	s.Require().EqualValues(3, holders.Buckets[1].Count)
+	s.Require().Equal("3000", holders.Buckets[1].Total.String())
+	s.Require().EqualValues(20, holders.Buckets[2].Bucket)
+	s.Require().EqualValues(2, holders.Buckets[2].Count)
+	s.Require().Equal("1000000000000000002000", holders.Buckets[2].Total.String())
 }
 
 func (s *TransactionTestSuite) TestSaveBalanceUpdates() {
@@ -536,6 +666,40 @@ func (s *TransactionTestSuite) TestRollbackTxs() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestRollbackRawTxs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackRawTxs(ctx, 7965)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	_, err = s.storage.RawTx.ByTxId(ctx, 2)
+	s.Require().Error(err)
+}
+
+func (s *TransactionTestSuite) TestRollbackRawBlock() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RollbackRawBlock(ctx, 7965)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	_, err = s.storage.RawBlock.ByHeight(ctx, 7965)
+	s.Require().Error(err)
+}
+
 func (s *TransactionTestSuite) TestRollbackActions() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -660,11 +824,17 @@ func (s *TransactionTestSuite) TestRollbackBalances() {
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.RollbackBalances(ctx, []uint64{3, 4})
+	err = tx.RollbackBalances(ctx, []uint64{3, 4, 8})
 	s.Require().NoError(err)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	holders, err := s.storage.Stats.Holders(ctx, string(currency.Nria), 10)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, holders.HoldersCount)
+	s.Require().Len(holders.Buckets, 1)
+	s.Require().EqualValues(20, holders.Buckets[0].Bucket)
 }
 
 func (s *TransactionTestSuite) TestUpdateAddresses() {
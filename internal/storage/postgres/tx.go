@@ -10,6 +10,7 @@ import (
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Tx -
@@ -56,6 +57,25 @@ func (tx *Tx) ByHeight(ctx context.Context, height types.Level, limit, offset in
 	return
 }
 
+// ByHeights - returns transactions of blocks with passed heights. Limit and offset are applied to every block separately.
+func (tx *Tx) ByHeights(ctx context.Context, heights []types.Level, limit, offset int) (txs []storage.Tx, err error) {
+	if len(heights) == 0 {
+		return
+	}
+	query := tx.DB().NewSelect().Model((*storage.Tx)(nil)).
+		ColumnExpr("tx.*").
+		ColumnExpr("row_number() over (partition by tx.height order by tx.id) as row_num").
+		Where("tx.height IN (?)", bun.In(heights))
+
+	outer := tx.DB().NewSelect().Model(&txs).
+		ModelTableExpr("(?) as tx", query).
+		Relation("Signer").
+		Order("tx.height asc", "tx.id asc")
+
+	err = partitionPageScope(outer, "tx.row_num", limit, offset).Scan(ctx)
+	return
+}
+
 func (tx *Tx) Filter(ctx context.Context, fltrs storage.TxFilter) (txs []storage.Tx, err error) {
 	query := tx.DB().NewSelect().Model(&txs).Relation("Signer")
 	query = txFilter(query, fltrs)
@@ -64,6 +84,17 @@ func (tx *Tx) Filter(ctx context.Context, fltrs storage.TxFilter) (txs []storage
 	return
 }
 
+// Stream - reads transactions matched the filter row by row without limit. Limit, offset, cursor and actions joining are ignored.
+func (tx *Tx) Stream(ctx context.Context, fltrs storage.TxFilter, fn func(storage.Tx) error) error {
+	query := tx.DB().NewSelect().
+		Model((*storage.Tx)(nil)).
+		Relation("Signer")
+	query = txConditionsScope(query, fltrs)
+	query = timeSortScope(query, "tx.time", "tx.id", fltrs.Sort)
+
+	return stream(ctx, tx.DB(), query, fn)
+}
+
 func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxFilter) (txs []storage.Tx, err error) {
 	query := tx.DB().NewSelect().
 		Model(&txs).
@@ -75,3 +106,38 @@ func (tx *Tx) ByAddress(ctx context.Context, addressId uint64, fltrs storage.TxF
 	err = query.Scan(ctx)
 	return txs, err
 }
+
+// ByIdWithRelations - returns transaction with signer and actions
+func (tx *Tx) ByIdWithRelations(ctx context.Context, id uint64) (transaction storage.Tx, err error) {
+	query := tx.DB().NewSelect().Model((*storage.Tx)(nil)).
+		Where("id = ?", id).
+		Limit(1)
+
+	err = tx.DB().NewSelect().
+		TableExpr("(?) as tx", query).
+		ColumnExpr("tx.*").
+		ColumnExpr("address.hash as signer__hash").
+		Join("left join address on address.id = tx.signer_id").
+		Scan(ctx, &transaction)
+	if err != nil {
+		return
+	}
+
+	err = tx.DB().NewSelect().
+		Model(&transaction.Actions).
+		Where("tx_id = ?", id).
+		Order("position asc").
+		Scan(ctx)
+	return
+}
+
+// ByIds - returns transactions by internal identities
+func (tx *Tx) ByIds(ctx context.Context, ids ...uint64) (txs []storage.Tx, err error) {
+	if len(ids) == 0 {
+		return
+	}
+	err = tx.DB().NewSelect().Model(&txs).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	return
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -60,6 +61,27 @@ func (s *StorageTestSuite) TestTxByHeight() {
 	s.Require().Equal("3fff1c39b9d163bfb9bcbf9dfea78675f1b4bc2c", hex.EncodeToString(tx.Signer.Hash))
 }
 
+func (s *StorageTestSuite) TestTxByHeights() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.Tx.ByHeights(ctx, []pkgTypes.Level{7316, 7965, 7964}, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 2)
+
+	s.Require().EqualValues(7316, txs[0].Height)
+	s.Require().EqualValues(1, txs[0].Id)
+	s.Require().NotNil(txs[0].Signer)
+	s.Require().Equal("3fff1c39b9d163bfb9bcbf9dfea78675f1b4bc2c", hex.EncodeToString(txs[0].Signer.Hash))
+
+	s.Require().EqualValues(7965, txs[1].Height)
+	s.Require().EqualValues(2, txs[1].Id)
+
+	txs, err = s.storage.Tx.ByHeights(ctx, []pkgTypes.Level{7316, 7965}, 10, 1)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 0)
+}
+
 func (s *StorageTestSuite) TestTxFilter() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -93,6 +115,29 @@ func (s *StorageTestSuite) TestTxFilter() {
 	s.Require().Len(tx.Actions, 1)
 }
 
+func (s *StorageTestSuite) TestTxStream() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	var txs []storage.Tx
+	err := s.storage.Tx.Stream(ctx, storage.TxFilter{
+		TimeFrom:    time.Date(2023, 11, 30, 23, 52, 23, 0, time.UTC),
+		Sort:        sdk.SortOrderAsc,
+		ActionTypes: types.NewActionTypeMask(types.ActionTypeSequence.String()),
+	}, func(tx storage.Tx) error {
+		txs = append(txs, tx)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+
+	tx := txs[0]
+	s.Require().EqualValues(7316, tx.Height)
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().NotNil(tx.Signer)
+	s.Require().Equal("3fff1c39b9d163bfb9bcbf9dfea78675f1b4bc2c", hex.EncodeToString(tx.Signer.Hash))
+}
+
 func (s *StorageTestSuite) TestTxByAddress() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -123,3 +168,30 @@ func (s *StorageTestSuite) TestTxByAddress() {
 	s.Require().NotNil(tx.Signer)
 	s.Require().Len(tx.Actions, 1)
 }
+
+func (s *StorageTestSuite) TestTxByIds() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.storage.Tx.ByIds(ctx, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 2)
+
+	empty, err := s.storage.Tx.ByIds(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(empty, 0)
+}
+
+func (s *StorageTestSuite) TestTxByIdWithRelations() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := s.storage.Tx.ByIdWithRelations(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().EqualValues(7316, tx.Height)
+	s.Require().NotNil(tx.Signer)
+	s.Require().Equal("3fff1c39b9d163bfb9bcbf9dfea78675f1b4bc2c", hex.EncodeToString(tx.Signer.Hash))
+	s.Require().Len(tx.Actions, 1)
+	s.Require().EqualValues(types.ActionTypeSequence, tx.Actions[0].Type)
+}
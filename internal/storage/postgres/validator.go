@@ -4,9 +4,12 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Validator -
@@ -20,3 +23,14 @@ func NewValidator(db *database.Bun) *Validator {
 		Table: postgres.NewTable[*storage.Validator](db),
 	}
 }
+
+// ByIds - returns validators with passed internal identities
+func (v *Validator) ByIds(ctx context.Context, ids ...uint64) (validators []storage.Validator, err error) {
+	if len(ids) == 0 {
+		return
+	}
+	err = v.DB().NewSelect().Model(&validators).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Watchlist -
+type Watchlist struct {
+	*postgres.Table[*storage.Watchlist]
+}
+
+// NewWatchlist -
+func NewWatchlist(db *database.Bun) *Watchlist {
+	return &Watchlist{
+		Table: postgres.NewTable[*storage.Watchlist](db),
+	}
+}
+
+// Active - returns all enabled rules
+func (w *Watchlist) Active(ctx context.Context) (rules []storage.Watchlist, err error) {
+	err = w.DB().NewSelect().
+		Model(&rules).
+		Where("enabled = true").
+		Order("id asc").
+		Scan(ctx)
+	return
+}
+
+// Delete - removes rule with all its alerts
+func (w *Watchlist) Delete(ctx context.Context, id uint64) error {
+	return w.DB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*storage.WatchlistAlert)(nil)).
+			Where("watchlist_id = ?", id).
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewDelete().
+			Model((*storage.Watchlist)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		return err
+	})
+}
+
+// SaveAlerts -
+func (w *Watchlist) SaveAlerts(ctx context.Context, alerts ...*storage.WatchlistAlert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+	_, err := w.DB().NewInsert().
+		Model(&alerts).
+		Returning("id").
+		Exec(ctx)
+	return err
+}
+
+// SetDelivered - marks alerts as delivered to webhook
+func (w *Watchlist) SetDelivered(ctx context.Context, ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := w.DB().NewUpdate().
+		Model((*storage.WatchlistAlert)(nil)).
+		Set("delivered = true").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	return err
+}
+
+// Undelivered - returns not delivered alerts of rules with webhook fired after the alert with `afterId` in the order of firing
+func (w *Watchlist) Undelivered(ctx context.Context, afterId uint64, limit int) (alerts []storage.WatchlistAlert, err error) {
+	err = w.DB().NewSelect().
+		Model(&alerts).
+		Relation("Watchlist").
+		Where("watchlist_alert.delivered = false").
+		Where("watchlist_alert.id > ?", afterId).
+		Where("watchlist.webhook_url <> ''").
+		Order("watchlist_alert.id asc").
+		Limit(limit).
+		Scan(ctx)
+	return
+}
+
+// Alert - returns alert by its internal id with its rule
+func (w *Watchlist) Alert(ctx context.Context, id uint64) (alert storage.WatchlistAlert, err error) {
+	err = w.DB().NewSelect().
+		Model(&alert).
+		Relation("Watchlist").
+		Where("watchlist_alert.id = ?", id).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Alerts - returns fired alerts of the rule
+func (w *Watchlist) Alerts(ctx context.Context, watchlistId uint64, limit, offset int, sort sdk.SortOrder) (alerts []storage.WatchlistAlert, err error) {
+	query := w.DB().NewSelect().
+		Model(&alerts).
+		Where("watchlist_id = ?", watchlistId)
+
+	query = limitScope(query, limit)
+	query = offsetScope(query, offset)
+	query = sortScope(query, "id", sort)
+	err = query.Scan(ctx)
+	return
+}
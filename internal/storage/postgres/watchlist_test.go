@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestWatchlistActive() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rules, err := s.storage.Watchlist.Active(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 1)
+
+	rule := rules[0]
+	s.Require().EqualValues(1, rule.Id)
+	s.Require().Equal("treasury", rule.Name)
+	s.Require().Equal("2e046327a2ccac7c8f8018ed44e43184b502eb3e", rule.AddressString())
+	s.Require().Equal(types.DirectionOut, rule.Direction)
+	s.Require().Equal("100", rule.Threshold.String())
+	s.Require().False(rule.IsRollup())
+}
+
+func (s *StorageTestSuite) TestWatchlistAlerts() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	alerts, err := s.storage.Watchlist.Alerts(ctx, 1, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 1)
+
+	alert := alerts[0]
+	s.Require().EqualValues(1, alert.WatchlistId)
+	s.Require().EqualValues(7965, alert.Height)
+	s.Require().Equal(types.ActionTypeTransfer, alert.ActionType)
+	s.Require().Equal("1000", alert.Amount.String())
+}
+
+func (s *StorageTestSuite) TestWatchlistAlert() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	alert, err := s.storage.Watchlist.Alert(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, alert.Id)
+	s.Require().NotNil(alert.Watchlist)
+	s.Require().Equal("treasury", alert.Watchlist.Name)
+}
+
+func (s *StorageTestSuite) TestWatchlistUndelivered() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	alerts, err := s.storage.Watchlist.Undelivered(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 1)
+
+	alert := alerts[0]
+	s.Require().EqualValues(3, alert.Id)
+	s.Require().False(alert.Delivered)
+	s.Require().NotNil(alert.Watchlist)
+	s.Require().Equal("http://localhost:8080/alerts", alert.Watchlist.WebhookUrl)
+
+	alerts, err = s.storage.Watchlist.Undelivered(ctx, 3, 10)
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 0)
+}
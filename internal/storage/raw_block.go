@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRawBlock interface {
+	storage.Table[*RawBlock]
+
+	ByHeight(ctx context.Context, height pkgTypes.Level) (RawBlock, error)
+}
+
+// RawBlock - results of `block` and `block_results` requests as they were received from the node.
+// They are stored at index time, so they stay available when the node prunes the height.
+// Bytes are compressed by the algorithm from indexer config.
+type RawBlock struct {
+	bun.BaseModel `bun:"raw_block" comment:"Table with raw blocks and block results"`
+
+	Height       pkgTypes.Level    `bun:"height,pk,notnull"                                   comment:"The number (height) of this block"`
+	Time         time.Time         `bun:"time,pk,notnull"                                     comment:"The time of block"`
+	Compression  types.Compression `bun:"compression,type:compression,notnull,default:'none'" comment:"Compression algorithm of block and block results"`
+	Block        []byte            `bun:"block,type:bytea"                                    comment:"JSON result of block request"`
+	BlockResults []byte            `bun:"block_results,type:bytea"                            comment:"JSON result of block_results request"`
+}
+
+func (RawBlock) TableName() string {
+	return "raw_block"
+}
+
+// Columns - list of columns used by COPY
+func (RawBlock) Columns() []string {
+	return []string{
+		"height", "time", "compression", "block", "block_results",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (rb RawBlock) Flat() []any {
+	return []any{
+		rb.Height, rb.Time, rb.Compression, rb.Block, rb.BlockResults,
+	}
+}
+
+// Decompressed - returns decompressed block and block results
+func (rb RawBlock) Decompressed() (block []byte, results []byte, err error) {
+	block, err = rb.Compression.Decompress(rb.Block)
+	if err != nil {
+		return
+	}
+	results, err = rb.Compression.Decompress(rb.BlockResults)
+	return
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRawTx interface {
+	storage.Table[*RawTx]
+
+	ByTxId(ctx context.Context, txId uint64) (RawTx, error)
+}
+
+// RawTx - signed transaction bytes as they were included in the block. They are kept apart from `tx` table
+// because sequence transactions may be large. Bytes are compressed by the algorithm from indexer config.
+type RawTx struct {
+	bun.BaseModel `bun:"raw_tx" comment:"Table with raw signed transactions"`
+
+	Id          uint64            `bun:"id,pk,notnull"                                       comment:"Internal identity of the transaction"`
+	Height      pkgTypes.Level    `bun:",notnull"                                            comment:"The number (height) of this block"`
+	Time        time.Time         `bun:"time,pk,notnull"                                     comment:"The time of block"`
+	Compression types.Compression `bun:"compression,type:compression,notnull,default:'none'" comment:"Compression algorithm of data"`
+	Data        []byte            `bun:"data,type:bytea"                                     comment:"Encoded SignedTransaction"`
+}
+
+func (RawTx) TableName() string {
+	return "raw_tx"
+}
+
+// Columns - list of columns used by COPY
+func (RawTx) Columns() []string {
+	return []string{
+		"id", "height", "time", "compression", "data",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (rt RawTx) Flat() []any {
+	return []any{
+		rt.Id, rt.Height, rt.Time, rt.Compression, rt.Data,
+	}
+}
+
+// Decompressed - returns decompressed SignedTransaction bytes
+func (rt RawTx) Decompressed() ([]byte, error) {
+	return rt.Compression.Decompress(rt.Data)
+}
@@ -38,7 +38,8 @@ type Rollup struct {
 	Size            int64       `bun:"size"                        comment:"Count bytes which was saved in the rollup"`
 	BridgeAddressId uint64      `bun:"bridge_address_id"           comment:"Address id associated with rollup"`
 
-	BridgeAddress *Address `bun:"rel:has-one,join:bridge_address_id=id"`
+	BridgeAddress *Address        `bun:"rel:has-one,join:bridge_address_id=id"`
+	Metadata      *RollupMetadata `bun:"rel:has-one,join:astria_id=rollup_id"`
 }
 
 // TableName -
@@ -55,4 +56,6 @@ type RollupListFilter struct {
 	Offset    int
 	SortField string
 	SortOrder sdk.SortOrder
+	VmType    []string
+	Name      string
 }
@@ -19,12 +19,28 @@ type RollupAction struct {
 	Height   types.Level `bun:"height"          comment:"Action block height"`
 	TxId     uint64      `bun:"tx_id"           comment:"Transaction internal id"`
 	Size     int64       `bun:"size"            comment:"Count bytes which was pushed to the rollup"`
+	SenderId uint64      `bun:"sender_id"       comment:"Internal id of address which sent the action"`
 
-	Action *Action `bun:"rel:belongs-to,join:action_id=id"`
-	Rollup *Rollup `bun:"rel:belongs-to,join:rollup_id=id"`
-	Tx     *Tx     `bun:"rel:belongs-to,join:tx_id=id"`
+	Action *Action  `bun:"rel:belongs-to,join:action_id=id"`
+	Rollup *Rollup  `bun:"rel:belongs-to,join:rollup_id=id"`
+	Tx     *Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+	Sender *Address `bun:"rel:belongs-to,join:sender_id=id"`
 }
 
 func (RollupAction) TableName() string {
 	return "rollup_action"
 }
+
+// Columns - list of columns used by COPY
+func (RollupAction) Columns() []string {
+	return []string{
+		"rollup_id", "action_id", "time", "height", "tx_id", "size", "sender_id",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (ra RollupAction) Flat() []any {
+	return []any{
+		ra.RollupId, ra.ActionId, ra.Time, ra.Height, ra.TxId, ra.Size, ra.SenderId,
+	}
+}
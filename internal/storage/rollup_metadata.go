@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRollupMetadata interface {
+	storage.Table[*RollupMetadata]
+
+	ByRollupId(ctx context.Context, rollupId []byte) (RollupMetadata, error)
+	Upsert(ctx context.Context, metadata *RollupMetadata) error
+	ApplyRegistry(ctx context.Context, metadata ...*RollupMetadata) (int64, error)
+	DeleteByRollupId(ctx context.Context, rollupId []byte) error
+}
+
+// RollupMetadata - human-readable information about rollup. It's loaded from the registry file or edited by administrator.
+type RollupMetadata struct {
+	bun.BaseModel `bun:"rollup_metadata" comment:"Table with rollup metadata"`
+
+	Id          uint64    `bun:"id,pk,notnull,autoincrement"                  comment:"Unique internal identity"`
+	CreatedAt   time.Time `bun:"created_at,notnull"                           comment:"Creation time"`
+	UpdatedAt   time.Time `bun:"updated_at,notnull"                           comment:"Time of the last update"`
+	RollupId    []byte    `bun:"rollup_id,notnull,unique:rollup_metadata_id"  comment:"Astria rollup identity"`
+	Version     uint64    `bun:"version,notnull,default:0"                    comment:"Version of the registry which the metadata was loaded from. Zero for metadata created by administrator"`
+	Name        string    `bun:"name,type:text,notnull"                       comment:"Rollup name"`
+	Slug        string    `bun:"slug,notnull,unique:rollup_metadata_slug"     comment:"Unique URL-friendly rollup name"`
+	Website     string    `bun:"website,type:text"                            comment:"Rollup website"`
+	Logo        string    `bun:"logo,type:text"                               comment:"URL of rollup logo"`
+	Description string    `bun:"description,type:text"                        comment:"Rollup description"`
+	VmType      string    `bun:"vm_type"                                      comment:"Type of rollup virtual machine"`
+	Contact     string    `bun:"contact,type:text"                            comment:"Contact of rollup team"`
+}
+
+// TableName -
+func (RollupMetadata) TableName() string {
+	return "rollup_metadata"
+}
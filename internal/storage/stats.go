@@ -7,17 +7,23 @@ import (
 	"context"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/shopspring/decimal"
 )
 
 type Timeframe string
 
 const (
-	TimeframeHour  Timeframe = "hour"
-	TimeframeDay   Timeframe = "day"
-	TimeframeMonth Timeframe = "month"
+	TimeframeMinute Timeframe = "minute"
+	TimeframeHour   Timeframe = "hour"
+	TimeframeDay    Timeframe = "day"
+	TimeframeWeek   Timeframe = "week"
+	TimeframeMonth  Timeframe = "month"
 )
 
+// MaxSeriesPoints - maximum count of points returned by series. Longer ranges are downsampled.
+const MaxSeriesPoints = 1000
+
 type TPS struct {
 	Low               float64
 	High              float64
@@ -34,6 +40,10 @@ type TxCountForLast24hItem struct {
 type SeriesRequest struct {
 	From time.Time
 	To   time.Time
+
+	// Points - requested count of points. If range contains more buckets than points, buckets are merged.
+	// It's applied only if `From` or `To` is set.
+	Points int
 }
 
 func NewSeriesRequest(from, to int64) (sr SeriesRequest) {
@@ -95,9 +105,73 @@ type RollupSummary struct {
 	MaxSize      int64 `bun:"max_size"`
 }
 
+// ActionSeriesItem - count of actions of the type and amount transferred by them during the time bucket. Volume is zero for actions without value.
+// Min and max are extremes of merged buckets.
+type ActionSeriesItem struct {
+	Time      time.Time       `bun:"ts"`
+	Count     int64           `bun:"actions_count"`
+	Volume    decimal.Decimal `bun:"volume"`
+	MaxCount  int64           `bun:"max_count"`
+	MinCount  int64           `bun:"min_count"`
+	MaxVolume decimal.Decimal `bun:"max_volume"`
+	MinVolume decimal.Decimal `bun:"min_volume"`
+}
+
+// HolderStats - distribution of the asset between holders
+type HolderStats struct {
+	Currency     string
+	HoldersCount int64
+	Supply       decimal.Decimal
+	Gini         float64
+	Top          []Address
+	Buckets      []HolderBucket
+}
+
+// ActiveAddressesItem - count of addresses which signed transactions or received funds during the day
+type ActiveAddressesItem struct {
+	Time      time.Time `bun:"ts"`
+	Active    int64     `bun:"active"`
+	Signers   int64     `bun:"signers"`
+	Receivers int64     `bun:"receivers"`
+}
+
+// metrics of rollup leaderboard
+const (
+	TopRollupsBySize    = "size"
+	TopRollupsByActions = "actions"
+	TopRollupsBySigners = "signers"
+)
+
+// TopRollupsRequest - parameters of rollup leaderboard. Statistics is computed for window ended at `To` and compared with the previous window of the same length.
+// Zero `To` means the current time.
+type TopRollupsRequest struct {
+	Window time.Duration
+	By     string
+	Limit  int
+	To     time.Time
+}
+
+// TopRollupItem - rollup statistics in the window and in the previous one
+type TopRollupItem struct {
+	RollupId         uint64  `bun:"rollup_id"`
+	Size             int64   `bun:"size"`
+	ActionsCount     int64   `bun:"actions_count"`
+	Signers          int64   `bun:"signers"`
+	SizeShare        float64 `bun:"size_share"`
+	PrevSize         int64   `bun:"prev_size"`
+	PrevActionsCount int64   `bun:"prev_actions_count"`
+	PrevSigners      int64   `bun:"prev_signers"`
+
+	Rollup *Rollup `bun:"-"`
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IStats interface {
 	Summary(ctx context.Context) (NetworkSummary, error)
 	Series(ctx context.Context, timeframe Timeframe, name string, req SeriesRequest) ([]SeriesItem, error)
 	RollupSeries(ctx context.Context, rollupId uint64, timeframe Timeframe, name string, req SeriesRequest) ([]SeriesItem, error)
+	ActionSeries(ctx context.Context, timeframe Timeframe, actionType types.ActionType, req SeriesRequest) ([]ActionSeriesItem, error)
+	Holders(ctx context.Context, currency string, limit int) (HolderStats, error)
+	ActiveAddresses(ctx context.Context, req SeriesRequest) ([]ActiveAddressesItem, error)
+	TopRollups(ctx context.Context, req TopRollupsRequest) ([]TopRollupItem, error)
 }
@@ -20,14 +20,19 @@ type ITx interface {
 
 	ByHash(ctx context.Context, hash []byte) (Tx, error)
 	ByHeight(ctx context.Context, height pkgTypes.Level, limit, offset int) ([]Tx, error)
+	ByHeights(ctx context.Context, heights []pkgTypes.Level, limit, offset int) ([]Tx, error)
 	ByAddress(ctx context.Context, addressId uint64, fltrs TxFilter) ([]Tx, error)
 	Filter(ctx context.Context, fltrs TxFilter) ([]Tx, error)
+	Stream(ctx context.Context, fltrs TxFilter, fn func(Tx) error) error
+	ByIds(ctx context.Context, ids ...uint64) ([]Tx, error)
+	ByIdWithRelations(ctx context.Context, id uint64) (Tx, error)
 }
 
 type TxFilter struct {
 	Limit       int
 	Offset      int
 	Sort        storage.SortOrder
+	Cursor      Cursor
 	Status      []string
 	ActionTypes types.ActionTypeMask
 	Height      uint64
@@ -60,9 +65,28 @@ type Tx struct {
 	Actions   []Action `bun:"rel:has-many,join:id=tx_id"`
 	Signer    *Address `bun:"rel:belongs-to"`
 	BytesSize int64    `bun:"-"`
+	Raw       *RawTx   `bun:"-"`
 }
 
 // TableName -
 func (Tx) TableName() string {
 	return "tx"
 }
+
+// Columns - list of columns used by COPY
+func (Tx) Columns() []string {
+	return []string{
+		"id", "height", "time", "position", "gas_wanted", "gas_used",
+		"actions_count", "status", "error", "codespace", "signer_id",
+		"action_types", "nonce", "hash", "signature",
+	}
+}
+
+// Flat - values of columns returned by Columns
+func (tx Tx) Flat() []any {
+	return []any{
+		tx.Id, tx.Height, tx.Time, tx.Position, tx.GasWanted, tx.GasUsed,
+		tx.ActionsCount, tx.Status, tx.Error, tx.Codespace, tx.SignerId,
+		tx.ActionTypes, tx.Nonce, tx.Hash, tx.Signature,
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// swagger:enum Compression
+/*
+	ENUM(
+		none,
+		gzip
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type Compression string
+
+// Compress - compresses data with the algorithm. Data is returned as is for `none` or empty compression.
+func (x Compression) Compress(data []byte) ([]byte, error) {
+	if x != CompressionGzip || len(data) == 0 {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress - returns data compressed by Compress
+func (x Compression) Decompress(data []byte) ([]byte, error) {
+	if x != CompressionGzip || len(data) == 0 {
+		return data, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
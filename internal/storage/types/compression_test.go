@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("astria"), 100)
+
+	for _, c := range []Compression{"", CompressionNone, CompressionGzip} {
+		t.Run(c.String(), func(t *testing.T) {
+			compressed, err := c.Compress(data)
+			require.NoError(t, err)
+			if c == CompressionGzip {
+				require.Less(t, len(compressed), len(data))
+			} else {
+				require.Equal(t, data, compressed)
+			}
+
+			decompressed, err := c.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+		})
+	}
+}
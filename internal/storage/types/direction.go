@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum Direction
+/*
+	ENUM(
+		any,
+		in,
+		out
+	)
+*/
+//go:generate go-enum --marshal --sql --values --names
+type Direction string
@@ -4,6 +4,8 @@
 package storage
 
 import (
+	"context"
+
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -14,6 +16,8 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IValidator interface {
 	storage.Table[*Validator]
+
+	ByIds(ctx context.Context, ids ...uint64) ([]Validator, error)
 }
 
 type Validator struct {
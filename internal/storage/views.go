@@ -4,10 +4,22 @@
 package storage
 
 const (
-	ViewBlockStatsByHour   = "block_stats_by_hour"
-	ViewBlockStatsByDay    = "block_stats_by_day"
-	ViewBlockStatsByMonth  = "block_stats_by_month"
-	ViewRollupStatsByHour  = "rollup_stats_by_hour"
-	ViewRollupStatsByDay   = "rollup_stats_by_day"
-	ViewRollupStatsByMonth = "rollup_stats_by_month"
+	ViewBlockStatsByMinute   = "block_stats_by_minute"
+	ViewBlockStatsByHour     = "block_stats_by_hour"
+	ViewBlockStatsByDay      = "block_stats_by_day"
+	ViewBlockStatsByMonth    = "block_stats_by_month"
+	ViewRollupStatsByHour    = "rollup_stats_by_hour"
+	ViewRollupStatsByDay     = "rollup_stats_by_day"
+	ViewRollupStatsByMonth   = "rollup_stats_by_month"
+	ViewBalanceUpdateByHour  = "balance_update_by_hour"
+	ViewBalanceUpdateByDay   = "balance_update_by_day"
+	ViewBalanceUpdateByMonth = "balance_update_by_month"
+	ViewSignerByDay          = "signer_by_day"
+	ViewReceiverByDay        = "receiver_by_day"
+	ViewRollupSenderByHour   = "rollup_sender_by_hour"
+	ViewRollupSenderByDay    = "rollup_sender_by_day"
+	ViewActionStatsByMinute  = "action_stats_by_minute"
+	ViewActionStatsByHour    = "action_stats_by_hour"
+	ViewActionStatsByDay     = "action_stats_by_day"
+	ViewActionStatsByMonth   = "action_stats_by_month"
 )
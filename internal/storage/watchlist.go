@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IWatchlist interface {
+	storage.Table[*Watchlist]
+
+	Active(ctx context.Context) ([]Watchlist, error)
+	Delete(ctx context.Context, id uint64) error
+	SaveAlerts(ctx context.Context, alerts ...*WatchlistAlert) error
+	SetDelivered(ctx context.Context, ids ...uint64) error
+	Undelivered(ctx context.Context, afterId uint64, limit int) ([]WatchlistAlert, error)
+	Alert(ctx context.Context, id uint64) (WatchlistAlert, error)
+	Alerts(ctx context.Context, watchlistId uint64, limit, offset int, sort storage.SortOrder) ([]WatchlistAlert, error)
+}
+
+// Watchlist - rule which describes activity of address or rollup to be notified about
+type Watchlist struct {
+	bun.BaseModel `bun:"watchlist" comment:"Table with watchlist rules"`
+
+	Id          uint64          `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	CreatedAt   time.Time       `bun:"created_at,notnull"           comment:"Creation time"`
+	UpdatedAt   time.Time       `bun:"updated_at,notnull"           comment:"Time of the last update"`
+	Name        string          `bun:"name,type:text"               comment:"Human-readable name of the rule"`
+	Address     []byte          `bun:"address"                      comment:"Watched address hash"`
+	RollupId    []byte          `bun:"rollup_id"                    comment:"Watched rollup id"`
+	ActionTypes types.Bits      `bun:"action_types"                 comment:"Bit mask for action types which should be matched. Zero matches any action"`
+	Threshold   decimal.Decimal `bun:"threshold,type:numeric"       comment:"Minimal amount of matched action"`
+	Currency    string          `bun:"currency"                     comment:"Currency of balance updates. Empty matches any currency"`
+	Direction   types.Direction `bun:"direction,type:direction"     comment:"Direction of funds movement relative to watched address"`
+	WebhookUrl  string          `bun:"webhook_url,type:text"        comment:"Webhook which receives alerts"`
+	Secret      string          `bun:"secret,type:text"             comment:"Secret for signing webhook requests"`
+	Enabled     bool            `bun:"enabled,notnull,default:true" comment:"Rule is evaluated by indexer"`
+}
+
+// TableName -
+func (Watchlist) TableName() string {
+	return "watchlist"
+}
+
+// AddressString - returns hex-encoded hash of watched address
+func (w Watchlist) AddressString() string {
+	return hex.EncodeToString(w.Address)
+}
+
+// IsRollup - returns true if rule watches rollup instead of address
+func (w Watchlist) IsRollup() bool {
+	return len(w.RollupId) > 0
+}
+
+// WatchlistAlert - activity which matched watchlist rule
+type WatchlistAlert struct {
+	bun.BaseModel `bun:"watchlist_alert" comment:"Table with fired watchlist alerts"`
+
+	Id          uint64           `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	WatchlistId uint64           `bun:"watchlist_id,notnull"         comment:"Watchlist internal identity"`
+	Height      pkgTypes.Level   `bun:"height,notnull"               comment:"Block height of matched action"`
+	Time        time.Time        `bun:"time,notnull"                 comment:"Block time of matched action"`
+	TxHash      []byte           `bun:"tx_hash"                      comment:"Hash of transaction contained matched action"`
+	Position    int64            `bun:"position"                     comment:"Position of action in transaction"`
+	ActionType  types.ActionType `bun:"action_type,type:action_type" comment:"Matched action type"`
+	Amount      decimal.Decimal  `bun:"amount,type:numeric"          comment:"Amount of matched action"`
+	Currency    string           `bun:"currency"                     comment:"Currency of amount"`
+	Direction   types.Direction  `bun:"direction,type:direction"     comment:"Direction of funds movement"`
+	Delivered   bool             `bun:"delivered"                    comment:"Alert was delivered to webhook"`
+
+	Watchlist *Watchlist `bun:"rel:belongs-to,join:watchlist_id=id"`
+}
+
+// TableName -
+func (WatchlistAlert) TableName() string {
+	return "watchlist_alert"
+}
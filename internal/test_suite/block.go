@@ -81,3 +81,28 @@ func CreateBlockWithTxs(tx types.ResponseDeliverTx, txData []byte, count int) (t
 
 	return block, now
 }
+
+// RecordedBlocks - returns sequence of blocks starting from `startHeight` which contain recorded transactions
+func RecordedBlocks(startHeight types.Level, count, txsPerBlock int) []types.BlockData {
+	raw, _ := base64.StdEncoding.DecodeString(txs[2])
+
+	blocks := make([]types.BlockData, count)
+	start := time.Now()
+	for i := range blocks {
+		block, _ := CreateBlockWithTxs(types.ResponseDeliverTx{
+			Log:       "[]",
+			GasWanted: 1000,
+			GasUsed:   1000,
+		}, raw, txsPerBlock)
+
+		height := startHeight + types.Level(i)
+		block.Height = height
+		block.Block.Height = int64(height)
+		block.Block.Time = start.Add(time.Duration(i) * time.Second)
+		block.Block.LastCommit = &types.Commit{
+			Height: int64(height - 1),
+		}
+		blocks[i] = block
+	}
+	return blocks
+}
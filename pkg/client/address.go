@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+func addressPath(hash string, parts ...string) string {
+	return joinPath(append([]string{"address", hash}, parts...)...)
+}
+
+// Addresses - returns list of addresses
+func (c *Client) Addresses(ctx context.Context, req ListRequest) (addresses []responses.Address, err error) {
+	err = c.get(ctx, "address", req.values(), &addresses)
+	return
+}
+
+// AddressesIterator - returns iterator over all addresses
+func (c *Client) AddressesIterator(req ListRequest) *Iterator[responses.Address] {
+	return newOffsetIterator(req, c.Addresses)
+}
+
+// AddressCount - returns count of addresses
+func (c *Client) AddressCount(ctx context.Context) (count uint64, err error) {
+	err = c.get(ctx, "address/count", nil, &count)
+	return
+}
+
+// Address - returns address by hash
+func (c *Client) Address(ctx context.Context, hash string) (address responses.Address, err error) {
+	err = c.get(ctx, addressPath(hash), nil, &address)
+	return
+}
+
+// AddressBalance - returns current balances of the address in every currency
+func (c *Client) AddressBalance(ctx context.Context, hash string) (balances []responses.Balance, err error) {
+	err = c.get(ctx, addressPath(hash, "balance"), nil, &balances)
+	return
+}
+
+// AddressBalanceAtHeight - returns balances of the address after the block with the height
+func (c *Client) AddressBalanceAtHeight(ctx context.Context, hash string, height uint64) (balances []responses.Balance, err error) {
+	values := make(url.Values)
+	values.Set("height", strconv.FormatUint(height, 10))
+	err = c.get(ctx, addressPath(hash, "balance"), values, &balances)
+	return
+}
+
+// AddressBalanceAtTime - returns balances of the address at the moment
+func (c *Client) AddressBalanceAtTime(ctx context.Context, hash string, t time.Time) (balances []responses.Balance, err error) {
+	values := make(url.Values)
+	values.Set("time", strconv.FormatInt(t.Unix(), 10))
+	err = c.get(ctx, addressPath(hash, "balance"), values, &balances)
+	return
+}
+
+// AddressBalanceSeries - returns balance of the address in the currency by `hour`, `day`, `week` or `month`. Empty currency means the native one.
+func (c *Client) AddressBalanceSeries(ctx context.Context, hash, timeframe, currency string, period TimeRange) (series []responses.BalanceSeriesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	setString(values, "currency", currency)
+	err = c.get(ctx, addressPath(hash, "balance", "series", timeframe), values, &series)
+	return
+}
+
+// AddressTxs - returns transactions of the address
+func (c *Client) AddressTxs(ctx context.Context, hash string, req AddressTxsRequest) (txs []responses.Tx, err error) {
+	err = c.get(ctx, addressPath(hash, "txs"), req.values(), &txs)
+	return
+}
+
+// AddressActions - returns actions of the address
+func (c *Client) AddressActions(ctx context.Context, hash string, req AddressActionsRequest) (actions []responses.Action, err error) {
+	err = c.get(ctx, addressPath(hash, "actions"), req.values(), &actions)
+	return
+}
+
+// AddressActionsPage - returns page of the address actions with keyset pagination. Empty cursor means the first page.
+func (c *Client) AddressActionsPage(ctx context.Context, hash string, req AddressActionsRequest, cursor string) (page responses.Page[responses.Action], err error) {
+	values := req.values()
+	values.Set(cursorParam, cursor)
+	err = c.get(ctx, addressPath(hash, "actions"), values, &page)
+	return
+}
+
+// AddressActionsIterator - returns iterator over all actions of the address matching the request
+func (c *Client) AddressActionsIterator(hash string, req AddressActionsRequest) *Iterator[responses.Action] {
+	return newCursorIterator(func(ctx context.Context, cursor string) (responses.Page[responses.Action], error) {
+		return c.AddressActionsPage(ctx, hash, req, cursor)
+	})
+}
+
+// AddressRollups - returns rollups which the address sent data to
+func (c *Client) AddressRollups(ctx context.Context, hash string, req ListRequest) (rollups []responses.Rollup, err error) {
+	err = c.get(ctx, addressPath(hash, "rollups"), req.values(), &rollups)
+	return
+}
+
+// ExportAddressActions - writes actions of the address matching the request to writer in requested format
+func (c *Client) ExportAddressActions(ctx context.Context, hash string, req ExportRequest, w io.Writer) error {
+	return c.stream(ctx, addressPath(hash, "export"), req.values(), w)
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+func blockPath(height types.Level, parts ...string) string {
+	return joinPath(append([]string{"block", strconv.FormatInt(int64(height), 10)}, parts...)...)
+}
+
+// Blocks - returns list of blocks
+func (c *Client) Blocks(ctx context.Context, req BlockListRequest) (blocks []responses.Block, err error) {
+	err = c.get(ctx, "block", req.values(), &blocks)
+	return
+}
+
+// BlocksPage - returns page of blocks with keyset pagination. Empty cursor means the first page.
+func (c *Client) BlocksPage(ctx context.Context, req BlockListRequest, cursor string) (page responses.Page[responses.Block], err error) {
+	values := req.values()
+	values.Set(cursorParam, cursor)
+	err = c.get(ctx, "block", values, &page)
+	return
+}
+
+// BlocksIterator - returns iterator over all blocks matching the request
+func (c *Client) BlocksIterator(req BlockListRequest) *Iterator[responses.Block] {
+	return newCursorIterator(func(ctx context.Context, cursor string) (responses.Page[responses.Block], error) {
+		return c.BlocksPage(ctx, req, cursor)
+	})
+}
+
+// BlockCount - returns count of blocks
+func (c *Client) BlockCount(ctx context.Context) (count uint64, err error) {
+	err = c.get(ctx, "block/count", nil, &count)
+	return
+}
+
+// Block - returns block by height. ErrNotFound is returned if block is not indexed yet.
+func (c *Client) Block(ctx context.Context, height types.Level, stats bool) (block responses.Block, err error) {
+	values := make(url.Values)
+	setBool(values, "stats", stats)
+	err = c.get(ctx, blockPath(height), values, &block)
+	return
+}
+
+// BlockActions - returns actions of the block
+func (c *Client) BlockActions(ctx context.Context, height types.Level, req ListRequest) (actions []responses.Action, err error) {
+	err = c.get(ctx, blockPath(height, "actions"), req.values(), &actions)
+	return
+}
+
+// BlockTxs - returns transactions of the block
+func (c *Client) BlockTxs(ctx context.Context, height types.Level, req ListRequest) (txs []responses.Tx, err error) {
+	err = c.get(ctx, blockPath(height, "txs"), req.values(), &txs)
+	return
+}
+
+// BlockStats - returns statistics of the block
+func (c *Client) BlockStats(ctx context.Context, height types.Level) (stats responses.BlockStats, err error) {
+	err = c.get(ctx, blockPath(height, "stats"), nil, &stats)
+	return
+}
+
+// BlockRollupActions - returns rollup actions of the block
+func (c *Client) BlockRollupActions(ctx context.Context, height types.Level, req ListRequest) (actions []responses.RollupAction, err error) {
+	err = c.get(ctx, blockPath(height, "rollup_actions"), req.values(), &actions)
+	return
+}
+
+// BlockRollupActionsCount - returns count of rollup actions of the block
+func (c *Client) BlockRollupActionsCount(ctx context.Context, height types.Level) (count int64, err error) {
+	err = c.get(ctx, blockPath(height, "rollup_actions", "count"), nil, &count)
+	return
+}
+
+// BlockRaw - returns CometBFT block and block results as they are returned by the node
+func (c *Client) BlockRaw(ctx context.Context, height types.Level) (block responses.RawBlock, err error) {
+	err = c.get(ctx, blockPath(height, "raw"), nil, &block)
+	return
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Package client - typed client of the Astria indexer REST and websocket API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+const (
+	userAgent = "Astria Indexer Go Client"
+
+	headerApiKey     = "X-API-Key"
+	headerRetryAfter = "Retry-After"
+
+	defaultTimeout       = 30 * time.Second
+	defaultMaxRetries    = 3
+	defaultRetryDelay    = 500 * time.Millisecond
+	defaultMaxRetryDelay = 10 * time.Second
+)
+
+// ErrNotFound - returned when the API has no requested entity
+var ErrNotFound = errors.New("not found")
+
+// Error - unsuccessful response of the API
+type Error struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return "api error: " + http.StatusText(e.StatusCode)
+	}
+	return "api error: " + e.Message
+}
+
+type Config struct {
+	// BaseURL - address of the API without version, for example `https://api.astrotrek.io`
+	BaseURL string
+	// ApiKey - key which is sent in `X-API-Key` header. Optional.
+	ApiKey string
+	// Timeout - timeout of one request attempt
+	Timeout time.Duration
+	// MaxRetries - count of retries of failed idempotent requests. Negative value disables retries.
+	MaxRetries int
+	// RetryDelay - delay before the first retry. It doubles on every next attempt.
+	RetryDelay time.Duration
+	// MaxRetryDelay - upper bound of delay between retries
+	MaxRetryDelay time.Duration
+	// HttpClient - custom HTTP client. Timeout is ignored if it's set.
+	HttpClient *http.Client
+}
+
+// Client - client of the API. It's safe for concurrent use.
+type Client struct {
+	cfg     Config
+	baseURL *url.URL
+	http    *http.Client
+}
+
+// NewClient -
+func NewClient(cfg Config) (*Client, error) {
+	baseURL, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing base url")
+	}
+	if baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, errors.Errorf("invalid base url: %s", cfg.BaseURL)
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = defaultRetryDelay
+	}
+	if cfg.MaxRetryDelay <= 0 {
+		cfg.MaxRetryDelay = defaultMaxRetryDelay
+	}
+
+	httpClient := cfg.HttpClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: cfg.Timeout,
+		}
+	}
+
+	return &Client{
+		cfg:     cfg,
+		baseURL: baseURL,
+		http:    httpClient,
+	}, nil
+}
+
+func (c *Client) url(path string, query url.Values) (string, error) {
+	u := *c.baseURL
+	u.Path = joinPath(strings.TrimSuffix(u.Path, "/"), "v1", path)
+	u.RawPath = ""
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return u.String(), nil
+}
+
+// joinPath - joins unescaped path segments
+func joinPath(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, output any) error {
+	return c.do(ctx, http.MethodGet, path, query, nil, output)
+}
+
+// do - sends request and decodes JSON response to output. `204 No Content` is returned as ErrNotFound.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, output any) error {
+	response, err := c.send(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNoContent {
+		if method == http.MethodDelete {
+			return nil
+		}
+		return ErrNotFound
+	}
+	if output == nil {
+		return nil
+	}
+	if err := json.NewDecoder(response.Body).Decode(output); err != nil {
+		return errors.Wrap(err, "decoding response")
+	}
+	return nil
+}
+
+// stream - sends GET request and copies response body to writer as is
+func (c *Client) stream(ctx context.Context, path string, query url.Values, w io.Writer) error {
+	response, err := c.send(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// send - sends request with retries. Only idempotent requests are retried after network errors and
+// `429`, `502`, `503`, `504` statuses. Response body must be closed by the caller.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	link, err := c.url(path, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "building url")
+	}
+
+	var data []byte
+	if body != nil {
+		data, err = json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "encoding request")
+		}
+	}
+
+	retries := 0
+	if method != http.MethodPost && c.cfg.MaxRetries > 0 {
+		retries = c.cfg.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		response, err := c.attempt(ctx, method, link, data)
+		if err == nil && response.StatusCode < http.StatusBadRequest {
+			return response, nil
+		}
+
+		var delay time.Duration
+		if err == nil {
+			err = decodeError(response)
+			delay = retryAfter(response)
+			response.Body.Close()
+			if !isRetryable(response.StatusCode) {
+				return nil, err
+			}
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if attempt >= retries {
+			return nil, err
+		}
+
+		if delay == 0 {
+			delay = c.backoff(attempt)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, method, link string, data []byte) (*http.Response, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, link, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/json")
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.cfg.ApiKey != "" {
+		req.Header.Set(headerApiKey, c.cfg.ApiKey)
+	}
+	return c.http.Do(req)
+}
+
+// backoff - returns exponential delay with jitter for the attempt
+func (c *Client) backoff(attempt int) time.Duration {
+	return backoff(c.cfg.RetryDelay, c.cfg.MaxRetryDelay, attempt)
+}
+
+func backoff(base, max time.Duration, attempt int) time.Duration {
+	delay := base << attempt
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+	// full jitter in range [delay/2, delay)
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func isRetryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryAfter(response *http.Response) time.Duration {
+	value := response.Header.Get(headerRetryAfter)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
+
+func decodeError(response *http.Response) error {
+	apiErr := &Error{
+		StatusCode: response.StatusCode,
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, 1024*1024))
+	if err != nil {
+		return apiErr
+	}
+	if err := json.Unmarshal(data, apiErr); err != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	return apiErr
+}
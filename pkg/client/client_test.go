@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(Config{
+		BaseURL:       server.URL,
+		ApiKey:        "secret",
+		RetryDelay:    time.Millisecond,
+		MaxRetryDelay: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(Config{BaseURL: "localhost"})
+	require.Error(t, err)
+
+	c, err := NewClient(Config{BaseURL: "https://api.astrotrek.io/api/"})
+	require.NoError(t, err)
+
+	link, err := c.url(blockPath(100, "txs"), ListRequest{Limit: 10}.values())
+	require.NoError(t, err)
+	require.Equal(t, "https://api.astrotrek.io/api/v1/block/100/txs?limit=10", link)
+}
+
+func TestClient_Get(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/v1/tx", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get(headerApiKey))
+
+		query := r.URL.Query()
+		require.Equal(t, "5", query.Get("limit"))
+		require.Equal(t, "desc", query.Get("sort"))
+		require.Equal(t, "success,failed", query.Get("status"))
+		require.Equal(t, "transfer", query.Get("action_types"))
+		require.Equal(t, "1692892095", query.Get("from"))
+		require.False(t, query.Has("to"))
+		require.False(t, query.Has("cursor"))
+
+		writeJSON(t, w, http.StatusOK, []responses.Tx{{Id: 1, Height: 100, Status: types.StatusSuccess}})
+	})
+
+	txs, err := c.Txs(context.Background(), TxListRequest{
+		ListRequest: ListRequest{Limit: 5, Sort: SortDesc},
+		TimeRange:   TimeRange{From: time.Unix(1692892095, 0)},
+		Status:      []string{"success", "failed"},
+		ActionTypes: []string{"transfer"},
+	})
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.EqualValues(t, 100, txs[0].Height)
+}
+
+func TestClient_NotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/block/100", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("stats"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.Block(context.Background(), 100, true)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClient_Retry(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			writeJSON(t, w, http.StatusServiceUnavailable, echoError("unavailable"))
+		case 2:
+			w.Header().Set(headerRetryAfter, "0")
+			writeJSON(t, w, http.StatusTooManyRequests, echoError("too many requests"))
+		default:
+			writeJSON(t, w, http.StatusOK, responses.State{LastHeight: 100})
+		}
+	})
+
+	state, err := c.Head(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 100, state.LastHeight)
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestClient_RetryExhausted(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeJSON(t, w, http.StatusBadGateway, echoError("bad gateway"))
+	})
+
+	_, err := c.BlockCount(context.Background())
+	require.Error(t, err)
+
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Equal(t, "bad gateway", apiErr.Message)
+	require.EqualValues(t, defaultMaxRetries+1, calls.Load())
+}
+
+func TestClient_NoRetry(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.Method {
+		case http.MethodPost:
+			writeJSON(t, w, http.StatusServiceUnavailable, echoError("unavailable"))
+		default:
+			writeJSON(t, w, http.StatusBadRequest, echoError("invalid hash"))
+		}
+	})
+
+	_, err := c.Tx(context.Background(), "invalid")
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	require.EqualValues(t, 1, calls.Load(), "client errors are not retried")
+
+	_, err = c.CreateWatchlist(context.Background(), WatchlistRequest{Name: "test"})
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	require.EqualValues(t, 2, calls.Load(), "post requests are not retried")
+}
+
+func TestClient_ContextCancel(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRetryAfter, "60")
+		writeJSON(t, w, http.StatusTooManyRequests, echoError("too many requests"))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Head(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_Export(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/rollup/O0Ia-lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=/actions/export", r.URL.Path)
+		require.Equal(t, "ndjson", r.URL.Query().Get("format"))
+		_, _ = w.Write([]byte("{\"id\":1}\n{\"id\":2}\n"))
+	})
+
+	var buf bytes.Buffer
+	err := c.ExportRollupActions(context.Background(), "O0Ia-lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=", ExportRequest{Format: "ndjson"}, &buf)
+	require.NoError(t, err)
+	require.Equal(t, "{\"id\":1}\n{\"id\":2}\n", buf.String())
+}
+
+func TestClient_TxRaw(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/tx/aa/raw":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte{0x0a, 0x01, 0x02})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	raw, err := c.TxRaw(context.Background(), "aa")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, 0x01, 0x02}, raw)
+
+	_, err = c.TxRaw(context.Background(), "bb")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClient_Watchlist(t *testing.T) {
+	enabled := true
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			require.Equal(t, "/v1/watchlists/3", r.URL.Path)
+			var req WatchlistRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "astria1address", req.Address)
+			require.NotNil(t, req.Enabled)
+			writeJSON(t, w, http.StatusOK, responses.Watchlist{Id: 3, Address: req.Address})
+		case http.MethodDelete:
+			require.Equal(t, "/v1/watchlists/3", r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	watchlist, err := c.UpdateWatchlist(context.Background(), 3, WatchlistRequest{
+		Address: "astria1address",
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, watchlist.Id)
+
+	require.NoError(t, c.DeleteWatchlist(context.Background(), 3))
+}
+
+func echoError(message string) map[string]string {
+	return map[string]string{"message": message}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Head - returns current state of the indexer
+func (c *Client) Head(ctx context.Context) (state responses.State, err error) {
+	err = c.get(ctx, "head", nil, &state)
+	return
+}
+
+// Constants - returns network constants
+func (c *Client) Constants(ctx context.Context) (constants responses.Constants, err error) {
+	err = c.get(ctx, "constants", nil, &constants)
+	return
+}
+
+// Enums - returns values of API enumerations
+func (c *Client) Enums(ctx context.Context) (enums responses.Enums, err error) {
+	err = c.get(ctx, "enums", nil, &enums)
+	return
+}
+
+// Search - searches blocks, transactions, addresses, rollups and validators by the query. Results are sorted by rank. Zero limit means server default.
+func (c *Client) Search(ctx context.Context, query string, limit int) (result []responses.SearchResult, err error) {
+	values := url.Values{"query": []string{query}}
+	if limit > 0 {
+		values.Set("limit", strconv.Itoa(limit))
+	}
+	err = c.get(ctx, "search", values, &result)
+	return
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+const (
+	cursorParam      = "cursor"
+	defaultPageLimit = 100
+)
+
+// Iterator - walks through all pages of the list endpoint. Pages are requested lazily. Usage:
+//
+//	it := c.BlocksIterator(client.BlockListRequest{})
+//	for it.Next(ctx) {
+//		block := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch func(ctx context.Context) ([]T, bool, error)
+
+	items []T
+	item  T
+	last  bool
+	err   error
+}
+
+// Next - moves the iterator to the next item. It returns false when there are no more items or error occurred.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if it.last || it.err != nil {
+			return false
+		}
+		it.items, it.last, it.err = it.fetch(ctx)
+		if it.err != nil {
+			return false
+		}
+	}
+	it.item = it.items[0]
+	it.items = it.items[1:]
+	return true
+}
+
+// Item - returns current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err - returns error which stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// newCursorIterator - returns iterator over endpoint with keyset pagination
+func newCursorIterator[T any](page func(ctx context.Context, cursor string) (responses.Page[T], error)) *Iterator[T] {
+	var cursor string
+	return &Iterator[T]{
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			p, err := page(ctx, cursor)
+			if err != nil {
+				return nil, false, err
+			}
+			cursor = p.NextCursor
+			return p.Items, cursor == "", nil
+		},
+	}
+}
+
+// newOffsetIterator - returns iterator over endpoint with offset pagination. The last page is the page shorter than limit.
+func newOffsetIterator[T any](req ListRequest, list func(ctx context.Context, req ListRequest) ([]T, error)) *Iterator[T] {
+	if req.Limit == 0 {
+		req.Limit = defaultPageLimit
+	}
+	return &Iterator[T]{
+		fetch: func(ctx context.Context) ([]T, bool, error) {
+			items, err := list(ctx, req)
+			if err != nil {
+				return nil, false, err
+			}
+			req.Offset += uint64(len(items))
+			return items, uint64(len(items)) < req.Limit, nil
+		},
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorIterator(t *testing.T) {
+	pages := map[string]responses.Page[responses.Block]{
+		"": {
+			Items:      []responses.Block{{Height: 1}, {Height: 2}},
+			NextCursor: "page2",
+		},
+		"page2": {
+			Items:      []responses.Block{{Height: 3}, {Height: 4}},
+			NextCursor: "page3",
+		},
+		"page3": {
+			Items: []responses.Block{{Height: 5}},
+		},
+	}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/block", r.URL.Path)
+		require.True(t, r.URL.Query().Has(cursorParam))
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		page, ok := pages[r.URL.Query().Get(cursorParam)]
+		require.True(t, ok)
+		writeJSON(t, w, http.StatusOK, page)
+	})
+
+	it := c.BlocksIterator(BlockListRequest{ListRequest: ListRequest{Limit: 2}})
+	var heights []uint64
+	for it.Next(context.Background()) {
+		heights = append(heights, it.Item().Height)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, heights)
+	require.False(t, it.Next(context.Background()))
+}
+
+func TestOffsetIterator(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal(t, "/v1/address", r.URL.Path)
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		items := make([]responses.Address, 0)
+		for i := offset; i < 5 && i < offset+2; i++ {
+			items = append(items, responses.Address{Id: uint64(i + 1)})
+		}
+		writeJSON(t, w, http.StatusOK, items)
+	})
+
+	it := c.AddressesIterator(ListRequest{Limit: 2})
+	var ids []uint64
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().Id)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, 3, calls)
+}
+
+func TestIterator_Error(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusBadRequest, echoError("invalid cursor"))
+	})
+
+	it := c.TxsIterator(TxListRequest{})
+	require.False(t, it.Next(context.Background()))
+
+	var apiErr *Error
+	require.ErrorAs(t, it.Err(), &apiErr)
+	require.Equal(t, "invalid cursor", apiErr.Message)
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sort orders
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// ListRequest - offset pagination parameters. Zero values are not sent, so server defaults are used.
+type ListRequest struct {
+	Limit  uint64
+	Offset uint64
+	Sort   string
+}
+
+func (r ListRequest) values() url.Values {
+	values := make(url.Values)
+	setUint(values, "limit", r.Limit)
+	setUint(values, "offset", r.Offset)
+	setString(values, "sort", r.Sort)
+	return values
+}
+
+// TimeRange - range of time filter. Zero values are not sent.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func (r TimeRange) apply(values url.Values) {
+	if !r.From.IsZero() {
+		values.Set("from", strconv.FormatInt(r.From.Unix(), 10))
+	}
+	if !r.To.IsZero() {
+		values.Set("to", strconv.FormatInt(r.To.Unix(), 10))
+	}
+}
+
+type BlockListRequest struct {
+	ListRequest
+	Stats bool
+}
+
+func (r BlockListRequest) values() url.Values {
+	values := r.ListRequest.values()
+	setBool(values, "stats", r.Stats)
+	return values
+}
+
+type TxListRequest struct {
+	ListRequest
+	TimeRange
+	Height      uint64
+	Status      []string
+	ActionTypes []string
+	WithActions bool
+}
+
+func (r TxListRequest) values() url.Values {
+	values := r.ListRequest.values()
+	r.TimeRange.apply(values)
+	setUint(values, "height", r.Height)
+	setList(values, "status", r.Status)
+	setList(values, "action_types", r.ActionTypes)
+	setBool(values, "with_actions", r.WithActions)
+	return values
+}
+
+type AddressTxsRequest struct {
+	ListRequest
+	TimeRange
+	Height      uint64
+	Status      []string
+	ActionTypes []string
+}
+
+func (r AddressTxsRequest) values() url.Values {
+	values := r.ListRequest.values()
+	r.TimeRange.apply(values)
+	setUint(values, "height", r.Height)
+	setList(values, "status", r.Status)
+	setList(values, "action_types", r.ActionTypes)
+	return values
+}
+
+type AddressActionsRequest struct {
+	ListRequest
+	ActionTypes []string
+}
+
+func (r AddressActionsRequest) values() url.Values {
+	values := r.ListRequest.values()
+	setList(values, "action_types", r.ActionTypes)
+	return values
+}
+
+type RollupListRequest struct {
+	ListRequest
+	// SortBy - field of sorting: `id`, `size`, `actions_count` or `name`
+	SortBy string
+	// VmType - types of rollup virtual machine from metadata
+	VmType []string
+	// Name - part of rollup name from metadata
+	Name string
+}
+
+func (r RollupListRequest) values() url.Values {
+	values := r.ListRequest.values()
+	setString(values, "sort_by", r.SortBy)
+	setList(values, "vm_type", r.VmType)
+	setString(values, "name", r.Name)
+	return values
+}
+
+// ExportRequest - parameters of export endpoints. Format is `csv` or `ndjson`.
+type ExportRequest struct {
+	TimeRange
+	Format      string
+	Sort        string
+	Height      uint64
+	Status      []string
+	ActionTypes []string
+}
+
+func (r ExportRequest) values() url.Values {
+	values := make(url.Values)
+	r.TimeRange.apply(values)
+	setString(values, "format", r.Format)
+	setString(values, "sort", r.Sort)
+	setUint(values, "height", r.Height)
+	setList(values, "status", r.Status)
+	setList(values, "action_types", r.ActionTypes)
+	return values
+}
+
+// RollupMetadataRequest - body of rollup metadata update request
+type RollupMetadataRequest struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug,omitempty"`
+	Website     string `json:"website,omitempty"`
+	Logo        string `json:"logo,omitempty"`
+	Description string `json:"description,omitempty"`
+	VmType      string `json:"vm_type,omitempty"`
+	Contact     string `json:"contact,omitempty"`
+}
+
+// WatchlistRequest - body of watchlist create and update requests
+type WatchlistRequest struct {
+	Name        string   `json:"name,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Rollup      string   `json:"rollup,omitempty"`
+	ActionTypes []string `json:"action_types,omitempty"`
+	Threshold   string   `json:"threshold,omitempty"`
+	Currency    string   `json:"currency,omitempty"`
+	Direction   string   `json:"direction,omitempty"`
+	WebhookUrl  string   `json:"webhook_url,omitempty"`
+	Secret      string   `json:"secret,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
+
+func setUint(values url.Values, key string, value uint64) {
+	if value > 0 {
+		values.Set(key, strconv.FormatUint(value, 10))
+	}
+}
+
+func setString(values url.Values, key, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+func setBool(values url.Values, key string, value bool) {
+	if value {
+		values.Set(key, "true")
+	}
+}
+
+func setList(values url.Values, key string, value []string) {
+	if len(value) > 0 {
+		values.Set(key, strings.Join(value, ","))
+	}
+}
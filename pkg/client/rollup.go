@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// rollupPath - returns path of rollup endpoints. Hash is base64url encoded rollup id.
+func rollupPath(hash string, parts ...string) string {
+	return joinPath(append([]string{"rollup", hash}, parts...)...)
+}
+
+// Rollups - returns list of rollups
+func (c *Client) Rollups(ctx context.Context, req RollupListRequest) (rollups []responses.Rollup, err error) {
+	err = c.get(ctx, "rollup", req.values(), &rollups)
+	return
+}
+
+// RollupsIterator - returns iterator over all rollups
+func (c *Client) RollupsIterator(req RollupListRequest) *Iterator[responses.Rollup] {
+	return newOffsetIterator(req.ListRequest, func(ctx context.Context, list ListRequest) ([]responses.Rollup, error) {
+		req.ListRequest = list
+		return c.Rollups(ctx, req)
+	})
+}
+
+// RollupCount - returns count of rollups
+func (c *Client) RollupCount(ctx context.Context) (count uint64, err error) {
+	err = c.get(ctx, "rollup/count", nil, &count)
+	return
+}
+
+// Rollup - returns rollup by base64url encoded id
+func (c *Client) Rollup(ctx context.Context, hash string) (rollup responses.Rollup, err error) {
+	err = c.get(ctx, rollupPath(hash), nil, &rollup)
+	return
+}
+
+// RollupActions - returns actions of the rollup
+func (c *Client) RollupActions(ctx context.Context, hash string, req ListRequest) (actions []responses.RollupAction, err error) {
+	err = c.get(ctx, rollupPath(hash, "actions"), req.values(), &actions)
+	return
+}
+
+// RollupActionsPage - returns page of the rollup actions with keyset pagination. Empty cursor means the first page.
+func (c *Client) RollupActionsPage(ctx context.Context, hash string, req ListRequest, cursor string) (page responses.Page[responses.RollupAction], err error) {
+	values := req.values()
+	values.Set(cursorParam, cursor)
+	err = c.get(ctx, rollupPath(hash, "actions"), values, &page)
+	return
+}
+
+// RollupActionsIterator - returns iterator over all actions of the rollup
+func (c *Client) RollupActionsIterator(hash string, req ListRequest) *Iterator[responses.RollupAction] {
+	return newCursorIterator(func(ctx context.Context, cursor string) (responses.Page[responses.RollupAction], error) {
+		return c.RollupActionsPage(ctx, hash, req, cursor)
+	})
+}
+
+// RollupAddresses - returns addresses which sent data to the rollup
+func (c *Client) RollupAddresses(ctx context.Context, hash string, req ListRequest) (addresses []responses.Address, err error) {
+	err = c.get(ctx, rollupPath(hash, "addresses"), req.values(), &addresses)
+	return
+}
+
+// ExportRollupActions - writes actions of the rollup matching the request to writer in requested format
+func (c *Client) ExportRollupActions(ctx context.Context, hash string, req ExportRequest, w io.Writer) error {
+	return c.stream(ctx, rollupPath(hash, "actions", "export"), req.values(), w)
+}
+
+// SetRollupMetadata - creates or replaces metadata of the rollup. Requires the administrative API key in Config.ApiKey.
+func (c *Client) SetRollupMetadata(ctx context.Context, hash string, req RollupMetadataRequest) (metadata responses.RollupMetadata, err error) {
+	err = c.do(ctx, http.MethodPut, rollupPath(hash, "metadata"), nil, req, &metadata)
+	return
+}
+
+// DeleteRollupMetadata - removes metadata of the rollup. Requires the administrative API key in Config.ApiKey.
+func (c *Client) DeleteRollupMetadata(ctx context.Context, hash string) error {
+	return c.do(ctx, http.MethodDelete, rollupPath(hash, "metadata"), nil, nil, nil)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Summary - returns network summary
+func (c *Client) Summary(ctx context.Context) (summary responses.NetworkSummary, err error) {
+	err = c.get(ctx, "stats/summary", nil, &summary)
+	return
+}
+
+// Series - returns histogram of the network series, for example `tps` by `hour`
+func (c *Client) Series(ctx context.Context, name, timeframe string, period TimeRange) (series []responses.SeriesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	err = c.get(ctx, joinPath("stats/series", name, timeframe), values, &series)
+	return
+}
+
+// DownsampledSeries - returns histogram of the series for the whole period merging buckets to fit in the count of points.
+// Period start is required.
+func (c *Client) DownsampledSeries(ctx context.Context, name, timeframe string, period TimeRange, points uint64) (series []responses.SeriesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	setUint(values, "points", points)
+	err = c.get(ctx, joinPath("stats/series", name, timeframe), values, &series)
+	return
+}
+
+// RollupSeries - returns histogram of the rollup series, for example `size` by `day`
+func (c *Client) RollupSeries(ctx context.Context, hash, name, timeframe string, period TimeRange) (series []responses.RollupSeriesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	err = c.get(ctx, joinPath("stats/rollup/series", hash, name, timeframe), values, &series)
+	return
+}
+
+// Holders - returns top holders of the currency and distribution of its supply. Empty currency means the native one.
+func (c *Client) Holders(ctx context.Context, currency string, limit uint64) (stats responses.HolderStats, err error) {
+	values := make(url.Values)
+	setString(values, "currency", currency)
+	setUint(values, "limit", limit)
+	err = c.get(ctx, "stats/holders", values, &stats)
+	return
+}
+
+// ActiveAddresses - returns daily count of addresses which signed transactions or received funds
+func (c *Client) ActiveAddresses(ctx context.Context, period TimeRange) (items []responses.ActiveAddressesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	err = c.get(ctx, "stats/active_addresses", values, &items)
+	return
+}
+
+// TopRollupsRequest - parameters of rollup leaderboard. Window is `24h`, `7d` or `30d`, metric is `size`, `actions` or `signers`. Zero values are not sent.
+type TopRollupsRequest struct {
+	Window string
+	By     string
+	Limit  uint64
+}
+
+// TopRollups - returns rollups with the largest activity in the window compared with the previous window
+func (c *Client) TopRollups(ctx context.Context, req TopRollupsRequest) (items []responses.TopRollup, err error) {
+	values := make(url.Values)
+	setString(values, "window", req.Window)
+	setString(values, "by", req.By)
+	setUint(values, "limit", req.Limit)
+	err = c.get(ctx, "stats/rollups/top", values, &items)
+	return
+}
+
+// ActionSeries - returns count of actions of the type and transferred volume by timeframe
+func (c *Client) ActionSeries(ctx context.Context, actionType, timeframe string, period TimeRange) (series []responses.ActionSeriesItem, err error) {
+	values := make(url.Values)
+	period.apply(values)
+	err = c.get(ctx, joinPath("stats/series/actions", actionType, timeframe), values, &series)
+	return
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/goccy/go-json"
+)
+
+func txPath(hash string, parts ...string) string {
+	return joinPath(append([]string{"tx", hash}, parts...)...)
+}
+
+// Txs - returns list of transactions
+func (c *Client) Txs(ctx context.Context, req TxListRequest) (txs []responses.Tx, err error) {
+	err = c.get(ctx, "tx", req.values(), &txs)
+	return
+}
+
+// TxsPage - returns page of transactions with keyset pagination. Empty cursor means the first page.
+func (c *Client) TxsPage(ctx context.Context, req TxListRequest, cursor string) (page responses.Page[responses.Tx], err error) {
+	values := req.values()
+	values.Set(cursorParam, cursor)
+	err = c.get(ctx, "tx", values, &page)
+	return
+}
+
+// TxsIterator - returns iterator over all transactions matching the request
+func (c *Client) TxsIterator(req TxListRequest) *Iterator[responses.Tx] {
+	return newCursorIterator(func(ctx context.Context, cursor string) (responses.Page[responses.Tx], error) {
+		return c.TxsPage(ctx, req, cursor)
+	})
+}
+
+// TxCount - returns count of transactions
+func (c *Client) TxCount(ctx context.Context) (count uint64, err error) {
+	err = c.get(ctx, "tx/count", nil, &count)
+	return
+}
+
+// ExportTxs - writes transactions matching the request to writer in requested format
+func (c *Client) ExportTxs(ctx context.Context, req ExportRequest, w io.Writer) error {
+	return c.stream(ctx, "tx/export", req.values(), w)
+}
+
+// Tx - returns transaction by hexadecimal hash
+func (c *Client) Tx(ctx context.Context, hash string) (tx responses.Tx, err error) {
+	err = c.get(ctx, txPath(hash), nil, &tx)
+	return
+}
+
+// TxRaw - returns encoded SignedTransaction. ErrNotFound is returned if raw bytes are not stored.
+func (c *Client) TxRaw(ctx context.Context, hash string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.stream(ctx, txPath(hash, "raw"), nil, &buf); err != nil {
+		return nil, err
+	}
+	if buf.Len() == 0 {
+		return nil, ErrNotFound
+	}
+	return buf.Bytes(), nil
+}
+
+// TxDecoded - returns SignedTransaction in canonical protobuf JSON
+func (c *Client) TxDecoded(ctx context.Context, hash string) (tx json.RawMessage, err error) {
+	err = c.get(ctx, txPath(hash, "decoded"), nil, &tx)
+	return
+}
+
+// TxActions - returns actions of the transaction
+func (c *Client) TxActions(ctx context.Context, hash string, req ListRequest) (actions []responses.Action, err error) {
+	err = c.get(ctx, txPath(hash, "actions"), req.values(), &actions)
+	return
+}
+
+// TxRollupActions - returns rollup actions of the transaction
+func (c *Client) TxRollupActions(ctx context.Context, hash string, req ListRequest) (actions []responses.RollupAction, err error) {
+	err = c.get(ctx, txPath(hash, "rollup_actions"), req.values(), &actions)
+	return
+}
+
+// TxRollupActionsCount - returns count of rollup actions of the transaction
+func (c *Client) TxRollupActionsCount(ctx context.Context, hash string) (count uint64, err error) {
+	err = c.get(ctx, txPath(hash, "rollup_actions", "count"), nil, &count)
+	return
+}
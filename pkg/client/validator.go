@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+func validatorPath(id uint64, parts ...string) string {
+	return joinPath(append([]string{"validators", strconv.FormatUint(id, 10)}, parts...)...)
+}
+
+// Validators - returns list of validators
+func (c *Client) Validators(ctx context.Context, req ListRequest) (validators []responses.Validator, err error) {
+	err = c.get(ctx, "validators", req.values(), &validators)
+	return
+}
+
+// ValidatorsIterator - returns iterator over all validators
+func (c *Client) ValidatorsIterator(req ListRequest) *Iterator[responses.Validator] {
+	return newOffsetIterator(req, c.Validators)
+}
+
+// Validator - returns validator by internal id
+func (c *Client) Validator(ctx context.Context, id uint64) (validator responses.Validator, err error) {
+	err = c.get(ctx, validatorPath(id), nil, &validator)
+	return
+}
+
+// ValidatorBlocks - returns blocks proposed by the validator
+func (c *Client) ValidatorBlocks(ctx context.Context, id uint64, req ListRequest) (blocks []responses.Block, err error) {
+	err = c.get(ctx, validatorPath(id, "blocks"), req.values(), &blocks)
+	return
+}
+
+// ValidatorUptime - returns uptime of the validator in the last `limit` blocks. Zero limit means server default.
+func (c *Client) ValidatorUptime(ctx context.Context, id uint64, limit uint64) (uptime responses.ValidatorUptime, err error) {
+	values := make(url.Values)
+	setUint(values, "limit", limit)
+	err = c.get(ctx, validatorPath(id, "uptime"), values, &uptime)
+	return
+}
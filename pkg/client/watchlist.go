@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Watchlist endpoints require the administrative API key in Config.ApiKey.
+
+func watchlistPath(id uint64, parts ...string) string {
+	return joinPath(append([]string{"watchlists", strconv.FormatUint(id, 10)}, parts...)...)
+}
+
+// Watchlists - returns list of watchlists
+func (c *Client) Watchlists(ctx context.Context, req ListRequest) (watchlists []responses.Watchlist, err error) {
+	err = c.get(ctx, "watchlists", req.values(), &watchlists)
+	return
+}
+
+// Watchlist - returns watchlist by id
+func (c *Client) Watchlist(ctx context.Context, id uint64) (watchlist responses.Watchlist, err error) {
+	err = c.get(ctx, watchlistPath(id), nil, &watchlist)
+	return
+}
+
+// CreateWatchlist - creates watchlist. The request is not retried because it's not idempotent.
+func (c *Client) CreateWatchlist(ctx context.Context, req WatchlistRequest) (watchlist responses.Watchlist, err error) {
+	err = c.do(ctx, http.MethodPost, "watchlists", nil, req, &watchlist)
+	return
+}
+
+// UpdateWatchlist - replaces watchlist rule
+func (c *Client) UpdateWatchlist(ctx context.Context, id uint64, req WatchlistRequest) (watchlist responses.Watchlist, err error) {
+	err = c.do(ctx, http.MethodPut, watchlistPath(id), nil, req, &watchlist)
+	return
+}
+
+// DeleteWatchlist - removes watchlist
+func (c *Client) DeleteWatchlist(ctx context.Context, id uint64) error {
+	return c.do(ctx, http.MethodDelete, watchlistPath(id), nil, nil, nil)
+}
+
+// WatchlistAlerts - returns alerts of the watchlist
+func (c *Client) WatchlistAlerts(ctx context.Context, id uint64, req ListRequest) (alerts []responses.WatchlistAlert, err error) {
+	err = c.get(ctx, watchlistPath(id, "alerts"), req.values(), &alerts)
+	return
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	ws "github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/dipdup-io/workerpool"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+const (
+	// readTimeout - server pings every 9 seconds, so silence longer than the timeout means broken connection
+	readTimeout  = 30 * time.Second
+	writeTimeout = 10 * time.Second
+	eventsBuffer = 1024
+)
+
+// Event - notification received from websocket API. Body depends on channel, use typed getters to decode it.
+type Event struct {
+	Channel string          `json:"channel"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// DecodeEvent - decodes body of the event to passed type
+func DecodeEvent[T any](event Event) (T, error) {
+	var body T
+	err := json.Unmarshal(event.Body, &body)
+	return body, err
+}
+
+// Head - returns body of `head` channel event
+func (e Event) Head() (responses.State, error) {
+	return DecodeEvent[responses.State](e)
+}
+
+// Block - returns body of `blocks` channel event
+func (e Event) Block() (responses.Block, error) {
+	return DecodeEvent[responses.Block](e)
+}
+
+// Tx - returns body of `txs` channel event
+func (e Event) Tx() (responses.Tx, error) {
+	return DecodeEvent[responses.Tx](e)
+}
+
+// Action - returns body of `actions` channel event
+func (e Event) Action() (responses.Action, error) {
+	return DecodeEvent[responses.Action](e)
+}
+
+// Rollup - returns body of `rollup` channel event
+func (e Event) Rollup() (ws.RollupMessage, error) {
+	return DecodeEvent[ws.RollupMessage](e)
+}
+
+// Alert - returns body of `alerts` channel event
+func (e Event) Alert() (responses.WatchlistAlert, error) {
+	return DecodeEvent[responses.WatchlistAlert](e)
+}
+
+// Subscriber - websocket API client which reconnects automatically. After reconnection subscriptions are restored
+// and channels with history (`blocks`, `txs`, `actions`, `rollup`) are resumed from the last received height,
+// so no notifications are lost. Transactions and actions of the last received block may be delivered twice.
+type Subscriber struct {
+	url           string
+	header        http.Header
+	dialer        *websocket.Dialer
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+
+	mx      *sync.Mutex
+	conn    *websocket.Conn
+	subs    map[string]ws.Subscribe
+	resume  map[string]uint64
+	events  chan Event
+	errors  chan error
+	started bool
+	g       workerpool.Group
+}
+
+// Subscriber - returns websocket subscriber connected to the API of the client
+func (c *Client) Subscriber() *Subscriber {
+	u := *c.baseURL
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path = joinPath(strings.TrimSuffix(u.Path, "/"), "v1", "ws")
+	u.RawPath = ""
+	u.RawQuery = ""
+
+	header := make(http.Header)
+	header.Set("User-Agent", userAgent)
+	if c.cfg.ApiKey != "" {
+		header.Set(headerApiKey, c.cfg.ApiKey)
+	}
+
+	return &Subscriber{
+		url:           u.String(),
+		header:        header,
+		dialer:        websocket.DefaultDialer,
+		retryDelay:    c.cfg.RetryDelay,
+		maxRetryDelay: c.cfg.MaxRetryDelay,
+		mx:            new(sync.Mutex),
+		subs:          make(map[string]ws.Subscribe),
+		resume:        make(map[string]uint64),
+		events:        make(chan Event, eventsBuffer),
+		errors:        make(chan error, 16),
+		g:             workerpool.NewGroup(),
+	}
+}
+
+// Events - returns channel of received notifications. It's closed after the subscriber is stopped.
+func (s *Subscriber) Events() <-chan Event {
+	return s.events
+}
+
+// Errors - returns channel of connection errors. Errors are informational: the subscriber reconnects by itself.
+// Errors are dropped if nobody reads the channel.
+func (s *Subscriber) Errors() <-chan error {
+	return s.errors
+}
+
+// Subscribe - subscribes to the channel with filters. Filters are one of websocket filter structures,
+// for example `websocket.BlockFilters`. Nil filters mean no filters. If fromHeight is positive,
+// history is sent from the height before live notifications. Subscription is restored after reconnection.
+func (s *Subscriber) Subscribe(channel string, filters any, fromHeight uint64) error {
+	raw := json.RawMessage("{}")
+	if filters != nil {
+		data, err := json.Marshal(filters)
+		if err != nil {
+			return errors.Wrap(err, "encoding filters")
+		}
+		raw = data
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.subs[channel] = ws.Subscribe{
+		Channel: channel,
+		Filters: raw,
+	}
+	if fromHeight > 0 {
+		s.resume[channel] = fromHeight
+	} else {
+		delete(s.resume, channel)
+	}
+	if s.conn == nil {
+		return nil
+	}
+	return s.send(s.conn, ws.MethodSubscribe, s.subscribeMessage(channel))
+}
+
+// Unsubscribe - unsubscribes from the channel
+func (s *Subscriber) Unsubscribe(channel string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	delete(s.subs, channel)
+	delete(s.resume, channel)
+	if s.conn == nil {
+		return nil
+	}
+	return s.send(s.conn, ws.MethodUnsubscribe, ws.Unsubscribe{Channel: channel})
+}
+
+// Start - connects to the API and keeps connection alive until the context is cancelled
+func (s *Subscriber) Start(ctx context.Context) {
+	s.mx.Lock()
+	if s.started {
+		s.mx.Unlock()
+		return
+	}
+	s.started = true
+	s.mx.Unlock()
+
+	s.g.GoCtx(ctx, s.run)
+}
+
+// Close - waits the end of work. The context passed to Start must be cancelled before.
+func (s *Subscriber) Close() error {
+	s.g.Wait()
+	return nil
+}
+
+func (s *Subscriber) run(ctx context.Context) {
+	defer close(s.events)
+
+	for attempt := 0; ; {
+		connected, err := s.connect(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.reportError(err)
+		}
+		if connected {
+			attempt = 0
+		}
+
+		timer := time.NewTimer(backoff(s.retryDelay, s.maxRetryDelay, attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		attempt++
+	}
+}
+
+// connect - dials the server, restores subscriptions and reads notifications until error.
+// It returns true if connection was established.
+func (s *Subscriber) connect(ctx context.Context) (bool, error) {
+	conn, _, err := s.dialer.DialContext(ctx, s.url, s.header)
+	if err != nil {
+		return false, errors.Wrap(err, "dial")
+	}
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	s.mx.Lock()
+	for channel := range s.subs {
+		if err := s.send(conn, ws.MethodSubscribe, s.subscribeMessage(channel)); err != nil {
+			s.mx.Unlock()
+			return true, errors.Wrap(err, "subscribe")
+		}
+	}
+	s.conn = conn
+	s.mx.Unlock()
+
+	defer func() {
+		s.mx.Lock()
+		s.conn = nil
+		s.mx.Unlock()
+	}()
+
+	conn.SetPingHandler(func(data string) error {
+		if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+			return err
+		}
+		s.mx.Lock()
+		defer s.mx.Unlock()
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(writeTimeout))
+	})
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+			return true, err
+		}
+		var event Event
+		if err := conn.ReadJSON(&event); err != nil {
+			return true, errors.Wrap(err, "read")
+		}
+		s.track(event)
+
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case s.events <- event:
+		}
+	}
+}
+
+// subscribeMessage - returns subscribe message of the channel with resume height. Must be called under lock.
+func (s *Subscriber) subscribeMessage(channel string) ws.Subscribe {
+	msg := s.subs[channel]
+	msg.FromHeight = s.resume[channel]
+	return msg
+}
+
+// send - writes message to the connection. Must be called under lock.
+func (s *Subscriber) send(conn *websocket.Conn, method string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(ws.Message{
+		Method: method,
+		Body:   data,
+	})
+}
+
+// track - remembers height to resume the channel from after reconnection
+func (s *Subscriber) track(event Event) {
+	var body struct {
+		Height uint64 `json:"height"`
+		Type   string `json:"type"`
+	}
+	if err := json.Unmarshal(event.Body, &body); err != nil || body.Height == 0 {
+		return
+	}
+
+	var next uint64
+	switch event.Channel {
+	case ws.ChannelBlocks:
+		next = body.Height + 1
+	case ws.ChannelRollup:
+		next = body.Height
+		if body.Type == ws.RollupMessageEndOfBlock {
+			next++
+		}
+	case ws.ChannelTxs, ws.ChannelActions:
+		next = body.Height
+	default:
+		return
+	}
+
+	s.mx.Lock()
+	if _, ok := s.subs[event.Channel]; ok {
+		s.resume[event.Channel] = next
+	}
+	s.mx.Unlock()
+}
+
+func (s *Subscriber) reportError(err error) {
+	select {
+	case s.errors <- err:
+	default:
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	ws "github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriber_Reconnect(t *testing.T) {
+	subscriptions := make(chan ws.Subscribe, 10)
+	upgrader := websocket.Upgrader{}
+
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/ws", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get(headerApiKey))
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		connection := connections.Add(1)
+
+		var msg ws.Message
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, ws.MethodSubscribe, msg.Method)
+
+		var sub ws.Subscribe
+		require.NoError(t, json.Unmarshal(msg.Body, &sub))
+		subscriptions <- sub
+
+		// send two blocks and break the connection
+		height := sub.FromHeight
+		if height == 0 {
+			height = 100
+		}
+		for i := uint64(0); i < 2; i++ {
+			require.NoError(t, conn.WriteJSON(ws.NewBlockNotification(responses.Block{Height: height + i})))
+		}
+		if connection == 1 {
+			return
+		}
+		// keep the second connection till the client leaves
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{
+		BaseURL:       server.URL,
+		ApiKey:        "secret",
+		RetryDelay:    time.Millisecond,
+		MaxRetryDelay: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	sub := c.Subscriber()
+	require.NoError(t, sub.Subscribe(ws.ChannelBlocks, ws.BlockFilters{Actions: []string{"transfer"}}, 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sub.Start(ctx)
+
+	first := <-subscriptions
+	require.EqualValues(t, 0, first.FromHeight)
+	require.JSONEq(t, `{"action_type":["transfer"]}`, string(first.Filters))
+
+	heights := make([]uint64, 0)
+	for len(heights) < 4 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("timeout")
+		case event := <-sub.Events():
+			require.Equal(t, ws.ChannelBlocks, event.Channel)
+			block, err := event.Block()
+			require.NoError(t, err)
+			heights = append(heights, block.Height)
+		}
+	}
+	require.Equal(t, []uint64{100, 101, 102, 103}, heights)
+
+	second := <-subscriptions
+	require.EqualValues(t, 102, second.FromHeight, "subscription is resumed after the last received block")
+
+	cancel()
+	require.NoError(t, sub.Close())
+
+	_, ok := <-sub.Events()
+	for ok {
+		_, ok = <-sub.Events()
+	}
+}
+
+func TestSubscriber_Track(t *testing.T) {
+	c, err := NewClient(Config{BaseURL: "https://api.astrotrek.io"})
+	require.NoError(t, err)
+
+	sub := c.Subscriber()
+	require.Equal(t, "wss://api.astrotrek.io/v1/ws", sub.url)
+
+	require.NoError(t, sub.Subscribe(ws.ChannelRollup, ws.RollupFilters{Rollups: []string{"rollup"}}, 10))
+	require.EqualValues(t, 10, sub.subscribeMessage(ws.ChannelRollup).FromHeight)
+
+	sub.track(Event{Channel: ws.ChannelRollup, Body: json.RawMessage(`{"type":"action","height":12}`)})
+	require.EqualValues(t, 12, sub.resume[ws.ChannelRollup])
+
+	sub.track(Event{Channel: ws.ChannelRollup, Body: json.RawMessage(`{"type":"end_of_block","height":12}`)})
+	require.EqualValues(t, 13, sub.resume[ws.ChannelRollup])
+
+	sub.track(Event{Channel: ws.ChannelTxs, Body: json.RawMessage(`{"height":12}`)})
+	require.NotContains(t, sub.resume, ws.ChannelTxs, "not subscribed channels are not tracked")
+
+	require.NoError(t, sub.Unsubscribe(ws.ChannelRollup))
+	require.Empty(t, sub.resume)
+	require.Empty(t, sub.subs)
+}
@@ -13,14 +13,43 @@ type Config struct {
 	LogLevel      string           `validate:"omitempty,oneof=debug trace info warn error fatal panic" yaml:"log_level"`
 	Indexer       Indexer          `yaml:"indexer"`
 	Profiler      *profiler.Config `validate:"omitempty"                                               yaml:"profiler"`
+	Sinks         Sinks            `validate:"omitempty"                                               yaml:"sinks"`
 }
 
 type Indexer struct {
-	Name         string `validate:"omitempty"       yaml:"name"`
-	ThreadsCount uint32 `validate:"omitempty,min=1" yaml:"threads_count"`
-	StartLevel   int64  `validate:"omitempty"       yaml:"start_level"`
-	BlockPeriod  int64  `validate:"omitempty"       yaml:"block_period"`
-	ScriptsDir   string `validate:"omitempty,dir"   yaml:"scripts_dir"`
+	Name              string `validate:"omitempty"                 yaml:"name"`
+	ThreadsCount      uint32 `validate:"omitempty,min=1"           yaml:"threads_count"`
+	ParseThreadsCount uint32 `validate:"omitempty,min=1"           yaml:"parse_threads_count"`
+	StartLevel        int64  `validate:"omitempty"                 yaml:"start_level"`
+	BlockPeriod       int64  `validate:"omitempty"                 yaml:"block_period"`
+	ScriptsDir        string `validate:"omitempty,dir"             yaml:"scripts_dir"`
+	BulkSize          int    `validate:"omitempty,min=0"           yaml:"bulk_size"`
+	RawCompression    string `validate:"omitempty,oneof=none gzip" yaml:"raw_compression"`
+}
+
+type Sinks struct {
+	Webhook   *WebhookSink   `validate:"omitempty" yaml:"webhook"`
+	File      *FileSink      `validate:"omitempty" yaml:"file"`
+	Watchlist *WatchlistSink `validate:"omitempty" yaml:"watchlist"`
+}
+
+type WebhookSink struct {
+	Url        string `validate:"required,url"    yaml:"url"`
+	Secret     string `validate:"omitempty"       yaml:"secret"`
+	Timeout    int    `validate:"omitempty,min=1" yaml:"timeout"`
+	MaxRetries int    `validate:"omitempty,min=1" yaml:"max_retries"`
+}
+
+type FileSink struct {
+	Dir      string `validate:"required"        yaml:"dir"`
+	Prefix   string `validate:"omitempty"       yaml:"prefix"`
+	MaxSize  int64  `validate:"omitempty,min=1" yaml:"max_size"`
+	MaxFiles int    `validate:"omitempty,min=0" yaml:"max_files"`
+}
+
+type WatchlistSink struct {
+	Timeout    int `validate:"omitempty,min=1" yaml:"timeout"`
+	MaxRetries int `validate:"omitempty,min=1" yaml:"max_retries"`
 }
 
 // Substitute -
@@ -124,6 +124,7 @@ func parseIcs20Withdrawal(body *astria.Action_Ics20Withdrawal, height types.Leve
 		action.BalanceUpdates = append(action.BalanceUpdates, storage.BalanceUpdate{
 			Address:  addr,
 			Height:   action.Height,
+			Time:     action.Time,
 			Currency: body.Ics20Withdrawal.Denom,
 			Update:   decAmount,
 		})
@@ -155,6 +156,7 @@ func parseMintAction(body *astria.Action_MintAction, height types.Level, ctx *Co
 		action.BalanceUpdates = append(action.BalanceUpdates, storage.BalanceUpdate{
 			Address:  addr,
 			Height:   action.Height,
+			Time:     action.Time,
 			Currency: addr.Balance.Currency,
 			Update:   decAmount,
 		})
@@ -200,6 +202,7 @@ func parseSequenceAction(body *astria.Action_SequenceAction, from bytes.HexBytes
 			Size:   int64(dataSize),
 			Action: action,
 			Rollup: rollup,
+			Sender: fromAddress,
 		}
 		ctx.DataSize += int64(dataSize)
 	}
@@ -269,12 +272,14 @@ func parseTransferAction(body *astria.Action_TransferAction, from bytes.HexBytes
 				storage.BalanceUpdate{
 					Address:  toAddr,
 					Height:   action.Height,
+					Time:     action.Time,
 					Currency: toAddr.Balance.Currency,
 					Update:   decAmount,
 				},
 				storage.BalanceUpdate{
 					Address:  fromAddr,
 					Height:   action.Height,
+					Time:     action.Time,
 					Currency: fromAddr.Balance.Currency,
 					Update:   decAmount.Copy().Neg(),
 				})
@@ -347,15 +352,16 @@ func parseInitBridgeAccount(body *astria.Action_InitBridgeAccountAction, from by
 		action.Data["asset_id"] = body.InitBridgeAccountAction.GetAssetId()
 
 		rollup := ctx.Rollups.Set(body.InitBridgeAccountAction.RollupId.GetInner(), height, 0)
+		fromAddress := ctx.Addresses.Set(from, height, decimal.Zero, 1, 0)
+		rollup.BridgeAddress = fromAddress
+
 		action.RollupAction = &storage.RollupAction{
 			Time:   action.Time,
 			Height: action.Height,
 			Action: action,
 			Rollup: rollup,
+			Sender: fromAddress,
 		}
-
-		fromAddress := ctx.Addresses.Set(from, height, decimal.Zero, 1, 0)
-		rollup.BridgeAddress = fromAddress
 	}
 	return nil
 }
@@ -410,12 +416,14 @@ func parseBridgeLock(body *astria.Action_BridgeLockAction, from bytes.HexBytes,
 				storage.BalanceUpdate{
 					Address:  toAddr,
 					Height:   action.Height,
+					Time:     action.Time,
 					Currency: toAddr.Balance.Currency,
 					Update:   decAmount,
 				},
 				storage.BalanceUpdate{
 					Address:  fromAddr,
 					Height:   action.Height,
+					Time:     action.Time,
 					Currency: fromAddr.Balance.Currency,
 					Update:   decAmount.Neg(),
 				},
@@ -219,6 +219,7 @@ func TestDecodeActions(t *testing.T) {
 			RollupAction: &storage.RollupAction{
 				Size:   10,
 				Height: 1000,
+				Sender: addressModel,
 				Rollup: &storage.Rollup{
 					AstriaId:     message.SequenceAction.RollupId.Inner,
 					FirstHeight:  1000,
@@ -694,6 +695,7 @@ func TestDecodeActions(t *testing.T) {
 			},
 			RollupAction: &storage.RollupAction{
 				Height: 1000,
+				Sender: fromAddr,
 				Rollup: &storage.Rollup{
 					AstriaId:      message.InitBridgeAccountAction.RollupId.Inner,
 					FirstHeight:   1000,
@@ -29,7 +29,7 @@ func TestParseAccounts(t *testing.T) {
 
 	module := NewModule(postgres.Storage{}, config.Indexer{})
 
-	err = module.parseAccounts(g.AppState.Accounts, 1, &data)
+	err = module.parseAccounts(g.AppState.Accounts, 1, g.GenesisTime, &data)
 	require.NoError(t, err)
 
 	want := map[string]*storage.Address{
@@ -4,6 +4,8 @@
 package genesis
 
 import (
+	"time"
+
 	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/node/types"
@@ -49,7 +51,7 @@ func (module *Module) parse(genesis types.Genesis) (parsedData, error) {
 
 	module.parseConstants(genesis.AppState, genesis.ConsensusParams, &data)
 
-	if err := module.parseAccounts(genesis.AppState.Accounts, block.Height, &data); err != nil {
+	if err := module.parseAccounts(genesis.AppState.Accounts, block.Height, block.Time, &data); err != nil {
 		return data, errors.Wrap(err, "parse genesis accounts")
 	}
 	if err := module.parseValidators(genesis.Validators, block.Height, &data); err != nil {
@@ -61,7 +63,7 @@ func (module *Module) parse(genesis types.Genesis) (parsedData, error) {
 	return data, nil
 }
 
-func (module *Module) parseAccounts(accounts []types.Account, height pkgTypes.Level, data *parsedData) error {
+func (module *Module) parseAccounts(accounts []types.Account, height pkgTypes.Level, blockTime time.Time, data *parsedData) error {
 	for i := range accounts {
 		address := storage.Address{
 			Height: height,
@@ -85,6 +87,7 @@ func (module *Module) parseAccounts(accounts []types.Account, height pkgTypes.Le
 			Update:   address.Balance.Total,
 			Currency: address.Balance.Currency,
 			Height:   0,
+			Time:     blockTime,
 		})
 	}
 	return nil
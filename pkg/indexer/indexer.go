@@ -15,6 +15,7 @@ import (
 	"github.com/celenium-io/astria-indexer/pkg/indexer/genesis"
 	"github.com/celenium-io/astria-indexer/pkg/indexer/parser"
 	"github.com/celenium-io/astria-indexer/pkg/indexer/rollback"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/sink"
 	"github.com/celenium-io/astria-indexer/pkg/indexer/storage"
 	"github.com/celenium-io/astria-indexer/pkg/node"
 	"github.com/celenium-io/astria-indexer/pkg/node/rpc"
@@ -35,6 +36,7 @@ type Indexer struct {
 	storage  *storage.Module
 	rollback *rollback.Module
 	genesis  *genesis.Module
+	sinks    sink.Sinks
 	stopper  modules.Module
 	wg       *sync.WaitGroup
 	log      zerolog.Logger
@@ -51,17 +53,22 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating receiver module")
 	}
 
-	rb, err := createRollback(r, pg, &api, cfg.Indexer)
+	sinks, err := createSinks(cfg.Sinks, pg)
+	if err != nil {
+		return Indexer{}, errors.Wrap(err, "while creating sinks")
+	}
+
+	rb, err := createRollback(r, pg, &api, cfg.Indexer, sinks)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
 
-	p, err := createParser(r)
+	p, err := createParser(r, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating parser module")
 	}
 
-	s, err := createStorage(pg, cfg, p)
+	s, err := createStorage(pg, cfg, p, sinks)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating storage module")
 	}
@@ -84,6 +91,7 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		storage:  s,
 		rollback: rb,
 		genesis:  genesisModule,
+		sinks:    sinks,
 		stopper:  stopperModule,
 		wg:       new(sync.WaitGroup),
 		log:      log.With().Str("module", "indexer").Logger(),
@@ -93,6 +101,7 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 func (i *Indexer) Start(ctx context.Context) {
 	i.log.Info().Msg("starting...")
 
+	i.sinks.Start(ctx)
 	i.genesis.Start(ctx)
 	i.storage.Start(ctx)
 	i.parser.Start(ctx)
@@ -118,6 +127,9 @@ func (i *Indexer) Close() error {
 	if err := i.rollback.Close(); err != nil {
 		log.Err(err).Msg("closing rollback")
 	}
+	if err := i.sinks.Close(); err != nil {
+		log.Err(err).Msg("closing sinks")
+	}
 
 	return nil
 }
@@ -134,8 +146,26 @@ func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage)
 	return api, &receiverModule, nil
 }
 
-func createRollback(receiverModule modules.Module, pg postgres.Storage, api node.Api, cfg config.Indexer) (*rollback.Module, error) {
-	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, api, cfg)
+func createSinks(cfg config.Sinks, pg postgres.Storage) (sink.Sinks, error) {
+	sinks := make(sink.Sinks, 0)
+	if cfg.Webhook != nil {
+		sinks = append(sinks, sink.NewWebhook(*cfg.Webhook, pg.Outbox))
+	}
+	if cfg.File != nil {
+		fileSink, err := sink.NewFile(*cfg.File)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating file sink")
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if cfg.Watchlist != nil {
+		sinks = append(sinks, sink.NewWatchlist(*cfg.Watchlist, pg.Watchlist, pg.Notificator))
+	}
+	return sinks, nil
+}
+
+func createRollback(receiverModule modules.Module, pg postgres.Storage, api node.Api, cfg config.Indexer, sinks sink.Sinks) (*rollback.Module, error) {
+	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, api, cfg, sinks...)
 
 	// rollback <- listen signal -- receiver
 	if err := rollbackModule.AttachTo(receiverModule, receiver.RollbackOutput, rollback.InputName); err != nil {
@@ -150,8 +180,8 @@ func createRollback(receiverModule modules.Module, pg postgres.Storage, api node
 	return &rollbackModule, nil
 }
 
-func createParser(receiverModule modules.Module) (*parser.Module, error) {
-	parserModule := parser.NewModule()
+func createParser(receiverModule modules.Module, cfg config.Indexer) (*parser.Module, error) {
+	parserModule := parser.NewModule(cfg)
 
 	if err := parserModule.AttachTo(receiverModule, receiver.BlocksOutput, parser.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching parser to receiver")
@@ -160,8 +190,8 @@ func createParser(receiverModule modules.Module) (*parser.Module, error) {
 	return &parserModule, nil
 }
 
-func createStorage(pg postgres.Storage, cfg config.Config, parserModule modules.Module) (*storage.Module, error) {
-	storageModule := storage.NewModule(pg.Transactable, pg.Notificator, cfg.Indexer)
+func createStorage(pg postgres.Storage, cfg config.Config, parserModule modules.Module, sinks sink.Sinks) (*storage.Module, error) {
+	storageModule := storage.NewModule(pg.Transactable, pg.Notificator, cfg.Indexer, sinks...)
 
 	if err := storageModule.AttachTo(parserModule, parser.OutputName, storage.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching storage to parser")
@@ -11,9 +11,9 @@ import (
 
 func (p *Module) listen(ctx context.Context) {
 	p.Log.Info().Msg("module started")
-
 	input := p.MustInput(InputName)
 
+	var seq uint64
 	for {
 		select {
 		case <-ctx.Done():
@@ -31,13 +31,26 @@ func (p *Module) listen(ctx context.Context) {
 				continue
 			}
 
-			if err := p.parse(block); err != nil {
+			if p.isParallel() {
+				select {
+				case <-ctx.Done():
+					return
+				case p.inflight <- struct{}{}:
+				}
+				p.pool.AddTask(parseTask{seq: seq, block: block})
+				seq++
+				continue
+			}
+
+			parsed, err := p.parse(block)
+			if err != nil {
 				p.Log.Err(err).
 					Uint64("height", uint64(block.Height)).
 					Msg("block parsing error")
 				p.MustOutput(StopOutput).Push(struct{}{})
 				continue
 			}
+			p.MustOutput(OutputName).Push(parsed)
 		}
 	}
 }
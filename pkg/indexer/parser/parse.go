@@ -15,7 +15,7 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-func (p *Module) parse(b types.BlockData) error {
+func (p *Module) parse(b types.BlockData) (*storage.Block, error) {
 	start := time.Now()
 	p.Log.Info().
 		Int64("height", b.Block.Height).
@@ -23,9 +23,14 @@ func (p *Module) parse(b types.BlockData) error {
 
 	decodeCtx := decode.NewContext()
 
-	txs, err := parseTxs(b, &decodeCtx)
+	txs, err := parseTxs(b, p.compression, &decodeCtx)
 	if err != nil {
-		return errors.Wrapf(err, "while parsing block on level=%d", b.Height)
+		return nil, errors.Wrapf(err, "while parsing block on level=%d", b.Height)
+	}
+
+	raw, err := p.parseRawBlock(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while compressing block on level=%d", b.Height)
 	}
 
 	block := &storage.Block{
@@ -51,6 +56,7 @@ func (p *Module) parse(b types.BlockData) error {
 		Rollups:       decodeCtx.Rollups,
 		RollupAddress: decodeCtx.RollupAddress,
 		ActionTypes:   decodeCtx.ActionTypes,
+		Raw:           raw,
 
 		Txs: txs,
 		Stats: &storage.BlockStats{
@@ -72,10 +78,31 @@ func (p *Module) parse(b types.BlockData) error {
 		Uint64("height", uint64(block.Height)).
 		Int64("ms", time.Since(start).Milliseconds()).
 		Msg("block parsed")
+	return block, nil
+}
 
-	output := p.MustOutput(OutputName)
-	output.Push(block)
-	return nil
+// parseRawBlock - returns block and block results as they were received from the node. It returns nil if they weren't received.
+func (p *Module) parseRawBlock(b types.BlockData) (*storage.RawBlock, error) {
+	if len(b.RawBlock) == 0 && len(b.RawBlockResults) == 0 {
+		return nil, nil
+	}
+
+	block, err := p.compression.Compress(b.RawBlock)
+	if err != nil {
+		return nil, err
+	}
+	results, err := p.compression.Compress(b.RawBlockResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.RawBlock{
+		Height:       b.Height,
+		Time:         b.Block.Time,
+		Compression:  p.compression,
+		Block:        block,
+		BlockResults: results,
+	}, nil
 }
 
 func (p *Module) parseBlockSignatures(commit *types.Commit) []storage.BlockSignature {
@@ -11,7 +11,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func parseTxs(b types.BlockData, ctx *decode.Context) ([]*storage.Tx, error) {
+func parseTxs(b types.BlockData, compression storageTypes.Compression, ctx *decode.Context) ([]*storage.Tx, error) {
 	count := len(b.Block.Txs)
 	index := 0
 	if count == 0 {
@@ -27,7 +27,7 @@ func parseTxs(b types.BlockData, ctx *decode.Context) ([]*storage.Tx, error) {
 	txs := make([]*storage.Tx, count)
 
 	for i := index; i < len(b.TxsResults); i++ {
-		t, err := parseTx(b, i, b.TxsResults[i], ctx)
+		t, err := parseTx(b, i, b.TxsResults[i], compression, ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -40,12 +40,17 @@ func parseTxs(b types.BlockData, ctx *decode.Context) ([]*storage.Tx, error) {
 	return txs, nil
 }
 
-func parseTx(b types.BlockData, index int, txRes *types.ResponseDeliverTx, ctx *decode.Context) (storage.Tx, error) {
+func parseTx(b types.BlockData, index int, txRes *types.ResponseDeliverTx, compression storageTypes.Compression, ctx *decode.Context) (storage.Tx, error) {
 	d, err := decode.Tx(b, index, ctx)
 	if err != nil {
 		return storage.Tx{}, errors.Wrapf(err, "while parsing Tx on index %d", index)
 	}
 
+	raw, err := compression.Compress(b.Block.Txs[index])
+	if err != nil {
+		return storage.Tx{}, errors.Wrapf(err, "while compressing Tx on index %d", index)
+	}
+
 	t := storage.Tx{
 		Height:       b.Height,
 		Time:         b.Block.Time,
@@ -63,6 +68,10 @@ func parseTx(b types.BlockData, index int, txRes *types.ResponseDeliverTx, ctx *
 
 		Actions:   d.Actions,
 		BytesSize: int64(len(txRes.Data)),
+		Raw: &storage.RawTx{
+			Compression: compression,
+			Data:        raw,
+		},
 	}
 
 	if txRes.IsFailed() {
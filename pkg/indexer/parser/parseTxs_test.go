@@ -17,7 +17,7 @@ func TestParseTxs_EmptyTxsResults(t *testing.T) {
 	block, _ := testsuite.EmptyBlock()
 
 	ctx := decode.NewContext()
-	resultTxs, err := parseTxs(block, &ctx)
+	resultTxs, err := parseTxs(block, storageTypes.CompressionNone, &ctx)
 
 	assert.NoError(t, err)
 	assert.Empty(t, resultTxs)
@@ -36,7 +36,7 @@ func TestParseTxs_SuccessTx(t *testing.T) {
 	}
 	block, now := testsuite.CreateTestBlock(txRes, true)
 	ctx := decode.NewContext()
-	resultTxs, err := parseTxs(block, &ctx)
+	resultTxs, err := parseTxs(block, storageTypes.CompressionNone, &ctx)
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 1)
@@ -48,6 +48,29 @@ func TestParseTxs_SuccessTx(t *testing.T) {
 	assert.Equal(t, int64(12000), f.GasWanted)
 	assert.Equal(t, int64(1000), f.GasUsed)
 	assert.Equal(t, "codespace", f.Codespace)
+	assert.EqualValues(t, block.Block.Txs[2], f.Raw.Data)
+	assert.Equal(t, storageTypes.CompressionNone, f.Raw.Compression)
+}
+
+func TestParseTxs_CompressedRaw(t *testing.T) {
+	txRes := types.ResponseDeliverTx{
+		Data:      []byte{},
+		GasWanted: 12000,
+		GasUsed:   1000,
+	}
+	block, _ := testsuite.CreateTestBlock(txRes, true)
+	ctx := decode.NewContext()
+	resultTxs, err := parseTxs(block, storageTypes.CompressionGzip, &ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, resultTxs, 1)
+
+	raw := resultTxs[0].Raw
+	assert.Equal(t, storageTypes.CompressionGzip, raw.Compression)
+
+	data, err := raw.Decompressed()
+	assert.NoError(t, err)
+	assert.EqualValues(t, block.Block.Txs[2], data)
 }
 
 func TestParseTxs_FailedTx(t *testing.T) {
@@ -63,7 +86,7 @@ func TestParseTxs_FailedTx(t *testing.T) {
 	}
 	block, now := testsuite.CreateTestBlock(txRes, true)
 	ctx := decode.NewContext()
-	resultTxs, err := parseTxs(block, &ctx)
+	resultTxs, err := parseTxs(block, storageTypes.CompressionNone, &ctx)
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 1)
@@ -90,7 +113,7 @@ func TestParseTxs_FailedTxWithNonstandardErrorCode(t *testing.T) {
 	}
 	block, now := testsuite.CreateTestBlock(txRes, true)
 	ctx := decode.NewContext()
-	resultTxs, err := parseTxs(block, &ctx)
+	resultTxs, err := parseTxs(block, storageTypes.CompressionNone, &ctx)
 
 	assert.NoError(t, err)
 	assert.Len(t, resultTxs, 1)
@@ -6,11 +6,27 @@ package parser
 import (
 	"context"
 
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/workerpool"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 )
 
+// Module - decodes blocks received from input. If threads count is greater than 1 blocks are parsed concurrently and pushed to output in the received order.
+//
+//	                       |----------------|
+//	                       |                |
+//	-- types.BlockData ->  |     MODULE     | -- *storage.Block ->
+//	                       |                |
+//	                       |----------------|
 type Module struct {
 	modules.BaseModule
+
+	threadsCount int
+	compression  storageTypes.Compression
+	pool         *workerpool.Pool[parseTask]
+	results      chan parseResult
+	inflight     chan struct{}
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -21,24 +37,53 @@ const (
 	StopOutput = "stop"
 )
 
-func NewModule() Module {
+func NewModule(cfg config.Indexer) Module {
 	m := Module{
-		BaseModule: modules.New("parser"),
+		BaseModule:   modules.New("parser"),
+		threadsCount: int(cfg.ParseThreadsCount),
+		compression:  storageTypes.CompressionNone,
+	}
+	if cfg.RawCompression != "" {
+		m.compression = storageTypes.Compression(cfg.RawCompression)
 	}
 	m.CreateInput(InputName)
 	m.CreateOutput(OutputName)
 	m.CreateOutput(StopOutput)
 
+	if m.isParallel() {
+		m.results = make(chan parseResult, m.threadsCount)
+		m.inflight = make(chan struct{}, m.threadsCount*inflightMultiplier)
+	}
+
 	return m
 }
 
 func (p *Module) Start(ctx context.Context) {
-	p.Log.Info().Msg("starting parser module...")
+	p.Log.Info().Int("threads", p.threadsCount).Msg("starting parser module...")
+	if p.isParallel() {
+		// pool is created here to bind workers to the started module instead of its copy returned by constructor
+		p.pool = workerpool.NewPool(p.worker, p.threadsCount)
+		p.pool.Start(ctx)
+		p.G.GoCtx(ctx, p.sequencer)
+	}
 	p.G.GoCtx(ctx, p.listen)
 }
 
 func (p *Module) Close() error {
 	p.Log.Info().Msg("closing...")
 	p.G.Wait()
+
+	if p.isParallel() {
+		if p.pool != nil {
+			if err := p.pool.Close(); err != nil {
+				return err
+			}
+		}
+		close(p.results)
+	}
 	return nil
 }
+
+func (p *Module) isParallel() bool {
+	return p.threadsCount > 1
+}
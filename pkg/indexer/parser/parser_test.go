@@ -5,13 +5,17 @@ package parser
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	cometTypes "github.com/cometbft/cometbft/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
+	"github.com/rs/zerolog"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,10 +23,16 @@ import (
 var testTime = time.Now()
 
 func createModules(t *testing.T) (modules.BaseModule, string, Module) {
+	return createModulesWithThreads(t, 0)
+}
+
+func createModulesWithThreads(t testing.TB, threadsCount uint32) (modules.BaseModule, string, Module) {
 	writerModule := modules.New("writer-module")
 	outputName := "write"
 	writerModule.CreateOutput(outputName)
-	parserModule := NewModule()
+	parserModule := NewModule(config.Indexer{
+		ParseThreadsCount: threadsCount,
+	})
 
 	err := parserModule.AttachTo(&writerModule, outputName, InputName)
 	assert.NoError(t, err)
@@ -238,3 +248,88 @@ func TestModule_OnParseError(t *testing.T) {
 		}
 	}
 }
+
+func TestParserModule_ParallelKeepsOrder(t *testing.T) {
+	writerModule, outputName, parserModule := createModulesWithThreads(t, 4)
+
+	readerModule := modules.New("reader-module")
+	readerInputName := "read"
+	readerModule.CreateInput(readerInputName)
+	err := readerModule.AttachTo(&parserModule, OutputName, readerInputName)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	parserModule.Start(ctx)
+
+	blocks := testsuite.RecordedBlocks(100, 50, 10)
+	go func() {
+		for i := range blocks {
+			writerModule.MustOutput(outputName).Push(blocks[i])
+		}
+	}()
+
+	for i := range blocks {
+		select {
+		case <-ctx.Done():
+			t.Fatal("stop by cancelled context")
+		case msg, ok := <-readerModule.MustInput(readerInputName).Listen():
+			assert.True(t, ok, "received value should be delivered by successful send operation")
+
+			parsedBlock, ok := msg.(*storage.Block)
+			assert.Truef(t, ok, "invalid message type: %T", msg)
+			assert.Equal(t, blocks[i].Height, parsedBlock.Height)
+			assert.Len(t, parsedBlock.Txs, 10)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	blocks := testsuite.RecordedBlocks(1, 200, 100)
+
+	for _, threads := range []uint32{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("threads_%d", threads), func(b *testing.B) {
+			writerModule, outputName, parserModule := createModulesWithThreads(b, threads)
+			parserModule.Log = zerolog.Nop()
+
+			readerModule := modules.New("reader-module")
+			readerInputName := "read"
+			readerModule.CreateInput(readerInputName)
+			if err := readerModule.AttachTo(&parserModule, OutputName, readerInputName); err != nil {
+				b.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			parserModule.Start(ctx)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				go func() {
+					for i := range blocks {
+						writerModule.MustOutput(outputName).Push(blocks[i])
+					}
+				}()
+
+				for i := range blocks {
+					msg := <-readerModule.MustInput(readerInputName).Listen()
+					parsedBlock, ok := msg.(*storage.Block)
+					if !ok {
+						b.Fatalf("invalid message type: %T", msg)
+					}
+					if parsedBlock.Height != blocks[i].Height {
+						b.Fatalf("unexpected block order: %d != %d", parsedBlock.Height, blocks[i].Height)
+					}
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(len(blocks)*b.N)/b.Elapsed().Seconds(), "blocks/s")
+
+			cancel()
+			if err := parserModule.Close(); err != nil {
+				b.Fatal(err)
+			}
+		})
+	}
+}
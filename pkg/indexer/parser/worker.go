@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+// count of blocks per worker which can be parsed but not pushed to output yet
+const inflightMultiplier = 4
+
+type parseTask struct {
+	seq   uint64
+	block types.BlockData
+}
+
+type parseResult struct {
+	seq    uint64
+	height types.Level
+	block  *storage.Block
+	err    error
+}
+
+func (p *Module) worker(ctx context.Context, task parseTask) {
+	block, err := p.parse(task.block)
+	select {
+	case <-ctx.Done():
+	case p.results <- parseResult{
+		seq:    task.seq,
+		height: task.block.Height,
+		block:  block,
+		err:    err,
+	}:
+	}
+}
+
+// sequencer - restores the order of parsed blocks and pushes them to output
+func (p *Module) sequencer(ctx context.Context) {
+	var (
+		next    uint64
+		ordered = make(map[uint64]parseResult)
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result, ok := <-p.results:
+			if !ok {
+				return
+			}
+			ordered[result.seq] = result
+
+			r, ok := ordered[next]
+			for ok {
+				delete(ordered, next)
+				next++
+				<-p.inflight
+
+				if r.err != nil {
+					p.Log.Err(r.err).
+						Uint64("height", uint64(r.height)).
+						Msg("block parsing error")
+					p.MustOutput(StopOutput).Push(struct{}{})
+				} else {
+					p.MustOutput(OutputName).Push(r.block)
+				}
+
+				r, ok = ordered[next]
+			}
+		}
+	}
+}
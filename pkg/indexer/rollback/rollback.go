@@ -10,6 +10,7 @@ import (
 	"github.com/celenium-io/astria-indexer/pkg/node"
 
 	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/sink"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -40,6 +41,7 @@ type Module struct {
 	blocks    storage.IBlock
 	node      node.Api
 	indexName string
+	sinks     sink.Sinks
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -50,6 +52,7 @@ func NewModule(
 	blocks storage.IBlock,
 	node node.Api,
 	cfg config.Indexer,
+	sinks ...sink.Sink,
 ) Module {
 	module := Module{
 		BaseModule: modules.New("rollback"),
@@ -58,6 +61,7 @@ func NewModule(
 		blocks:     blocks,
 		node:       node,
 		indexName:  cfg.Name,
+		sinks:      sinks,
 	}
 
 	module.CreateInput(InputName)
@@ -132,13 +136,24 @@ func (module *Module) rollback(ctx context.Context) error {
 				Hex("node_block_hash", nodeBlock.BlockID.Hash).
 				Msg("need rollback")
 
-			if err := module.rollbackBlock(ctx, lastBlock.Height); err != nil {
+			if err := module.rollbackBlock(ctx, lastBlock); err != nil {
 				return errors.Wrapf(err, "rollback block: %d", lastBlock.Height)
 			}
+			module.retract(ctx, lastBlock)
 		}
 	}
 }
 
+// retract - notifies sinks that events of the rolled back block are not valid anymore
+func (module *Module) retract(ctx context.Context, block storage.Block) {
+	if len(module.sinks) == 0 {
+		return
+	}
+	if err := module.sinks.Emit(ctx, []sink.Event{sink.RetractionEvent(block)}); err != nil {
+		module.Log.Err(err).Msg("retraction emitting error")
+	}
+}
+
 func (module *Module) finish(ctx context.Context) error {
 	newState, err := module.state.ByName(ctx, module.indexName)
 	if err != nil {
@@ -153,14 +168,19 @@ func (module *Module) finish(ctx context.Context) error {
 	return nil
 }
 
-func (module *Module) rollbackBlock(ctx context.Context, height types.Level) error {
+func (module *Module) rollbackBlock(ctx context.Context, block storage.Block) error {
 	tx, err := postgres.BeginTransaction(ctx, module.tx)
 	if err != nil {
 		return err
 	}
 	defer tx.Close(ctx)
 
-	if err := rollbackBlock(ctx, tx, height, module.indexName); err != nil {
+	// retraction is committed together with rollback by transactional sinks
+	if err := module.sinks.EmitTx(ctx, tx, []sink.Event{sink.RetractionEvent(block)}); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := rollbackBlock(ctx, tx, block.Height, module.indexName); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
@@ -182,6 +202,14 @@ func rollbackBlock(ctx context.Context, tx storage.Transaction, height types.Lev
 		return err
 	}
 
+	if err := tx.RollbackRawTxs(ctx, height); err != nil {
+		return err
+	}
+
+	if err := tx.RollbackRawBlock(ctx, height); err != nil {
+		return err
+	}
+
 	actions, err := tx.RollbackActions(ctx, height)
 	if err != nil {
 		return err
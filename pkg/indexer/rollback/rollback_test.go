@@ -69,6 +69,18 @@ func Test_rollbackBlock(t *testing.T) {
 			MaxTimes(1).
 			MinTimes(1)
 
+		tx.EXPECT().
+			RollbackRawTxs(ctx, height).
+			Return(nil).
+			MaxTimes(1).
+			MinTimes(1)
+
+		tx.EXPECT().
+			RollbackRawBlock(ctx, height).
+			Return(nil).
+			MaxTimes(1).
+			MinTimes(1)
+
 		tx.EXPECT().
 			RollbackActions(ctx, height).
 			Return([]storage.Action{
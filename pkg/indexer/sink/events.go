@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// BlockEvents - converts saved block to the sequence of events: block, then every transaction followed by its actions and balance updates
+func BlockEvents(block *storage.Block) []Event {
+	blockData := BlockData{
+		Hash:       block.Hash,
+		ParentHash: block.ParentHash,
+		Proposer:   block.ProposerAddress,
+		TxCount:    int64(len(block.Txs)),
+	}
+	if block.Stats != nil {
+		blockData.Fee = block.Stats.Fee.String()
+		blockData.SupplyChange = block.Stats.SupplyChange.String()
+	}
+
+	events := []Event{
+		{
+			Type:   EventBlock,
+			Height: block.Height,
+			Time:   block.Time,
+			Data:   blockData,
+		},
+	}
+
+	for _, tx := range block.Txs {
+		txData := TxData{
+			Hash:         tx.Hash,
+			Position:     tx.Position,
+			Status:       tx.Status.String(),
+			Error:        tx.Error,
+			Nonce:        tx.Nonce,
+			ActionsCount: tx.ActionsCount,
+			GasWanted:    tx.GasWanted,
+			GasUsed:      tx.GasUsed,
+		}
+		if tx.Signer != nil {
+			txData.Signer = tx.Signer.String()
+		}
+		events = append(events, Event{
+			Type:   EventTx,
+			Height: block.Height,
+			Time:   block.Time,
+			Data:   txData,
+		})
+
+		for i := range tx.Actions {
+			events = append(events, Event{
+				Type:   EventAction,
+				Height: block.Height,
+				Time:   block.Time,
+				Data: ActionData{
+					TxHash:   tx.Hash,
+					Position: tx.Actions[i].Position,
+					Type:     tx.Actions[i].Type.String(),
+					Data:     tx.Actions[i].Data,
+				},
+			})
+
+			for _, update := range tx.Actions[i].BalanceUpdates {
+				data := BalanceUpdateData{
+					TxHash:   tx.Hash,
+					Currency: update.Currency,
+					Update:   update.Update.String(),
+				}
+				if update.Address != nil {
+					data.Address = update.Address.String()
+				}
+				events = append(events, Event{
+					Type:   EventBalanceUpdate,
+					Height: block.Height,
+					Time:   block.Time,
+					Data:   data,
+				})
+			}
+		}
+	}
+
+	return events
+}
+
+// RetractionEvent - returns event which cancels all events of the rolled back block
+func RetractionEvent(block storage.Block) Event {
+	return Event{
+		Type:    EventBlock,
+		Height:  block.Height,
+		Time:    block.Time,
+		Retract: true,
+		Data: BlockData{
+			Hash: block.Hash,
+		},
+	}
+}
+
+// Sinks - set of sinks which receive the same events
+type Sinks []Sink
+
+// Start - starts all sinks
+func (s Sinks) Start(ctx context.Context) {
+	for i := range s {
+		s[i].Start(ctx)
+	}
+}
+
+// Emit - sends events to all sinks except transactional ones. Error of one sink doesn't stop emitting to others.
+func (s Sinks) Emit(ctx context.Context, events []Event) error {
+	var result error
+	for i := range s {
+		if _, ok := s[i].(TxSink); ok {
+			continue
+		}
+		if err := s[i].Emit(ctx, events); err != nil {
+			err = errors.Wrap(err, s[i].Name())
+			if result == nil {
+				result = err
+			} else {
+				result = errors.Wrap(result, err.Error())
+			}
+		}
+	}
+	return result
+}
+
+// HasTx - returns true if there is at least one transactional sink
+func (s Sinks) HasTx() bool {
+	for i := range s {
+		if _, ok := s[i].(TxSink); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// EmitTx - saves events of transactional sinks in the transaction. The transaction must be rolled back on error.
+func (s Sinks) EmitTx(ctx context.Context, tx storage.Transaction, events []Event) error {
+	for i := range s {
+		txSink, ok := s[i].(TxSink)
+		if !ok {
+			continue
+		}
+		if err := txSink.EmitTx(ctx, tx, events); err != nil {
+			return errors.Wrap(err, txSink.Name())
+		}
+	}
+	return nil
+}
+
+// Close - closes all sinks
+func (s Sinks) Close() error {
+	var result error
+	for i := range s {
+		if err := s[i].Close(); err != nil && result == nil {
+			result = errors.Wrap(err, s[i].Name())
+		}
+	}
+	return result
+}
+
+func lastHeight(events []Event) types.Level {
+	if len(events) == 0 {
+		return 0
+	}
+	return events[len(events)-1].Height
+}
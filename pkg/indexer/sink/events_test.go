@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockEvents(t *testing.T) {
+	now := time.Now()
+	signer := &storage.Address{Hash: testsuite.MustHexDecode("deadbeaf")}
+	block := &storage.Block{
+		Height: 100,
+		Time:   now,
+		Hash:   testsuite.MustHexDecode("0102"),
+		Stats: &storage.BlockStats{
+			Fee:          decimal.RequireFromString("10"),
+			SupplyChange: decimal.Zero,
+		},
+		Txs: []*storage.Tx{
+			{
+				Hash:         testsuite.MustHexDecode("0304"),
+				Status:       storageTypes.StatusSuccess,
+				Signer:       signer,
+				Nonce:        1,
+				ActionsCount: 1,
+				Actions: []storage.Action{
+					{
+						Type: storageTypes.ActionTypeTransfer,
+						Data: map[string]any{
+							"amount": "100",
+						},
+						BalanceUpdates: []storage.BalanceUpdate{
+							{
+								Address:  signer,
+								Currency: "nria",
+								Update:   decimal.RequireFromString("-100"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	events := BlockEvents(block)
+	require.Len(t, events, 4)
+
+	require.Equal(t, EventBlock, events[0].Type)
+	require.EqualValues(t, 100, events[0].Height)
+	blockData, ok := events[0].Data.(BlockData)
+	require.True(t, ok)
+	require.EqualValues(t, 1, blockData.TxCount)
+	require.Equal(t, "10", blockData.Fee)
+
+	require.Equal(t, EventTx, events[1].Type)
+	txData, ok := events[1].Data.(TxData)
+	require.True(t, ok)
+	require.Equal(t, "deadbeaf", txData.Signer)
+	require.Equal(t, "success", txData.Status)
+
+	require.Equal(t, EventAction, events[2].Type)
+	actionData, ok := events[2].Data.(ActionData)
+	require.True(t, ok)
+	require.Equal(t, "transfer", actionData.Type)
+
+	require.Equal(t, EventBalanceUpdate, events[3].Type)
+	updateData, ok := events[3].Data.(BalanceUpdateData)
+	require.True(t, ok)
+	require.Equal(t, "-100", updateData.Update)
+	require.Equal(t, "deadbeaf", updateData.Address)
+
+	for i := range events {
+		require.False(t, events[i].Retract)
+	}
+}
+
+func TestRetractionEvent(t *testing.T) {
+	event := RetractionEvent(storage.Block{
+		Height: 100,
+		Hash:   testsuite.MustHexDecode("0102"),
+	})
+	require.Equal(t, EventBlock, event.Type)
+	require.True(t, event.Retract)
+	require.EqualValues(t, 100, event.Height)
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultFileMaxSize = 100 // megabytes
+	fileExtension      = ".ndjson"
+)
+
+// File - sink which writes events as newline-delimited JSON. Current file is rotated when its size exceeds the limit.
+type File struct {
+	dir      string
+	prefix   string
+	maxSize  int64
+	maxFiles int
+
+	file *os.File
+	size int64
+	mx   *sync.Mutex
+}
+
+var _ Sink = (*File)(nil)
+
+// NewFile -
+func NewFile(cfg config.FileSink) (*File, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "creating sink directory")
+	}
+
+	maxSize := int64(defaultFileMaxSize)
+	if cfg.MaxSize > 0 {
+		maxSize = cfg.MaxSize
+	}
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = "events"
+	}
+
+	f := &File{
+		dir:      cfg.Dir,
+		prefix:   prefix,
+		maxSize:  maxSize * 1024 * 1024,
+		maxFiles: cfg.MaxFiles,
+		mx:       new(sync.Mutex),
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Name -
+func (f *File) Name() string {
+	return "file"
+}
+
+// Start -
+func (f *File) Start(ctx context.Context) {}
+
+// Emit - appends events to the current file
+func (f *File) Emit(ctx context.Context, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var buf strings.Builder
+	for i := range events {
+		raw, err := json.Marshal(events[i])
+		if err != nil {
+			return errors.Wrap(err, "marshal event")
+		}
+		buf.Write(raw)
+		buf.WriteByte('\n')
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	n, err := f.file.WriteString(buf.String())
+	f.size += int64(n)
+	if err != nil {
+		return err
+	}
+	if err := f.file.Sync(); err != nil {
+		return err
+	}
+
+	if f.size >= f.maxSize {
+		return f.rotate()
+	}
+	return nil
+}
+
+// Close -
+func (f *File) Close() error {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *File) currentPath() string {
+	return filepath.Join(f.dir, f.prefix+fileExtension)
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.currentPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "opening sink file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	rotated := filepath.Join(f.dir, fmt.Sprintf("%s-%d%s", f.prefix, time.Now().UnixNano(), fileExtension))
+	if err := os.Rename(f.currentPath(), rotated); err != nil {
+		return errors.Wrap(err, "rotating sink file")
+	}
+	if err := f.removeOld(); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *File) removeOld() error {
+	if f.maxFiles <= 0 {
+		return nil
+	}
+
+	rotated, err := filepath.Glob(filepath.Join(f.dir, f.prefix+"-*"+fileExtension))
+	if err != nil {
+		return err
+	}
+	if len(rotated) <= f.maxFiles {
+		return nil
+	}
+
+	sort.Strings(rotated)
+	for _, name := range rotated[:len(rotated)-f.maxFiles] {
+		if err := os.Remove(name); err != nil {
+			return errors.Wrap(err, "removing old sink file")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Emit(t *testing.T) {
+	dir := t.TempDir()
+	f, err := NewFile(config.FileSink{Dir: dir})
+	require.NoError(t, err)
+
+	err = f.Emit(context.Background(), []Event{
+		{Type: EventBlock, Height: 1},
+		{Type: EventTx, Height: 1},
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	file, err := os.Open(filepath.Join(dir, "events.ndjson"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	var count int
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		require.EqualValues(t, 1, event.Height)
+		count++
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 2, count)
+}
+
+func TestFile_Rotate(t *testing.T) {
+	dir := t.TempDir()
+	f, err := NewFile(config.FileSink{Dir: dir, MaxFiles: 2})
+	require.NoError(t, err)
+	// rotate on every write
+	f.maxSize = 1
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, f.Emit(context.Background(), []Event{{Type: EventBlock}}))
+	}
+	require.NoError(t, f.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "events-*.ndjson"))
+	require.NoError(t, err)
+	require.Len(t, rotated, 2)
+
+	info, err := os.Stat(filepath.Join(dir, "events.ndjson"))
+	require.NoError(t, err)
+	require.Zero(t, info.Size())
+}
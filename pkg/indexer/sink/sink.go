@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+// Sink - output for events of indexed data. Emit is called after data was committed to the database.
+type Sink interface {
+	io.Closer
+
+	Name() string
+	Start(ctx context.Context)
+	Emit(ctx context.Context, events []Event) error
+}
+
+// TxSink - sink which saves events in the database transaction of indexed data instead of Emit.
+// Events are committed atomically with the data, so they are not lost if the indexer stops after commit.
+type TxSink interface {
+	Sink
+
+	EmitTx(ctx context.Context, tx storage.Transaction, events []Event) error
+}
+
+type EventType string
+
+const (
+	EventBlock         EventType = "block"
+	EventTx            EventType = "tx"
+	EventAction        EventType = "action"
+	EventBalanceUpdate EventType = "balance_update"
+)
+
+// Event - normalized event of indexed data. If Retract is true the event cancels all previously emitted events on the height.
+type Event struct {
+	Type    EventType   `json:"type"`
+	Height  types.Level `json:"height"`
+	Time    time.Time   `json:"time"`
+	Retract bool        `json:"retract,omitempty"`
+	Data    any         `json:"data,omitempty"`
+}
+
+type BlockData struct {
+	Hash         types.Hex `json:"hash"`
+	ParentHash   types.Hex `json:"parent_hash,omitempty"`
+	Proposer     string    `json:"proposer,omitempty"`
+	TxCount      int64     `json:"tx_count"`
+	Fee          string    `json:"fee,omitempty"`
+	SupplyChange string    `json:"supply_change,omitempty"`
+}
+
+type TxData struct {
+	Hash         types.Hex `json:"hash"`
+	Position     int64     `json:"position"`
+	Status       string    `json:"status"`
+	Error        string    `json:"error,omitempty"`
+	Signer       string    `json:"signer"`
+	Nonce        uint32    `json:"nonce"`
+	ActionsCount int64     `json:"actions_count"`
+	GasWanted    int64     `json:"gas_wanted"`
+	GasUsed      int64     `json:"gas_used"`
+}
+
+type ActionData struct {
+	TxHash   types.Hex      `json:"tx_hash"`
+	Position int64          `json:"position"`
+	Type     string         `json:"type"`
+	Data     map[string]any `json:"data,omitempty"`
+}
+
+type BalanceUpdateData struct {
+	TxHash   types.Hex `json:"tx_hash"`
+	Address  string    `json:"address"`
+	Currency string    `json:"currency"`
+	Update   string    `json:"update"`
+}
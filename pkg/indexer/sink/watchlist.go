@@ -0,0 +1,419 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultWatchlistMaxRetries = 5
+	watchlistPollInterval      = time.Second
+	watchlistBatchSize         = 100
+	// events of blocks older than the threshold are initial sync data: rules aren't evaluated on them
+	watchlistSyncThreshold = time.Hour
+)
+
+// Watchlist - sink which evaluates stored watchlist rules against events of saved blocks.
+// Matched alerts are saved to the database, published to the notification channel
+// and posted to the webhook of the rule if it's set. Webhooks are delivered from the database in the same way as outbox events,
+// so alerts which weren't delivered before restart are sent after it. Rolled back blocks don't retract fired alerts.
+// Alerts aren't fired during initial sync, so history of the chain doesn't flood the alerts queue.
+type Watchlist struct {
+	watchlists  storage.IWatchlist
+	notificator storage.Notificator
+	maxRetries  int
+	client      *http.Client
+	log         zerolog.Logger
+	wg          *sync.WaitGroup
+}
+
+var _ Sink = (*Watchlist)(nil)
+
+// NewWatchlist -
+func NewWatchlist(cfg config.WatchlistSink, watchlists storage.IWatchlist, notificator storage.Notificator) *Watchlist {
+	timeout := defaultWebhookTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	maxRetries := defaultWatchlistMaxRetries
+	if cfg.MaxRetries > 0 {
+		maxRetries = cfg.MaxRetries
+	}
+	return &Watchlist{
+		watchlists:  watchlists,
+		notificator: notificator,
+		maxRetries:  maxRetries,
+		client:      &http.Client{Timeout: timeout},
+		log:         log.With().Str("sink", "watchlist").Logger(),
+		wg:          new(sync.WaitGroup),
+	}
+}
+
+// Name -
+func (w *Watchlist) Name() string {
+	return "watchlist"
+}
+
+// Start - runs delivery of alerts to webhooks
+func (w *Watchlist) Start(ctx context.Context) {
+	w.wg.Add(1)
+	go w.deliver(ctx)
+}
+
+// Emit - matches events with enabled rules and fires alerts
+func (w *Watchlist) Emit(ctx context.Context, events []Event) error {
+	events = liveEvents(events)
+	if len(events) == 0 {
+		return nil
+	}
+
+	rules, err := w.watchlists.Active(ctx)
+	if err != nil {
+		return errors.Wrap(err, "receiving watchlist rules")
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	alerts := MatchAlerts(rules, events)
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	if err := w.watchlists.SaveAlerts(ctx, alerts...); err != nil {
+		return errors.Wrap(err, "saving alerts")
+	}
+
+	for i := range alerts {
+		if err := w.notificator.Notify(ctx, storage.ChannelAlert, strconv.FormatUint(alerts[i].Id, 10)); err != nil {
+			w.log.Err(err).Uint64("id", alerts[i].Id).Msg("alert notification")
+		}
+	}
+	return nil
+}
+
+// liveEvents - drops events of blocks which are far behind the head
+func liveEvents(events []Event) []Event {
+	live := make([]Event, 0, len(events))
+	for i := range events {
+		if time.Since(events[i].Time) > watchlistSyncThreshold {
+			continue
+		}
+		live = append(live, events[i])
+	}
+	return live
+}
+
+// Close -
+func (w *Watchlist) Close() error {
+	w.wg.Wait()
+	return nil
+}
+
+func (w *Watchlist) deliver(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(watchlistPollInterval)
+	defer ticker.Stop()
+
+	// alerts left undelivered before restart are read on the first tick
+	var lastId uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			id, err := w.deliverPending(ctx, lastId)
+			if err != nil {
+				w.log.Err(err).Msg("alerts delivery")
+			}
+			lastId = id
+		}
+	}
+}
+
+// deliverPending - posts undelivered alerts fired after `lastId` and returns id of the last processed alert.
+// Alerts failed after all attempts aren't retried until restart.
+func (w *Watchlist) deliverPending(ctx context.Context, lastId uint64) (uint64, error) {
+	for {
+		alerts, err := w.watchlists.Undelivered(ctx, lastId, watchlistBatchSize)
+		if err != nil {
+			return lastId, errors.Wrap(err, "receiving undelivered alerts")
+		}
+
+		for i := range alerts {
+			if err := w.deliverAlert(ctx, &alerts[i]); err != nil {
+				if ctx.Err() != nil {
+					return lastId, nil
+				}
+				w.log.Err(err).
+					Uint64("id", alerts[i].Id).
+					Uint64("watchlist_id", alerts[i].WatchlistId).
+					Msg("alert delivery failed after all attempts")
+			} else if err := w.watchlists.SetDelivered(ctx, alerts[i].Id); err != nil {
+				return lastId, errors.Wrap(err, "marking alert as delivered")
+			}
+			lastId = alerts[i].Id
+		}
+
+		if len(alerts) < watchlistBatchSize {
+			return lastId, nil
+		}
+	}
+}
+
+func (w *Watchlist) deliverAlert(ctx context.Context, alert *storage.WatchlistAlert) error {
+	body, err := json.Marshal(NewAlertData(*alert))
+	if err != nil {
+		return errors.Wrap(err, "marshal alert")
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = w.send(ctx, alert, body)
+		if err == nil || attempt >= w.maxRetries {
+			return err
+		}
+
+		w.log.Warn().
+			Err(err).
+			Uint64("id", alert.Id).
+			Int("attempt", attempt).
+			Msg("can't deliver alert")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff(attempt)):
+		}
+	}
+}
+
+func (w *Watchlist) send(ctx context.Context, alert *storage.WatchlistAlert, body []byte) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, alert.Watchlist.WebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventId, strconv.FormatUint(alert.Id, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if alert.Watchlist.Secret != "" {
+		req.Header.Set(HeaderSignature, Signature([]byte(alert.Watchlist.Secret), timestamp, body))
+	}
+
+	response, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errors.Errorf("unexpected response status: %d", response.StatusCode)
+	}
+	return nil
+}
+
+// AlertData - body of the webhook request sent on fired alert
+type AlertData struct {
+	Id          uint64         `json:"id"`
+	WatchlistId uint64         `json:"watchlist_id"`
+	Name        string         `json:"name,omitempty"`
+	Height      pkgTypes.Level `json:"height"`
+	Time        time.Time      `json:"time"`
+	TxHash      pkgTypes.Hex   `json:"tx_hash"`
+	Position    int64          `json:"position"`
+	ActionType  string         `json:"action_type"`
+	Amount      string         `json:"amount"`
+	Currency    string         `json:"currency,omitempty"`
+	Direction   string         `json:"direction"`
+}
+
+// NewAlertData -
+func NewAlertData(alert storage.WatchlistAlert) AlertData {
+	data := AlertData{
+		Id:          alert.Id,
+		WatchlistId: alert.WatchlistId,
+		Height:      alert.Height,
+		Time:        alert.Time,
+		TxHash:      alert.TxHash,
+		Position:    alert.Position,
+		ActionType:  alert.ActionType.String(),
+		Amount:      alert.Amount.String(),
+		Currency:    alert.Currency,
+		Direction:   alert.Direction.String(),
+	}
+	if alert.Watchlist != nil {
+		data.Name = alert.Watchlist.Name
+	}
+	return data
+}
+
+// MatchAlerts - returns alerts fired by rules on the sequence of events.
+// Address rules are matched by balance updates of the address. Actions without balance updates
+// are matched by the transaction signer if rule has no threshold and allows outgoing direction.
+// Rollup rules are matched by actions containing rollup id. Amount of sequence action is its data size.
+func MatchAlerts(rules []storage.Watchlist, events []Event) []*storage.WatchlistAlert {
+	m := matcher{
+		rules:   rules,
+		alerts:  make([]*storage.WatchlistAlert, 0),
+		matched: make(map[uint64]struct{}),
+	}
+
+	for i := range events {
+		if events[i].Retract {
+			continue
+		}
+
+		switch data := events[i].Data.(type) {
+		case BlockData:
+			m.finishAction()
+			m.tx = nil
+		case TxData:
+			m.finishAction()
+			m.tx = &data
+		case ActionData:
+			m.finishAction()
+			m.startAction(events[i], data)
+		case BalanceUpdateData:
+			m.balanceUpdate(data)
+		}
+	}
+	m.finishAction()
+
+	return m.alerts
+}
+
+type matcher struct {
+	rules  []storage.Watchlist
+	alerts []*storage.WatchlistAlert
+
+	tx      *TxData
+	event   Event
+	action  *ActionData
+	mask    types.ActionTypeMask
+	matched map[uint64]struct{}
+}
+
+func (m *matcher) startAction(event Event, action ActionData) {
+	m.event = event
+	m.action = &action
+	m.mask = types.NewActionTypeMask(action.Type)
+
+	rollupId, ok := action.Data["rollup_id"].([]byte)
+	if !ok {
+		return
+	}
+	amount := decimal.Zero
+	if data, ok := action.Data["data"].([]byte); ok {
+		amount = decimal.NewFromInt(int64(len(data)))
+	}
+
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if !rule.IsRollup() || !bytes.Equal(rule.RollupId, rollupId) || !m.matchType(rule) {
+			continue
+		}
+		if amount.LessThan(rule.Threshold) {
+			continue
+		}
+		m.fire(rule, amount, "", types.DirectionAny)
+	}
+}
+
+func (m *matcher) balanceUpdate(update BalanceUpdateData) {
+	if m.action == nil {
+		return
+	}
+	amount, err := decimal.NewFromString(update.Update)
+	if err != nil {
+		return
+	}
+	direction := types.DirectionIn
+	if amount.IsNegative() {
+		direction = types.DirectionOut
+	}
+	amount = amount.Abs()
+
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if rule.IsRollup() || !strings.EqualFold(rule.AddressString(), update.Address) || !m.matchType(rule) {
+			continue
+		}
+		if rule.Currency != "" && rule.Currency != update.Currency {
+			continue
+		}
+		if !matchDirection(rule.Direction, direction) || amount.LessThan(rule.Threshold) {
+			continue
+		}
+		m.fire(rule, amount, update.Currency, direction)
+	}
+}
+
+func (m *matcher) finishAction() {
+	if m.action == nil {
+		return
+	}
+
+	if m.tx != nil {
+		for i := range m.rules {
+			rule := &m.rules[i]
+			if _, ok := m.matched[rule.Id]; ok {
+				continue
+			}
+			if rule.IsRollup() || !rule.Threshold.IsZero() || !matchDirection(rule.Direction, types.DirectionOut) {
+				continue
+			}
+			if !strings.EqualFold(rule.AddressString(), m.tx.Signer) || !m.matchType(rule) {
+				continue
+			}
+			m.fire(rule, decimal.Zero, "", types.DirectionOut)
+		}
+	}
+
+	m.action = nil
+	clear(m.matched)
+}
+
+func (m *matcher) matchType(rule *storage.Watchlist) bool {
+	return rule.ActionTypes == 0 || rule.ActionTypes&m.mask.Bits != 0
+}
+
+func (m *matcher) fire(rule *storage.Watchlist, amount decimal.Decimal, currency string, direction types.Direction) {
+	alert := &storage.WatchlistAlert{
+		WatchlistId: rule.Id,
+		Height:      m.event.Height,
+		Time:        m.event.Time,
+		Position:    m.action.Position,
+		ActionType:  types.ActionType(m.action.Type),
+		Amount:      amount,
+		Currency:    currency,
+		Direction:   direction,
+		TxHash:      m.action.TxHash,
+		Watchlist:   rule,
+	}
+	m.alerts = append(m.alerts, alert)
+	m.matched[rule.Id] = struct{}{}
+}
+
+func matchDirection(expected, actual types.Direction) bool {
+	return expected == "" || expected == types.DirectionAny || expected == actual
+}
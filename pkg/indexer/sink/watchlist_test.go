@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTreasury = "2e046327a2ccac7c8f8018ed44e43184b502eb3e"
+	testReceiver = "230592632006db2733444bb6de11db3f4b2f9ae4"
+)
+
+func testWatchlistEvents(ts time.Time) []Event {
+	rollupId := testsuite.MustHexDecode("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539")
+	txHash := testsuite.MustHexDecode("20b0e6310801e7b2a16c69aace7b1a1d550e5c49c80f546941bb1ac747487fe5")
+	return []Event{
+		{Type: EventBlock, Height: 100, Time: ts, Data: BlockData{}},
+		{Type: EventTx, Height: 100, Time: ts, Data: TxData{Hash: txHash, Signer: testTreasury}},
+		{Type: EventAction, Height: 100, Time: ts, Data: ActionData{
+			TxHash:   txHash,
+			Position: 0,
+			Type:     string(types.ActionTypeTransfer),
+		}},
+		{Type: EventBalanceUpdate, Height: 100, Time: ts, Data: BalanceUpdateData{
+			TxHash:   txHash,
+			Address:  testTreasury,
+			Currency: "nria",
+			Update:   "-1000",
+		}},
+		{Type: EventBalanceUpdate, Height: 100, Time: ts, Data: BalanceUpdateData{
+			TxHash:   txHash,
+			Address:  testReceiver,
+			Currency: "nria",
+			Update:   "1000",
+		}},
+		{Type: EventAction, Height: 100, Time: ts, Data: ActionData{
+			TxHash:   txHash,
+			Position: 1,
+			Type:     string(types.ActionTypeSequence),
+			Data: map[string]any{
+				"rollup_id": rollupId,
+				"data":      []byte{1, 2, 3, 4},
+			},
+		}},
+	}
+}
+
+func TestMatchAlerts(t *testing.T) {
+	tests := []struct {
+		name string
+		rule storage.Watchlist
+		want []storage.WatchlistAlert
+	}{
+		{
+			name: "outgoing transfer above threshold",
+			rule: storage.Watchlist{
+				Id:          1,
+				Address:     testsuite.MustHexDecode(testTreasury),
+				ActionTypes: types.ActionTypeTransferBits,
+				Threshold:   decimal.RequireFromString("100"),
+				Direction:   types.DirectionOut,
+			},
+			want: []storage.WatchlistAlert{
+				{
+					WatchlistId: 1,
+					ActionType:  types.ActionTypeTransfer,
+					Amount:      decimal.RequireFromString("1000"),
+					Currency:    "nria",
+					Direction:   types.DirectionOut,
+				},
+			},
+		}, {
+			name: "threshold is not reached",
+			rule: storage.Watchlist{
+				Id:        2,
+				Address:   testsuite.MustHexDecode(testReceiver),
+				Threshold: decimal.RequireFromString("10000"),
+				Direction: types.DirectionAny,
+			},
+			want: []storage.WatchlistAlert{},
+		}, {
+			name: "wrong direction",
+			rule: storage.Watchlist{
+				Id:        3,
+				Address:   testsuite.MustHexDecode(testReceiver),
+				Direction: types.DirectionOut,
+			},
+			want: []storage.WatchlistAlert{},
+		}, {
+			name: "signed action without balance updates",
+			rule: storage.Watchlist{
+				Id:          4,
+				Address:     testsuite.MustHexDecode(testTreasury),
+				ActionTypes: types.ActionTypeSequenceBits,
+				Direction:   types.DirectionAny,
+			},
+			want: []storage.WatchlistAlert{
+				{
+					WatchlistId: 4,
+					Position:    1,
+					ActionType:  types.ActionTypeSequence,
+					Amount:      decimal.Zero,
+					Direction:   types.DirectionOut,
+				},
+			},
+		}, {
+			name: "rollup",
+			rule: storage.Watchlist{
+				Id:        5,
+				RollupId:  testsuite.MustHexDecode("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539"),
+				Threshold: decimal.RequireFromString("4"),
+			},
+			want: []storage.WatchlistAlert{
+				{
+					WatchlistId: 5,
+					Position:    1,
+					ActionType:  types.ActionTypeSequence,
+					Amount:      decimal.RequireFromString("4"),
+					Direction:   types.DirectionAny,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := MatchAlerts([]storage.Watchlist{tt.rule}, testWatchlistEvents(time.Now()))
+			require.Len(t, alerts, len(tt.want))
+
+			for i := range alerts {
+				require.Equal(t, tt.want[i].WatchlistId, alerts[i].WatchlistId)
+				require.Equal(t, tt.want[i].Position, alerts[i].Position)
+				require.Equal(t, tt.want[i].ActionType, alerts[i].ActionType)
+				require.Equal(t, tt.want[i].Amount.String(), alerts[i].Amount.String())
+				require.Equal(t, tt.want[i].Currency, alerts[i].Currency)
+				require.Equal(t, tt.want[i].Direction, alerts[i].Direction)
+				require.EqualValues(t, 100, alerts[i].Height)
+				require.NotNil(t, alerts[i].Watchlist)
+			}
+		})
+	}
+}
+
+func TestWatchlist_Emit(t *testing.T) {
+	const secret = "secret"
+
+	delivered := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `"action_type":"transfer"`)
+		require.Equal(t, "10", r.Header.Get(HeaderEventId))
+		require.Equal(t,
+			Signature([]byte(secret), r.Header.Get(HeaderTimestamp), body),
+			r.Header.Get(HeaderSignature),
+		)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	watchlists := mock.NewMockIWatchlist(ctrl)
+	notificator := mock.NewMockNotificator(ctrl)
+
+	rule := storage.Watchlist{
+		Id:          1,
+		Address:     testsuite.MustHexDecode(testTreasury),
+		ActionTypes: types.ActionTypeTransferBits,
+		Direction:   types.DirectionOut,
+		WebhookUrl:  server.URL,
+		Secret:      secret,
+	}
+	watchlists.EXPECT().
+		Active(gomock.Any()).
+		Return([]storage.Watchlist{rule}, nil).
+		Times(1)
+
+	saved := make(chan storage.WatchlistAlert, 1)
+	watchlists.EXPECT().
+		SaveAlerts(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, alerts ...*storage.WatchlistAlert) error {
+			require.Len(t, alerts, 1)
+			alerts[0].Id = 10
+			saved <- *alerts[0]
+			return nil
+		}).
+		Times(1)
+
+	notificator.EXPECT().
+		Notify(gomock.Any(), storage.ChannelAlert, "10").
+		Return(nil).
+		Times(1)
+
+	// alerts are delivered from the database
+	var pending []storage.WatchlistAlert
+	watchlists.EXPECT().
+		Undelivered(gomock.Any(), gomock.Any(), watchlistBatchSize).
+		DoAndReturn(func(_ context.Context, afterId uint64, _ int) ([]storage.WatchlistAlert, error) {
+			select {
+			case alert := <-saved:
+				pending = append(pending, alert)
+			default:
+			}
+			if len(pending) == 0 || pending[0].Id <= afterId {
+				return nil, nil
+			}
+			return pending, nil
+		}).
+		MinTimes(1)
+
+	watchlists.EXPECT().
+		SetDelivered(gomock.Any(), uint64(10)).
+		DoAndReturn(func(_ context.Context, _ ...uint64) error {
+			close(delivered)
+			return nil
+		}).
+		Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := NewWatchlist(config.WatchlistSink{}, watchlists, notificator)
+	w.Start(ctx)
+
+	err := w.Emit(ctx, testWatchlistEvents(time.Now()))
+	require.NoError(t, err)
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert was not delivered")
+	}
+
+	cancel()
+	require.NoError(t, w.Close())
+}
+
+func TestWatchlist_DeliverOnStart(t *testing.T) {
+	delivered := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "5", r.Header.Get(HeaderEventId))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	watchlists := mock.NewMockIWatchlist(ctrl)
+	notificator := mock.NewMockNotificator(ctrl)
+
+	// alert was fired before restart and wasn't delivered
+	watchlists.EXPECT().
+		Undelivered(gomock.Any(), uint64(0), watchlistBatchSize).
+		Return([]storage.WatchlistAlert{
+			{
+				Id:          5,
+				WatchlistId: 1,
+				ActionType:  types.ActionTypeTransfer,
+				Amount:      decimal.NewFromInt(100),
+				Direction:   types.DirectionOut,
+				Watchlist: &storage.Watchlist{
+					Id:         1,
+					WebhookUrl: server.URL,
+				},
+			},
+		}, nil).
+		Times(1)
+	watchlists.EXPECT().
+		Undelivered(gomock.Any(), uint64(5), watchlistBatchSize).
+		Return(nil, nil).
+		AnyTimes()
+
+	watchlists.EXPECT().
+		SetDelivered(gomock.Any(), uint64(5)).
+		DoAndReturn(func(_ context.Context, _ ...uint64) error {
+			close(delivered)
+			return nil
+		}).
+		Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := NewWatchlist(config.WatchlistSink{}, watchlists, notificator)
+	w.Start(ctx)
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert was not delivered")
+	}
+
+	cancel()
+	require.NoError(t, w.Close())
+}
+
+func TestWatchlist_EmitInitialSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// rules must not be requested and alerts must not be saved for old blocks
+	watchlists := mock.NewMockIWatchlist(ctrl)
+	notificator := mock.NewMockNotificator(ctrl)
+
+	w := NewWatchlist(config.WatchlistSink{}, watchlists, notificator)
+	err := w.Emit(context.Background(), testWatchlistEvents(time.Now().Add(-24*time.Hour)))
+	require.NoError(t, err)
+}
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	HeaderSignature = "X-Indexer-Signature"
+	HeaderEventId   = "X-Indexer-Event-Id"
+	HeaderTimestamp = "X-Indexer-Timestamp"
+
+	defaultWebhookTimeout    = 10 * time.Second
+	defaultWebhookMaxRetries = 10
+	webhookPollInterval      = time.Second
+	webhookBatchSize         = 100
+	webhookMaxBackoff        = 10 * time.Minute
+)
+
+// Webhook - sink which stores events to the outbox table and delivers them by HTTP POST requests.
+// Events are delivered in the order of emitting. Body of the request is signed by HMAC-SHA256 with the shared secret.
+type Webhook struct {
+	url        string
+	secret     []byte
+	maxRetries int
+	outbox     storage.IOutbox
+	client     *http.Client
+	log        zerolog.Logger
+	wg         *sync.WaitGroup
+}
+
+var _ TxSink = (*Webhook)(nil)
+
+// NewWebhook -
+func NewWebhook(cfg config.WebhookSink, outbox storage.IOutbox) *Webhook {
+	timeout := defaultWebhookTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	maxRetries := defaultWebhookMaxRetries
+	if cfg.MaxRetries > 0 {
+		maxRetries = cfg.MaxRetries
+	}
+	return &Webhook{
+		url:        cfg.Url,
+		secret:     []byte(cfg.Secret),
+		maxRetries: maxRetries,
+		outbox:     outbox,
+		client:     &http.Client{Timeout: timeout},
+		log:        log.With().Str("sink", "webhook").Logger(),
+		wg:         new(sync.WaitGroup),
+	}
+}
+
+// Name -
+func (w *Webhook) Name() string {
+	return "webhook"
+}
+
+// Start - runs delivery of events from outbox
+func (w *Webhook) Start(ctx context.Context) {
+	w.wg.Add(1)
+	go w.deliver(ctx)
+}
+
+// Emit - saves events to outbox. They will be delivered asynchronously.
+func (w *Webhook) Emit(ctx context.Context, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	event, err := newOutboxEvent(events)
+	if err != nil {
+		return err
+	}
+	return w.outbox.Save(ctx, event)
+}
+
+// EmitTx - saves events to outbox in the transaction of indexed data
+func (w *Webhook) EmitTx(ctx context.Context, tx storage.Transaction, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	event, err := newOutboxEvent(events)
+	if err != nil {
+		return err
+	}
+	return tx.Add(ctx, event)
+}
+
+func newOutboxEvent(events []Event) (*storage.Outbox, error) {
+	payload, err := json.Marshal(events)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal events")
+	}
+	now := time.Now().UTC()
+	return &storage.Outbox{
+		CreatedAt:     now,
+		NextAttemptAt: now,
+		Height:        lastHeight(events),
+		Payload:       string(payload),
+	}, nil
+}
+
+// Close -
+func (w *Webhook) Close() error {
+	w.wg.Wait()
+	return nil
+}
+
+func (w *Webhook) deliver(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.deliverPending(ctx); err != nil {
+				w.log.Err(err).Msg("webhook delivery")
+			}
+		}
+	}
+}
+
+func (w *Webhook) deliverPending(ctx context.Context) error {
+	pending, err := w.outbox.Pending(ctx, webhookBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "receiving pending events")
+	}
+
+	now := time.Now().UTC()
+	for i := range pending {
+		// the oldest event waits for retry: later events must not overtake it
+		if pending[i].NextAttemptAt.After(now) {
+			return nil
+		}
+
+		if err := w.send(ctx, pending[i]); err != nil {
+			w.log.Warn().
+				Err(err).
+				Uint64("id", pending[i].Id).
+				Int("attempt", pending[i].Attempts+1).
+				Msg("can't deliver events")
+			// stop processing to keep order of events
+			return w.retry(ctx, pending[i], err)
+		}
+
+		if err := w.outbox.Delete(ctx, pending[i].Id); err != nil {
+			return errors.Wrap(err, "deleting delivered events")
+		}
+	}
+	return nil
+}
+
+func (w *Webhook) send(ctx context.Context, event storage.Outbox) error {
+	body := []byte(event.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventId, strconv.FormatUint(event.Id, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if len(w.secret) > 0 {
+		req.Header.Set(HeaderSignature, Signature(w.secret, timestamp, body))
+	}
+
+	response, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errors.Errorf("unexpected response status: %d", response.StatusCode)
+	}
+	return nil
+}
+
+func (w *Webhook) retry(ctx context.Context, event storage.Outbox, deliveryErr error) error {
+	event.Attempts += 1
+	event.Error = deliveryErr.Error()
+	event.NextAttemptAt = time.Now().UTC().Add(backoff(event.Attempts))
+	if event.Attempts >= w.maxRetries {
+		event.Failed = true
+		w.log.Error().
+			Uint64("id", event.Id).
+			Uint64("height", uint64(event.Height)).
+			Msg("events delivery failed after all attempts")
+	}
+	return w.outbox.Update(ctx, &event)
+}
+
+// Signature - returns hex-encoded HMAC-SHA256 of timestamp and body joined by dot
+func Signature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func backoff(attempt int) time.Duration {
+	if attempt > 10 {
+		return webhookMaxBackoff
+	}
+	delay := time.Second << attempt
+	if delay > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package sink
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhook_Emit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outbox := mock.NewMockIOutbox(ctrl)
+	outbox.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *storage.Outbox) error {
+			require.EqualValues(t, 101, event.Height)
+			require.Contains(t, event.Payload, `"type":"block"`)
+			return nil
+		}).
+		Times(1)
+
+	w := NewWebhook(config.WebhookSink{Url: "http://localhost"}, outbox)
+	err := w.Emit(context.Background(), []Event{
+		{Type: EventBlock, Height: 100},
+		{Type: EventBlock, Height: 101},
+	})
+	require.NoError(t, err)
+}
+
+func TestWebhook_DeliverPending(t *testing.T) {
+	const secret = "secret"
+	payload := `[{"type":"block","height":100}]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, payload, string(body))
+		require.Equal(t, "1", r.Header.Get(HeaderEventId))
+		require.Equal(t,
+			Signature([]byte(secret), r.Header.Get(HeaderTimestamp), body),
+			r.Header.Get(HeaderSignature),
+		)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outbox := mock.NewMockIOutbox(ctrl)
+	outbox.EXPECT().
+		Pending(gomock.Any(), webhookBatchSize).
+		Return([]storage.Outbox{{Id: 1, Height: 100, Payload: payload}}, nil).
+		Times(1)
+	outbox.EXPECT().
+		Delete(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	w := NewWebhook(config.WebhookSink{Url: server.URL, Secret: secret}, outbox)
+	require.NoError(t, w.deliverPending(context.Background()))
+}
+
+func TestWebhook_DeliverRetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outbox := mock.NewMockIOutbox(ctrl)
+	outbox.EXPECT().
+		Pending(gomock.Any(), webhookBatchSize).
+		Return([]storage.Outbox{
+			{Id: 1, Height: 100, Payload: "[]", Attempts: 1},
+			{Id: 2, Height: 101, Payload: "[]"},
+		}, nil).
+		Times(1)
+	outbox.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *storage.Outbox) error {
+			require.EqualValues(t, 1, event.Id)
+			require.Equal(t, 2, event.Attempts)
+			require.True(t, event.Failed)
+			require.NotEmpty(t, event.Error)
+			return nil
+		}).
+		Times(1)
+
+	w := NewWebhook(config.WebhookSink{Url: server.URL, MaxRetries: 2}, outbox)
+	require.NoError(t, w.deliverPending(context.Background()))
+}
+
+func TestWebhook_DeliverHeadOfLine(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outbox := mock.NewMockIOutbox(ctrl)
+	outbox.EXPECT().
+		Pending(gomock.Any(), webhookBatchSize).
+		Return([]storage.Outbox{
+			{Id: 1, Height: 100, Payload: "[]", Attempts: 1, NextAttemptAt: time.Now().Add(time.Minute)},
+			{Id: 2, Height: 101, Payload: "[]"},
+		}, nil).
+		Times(1)
+
+	w := NewWebhook(config.WebhookSink{Url: server.URL}, outbox)
+	require.NoError(t, w.deliverPending(context.Background()))
+	require.Zero(t, calls, "later events must not overtake the event waiting for retry")
+}
+
+func TestWebhook_EmitTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outbox := mock.NewMockIOutbox(ctrl)
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			event, ok := model.(*storage.Outbox)
+			require.True(t, ok)
+			require.EqualValues(t, 100, event.Height)
+			require.False(t, event.NextAttemptAt.IsZero())
+			return nil
+		}).
+		Times(1)
+
+	w := NewWebhook(config.WebhookSink{Url: "http://localhost"}, outbox)
+	err := Sinks{w}.EmitTx(context.Background(), tx, []Event{{Type: EventBlock, Height: 100}})
+	require.NoError(t, err)
+
+	// transactional sink doesn't receive events after commit
+	require.NoError(t, Sinks{w}.Emit(context.Background(), []Event{{Type: EventBlock, Height: 100}}))
+}
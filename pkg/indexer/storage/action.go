@@ -32,6 +32,9 @@ func saveAction(
 			actions[i].RollupAction.ActionId = actions[i].Id
 			actions[i].RollupAction.RollupId = actions[i].RollupAction.Rollup.Id
 			actions[i].RollupAction.TxId = actions[i].TxId
+			if actions[i].RollupAction.Sender != nil {
+				actions[i].RollupAction.SenderId = actions[i].RollupAction.Sender.Id
+			}
 			rollupActions = append(rollupActions, actions[i].RollupAction)
 		}
 
@@ -32,15 +32,8 @@ func (module *Module) saveBlockSignatures(
 		return nil
 	}
 
-	if len(module.validators) == 0 {
-		validators, err := tx.Validators(ctx)
-		if err != nil {
-			return err
-		}
-		module.validators = make(map[string]uint64)
-		for i := range validators {
-			module.validators[validators[i].Address] = validators[i].Id
-		}
+	if err := module.loadValidators(ctx, tx); err != nil {
+		return err
 	}
 
 	for i := range signs {
@@ -57,3 +50,19 @@ func (module *Module) saveBlockSignatures(
 
 	return tx.SaveBlockSignatures(ctx, signs...)
 }
+
+func (module *Module) loadValidators(ctx context.Context, tx storage.Transaction) error {
+	if len(module.validators) > 0 {
+		return nil
+	}
+
+	validators, err := tx.Validators(ctx)
+	if err != nil {
+		return err
+	}
+	module.validators = make(map[string]uint64)
+	for i := range validators {
+		module.validators[validators[i].Address] = validators[i].Id
+	}
+	return nil
+}
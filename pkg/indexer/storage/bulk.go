@@ -0,0 +1,441 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+)
+
+const (
+	// blocks older than the threshold are considered as initial sync data
+	initialSyncThreshold = time.Hour
+	// pending batch is flushed if no blocks were received during the interval
+	bulkFlushInterval = 5 * time.Second
+	// time which is given to save pending batch on shutdown
+	bulkShutdownTimeout = 30 * time.Second
+)
+
+// isBulk - returns true if block should be saved in bulk mode: it's enabled and the block is far behind the head
+func (module *Module) isBulk(block *storage.Block) bool {
+	return module.bulkSize > 1 && time.Since(block.Time) > initialSyncThreshold
+}
+
+// flushBatch - saves all pending blocks in one transaction
+func (module *Module) flushBatch(ctx context.Context) error {
+	if len(module.batch) == 0 {
+		return nil
+	}
+
+	if _, err := module.saveBatch(ctx, module.batch); err != nil {
+		return err
+	}
+	module.emit(ctx, module.batch...)
+	module.batch = module.batch[:0]
+	return nil
+}
+
+// stopOnBatchError - signals the indexer to stop once. Pending batch can't be skipped, so the module doesn't save anything after the failure.
+func (module *Module) stopOnBatchError(err error) {
+	module.Log.Err(err).Msg("blocks saving error")
+	if module.stopped {
+		return
+	}
+	module.stopped = true
+	module.MustOutput(StopOutput).Push(struct{}{})
+}
+
+// flushOnShutdown - saves pending blocks when module is stopped. Context of the module is already canceled,
+// so a separate context is used to not lose up to bulk size of indexed blocks. Batch which already failed isn't retried:
+// the indexer is stopped because of it and blocks are indexed again from the last saved state on restart.
+func (module *Module) flushOnShutdown() {
+	if module.stopped || len(module.batch) == 0 {
+		return
+	}
+	first, last := module.batch[0].Height, module.batch[len(module.batch)-1].Height
+
+	ctx, cancel := context.WithTimeout(context.Background(), bulkShutdownTimeout)
+	defer cancel()
+
+	if err := module.flushBatch(ctx); err != nil {
+		module.Log.Err(err).
+			Uint64("from", uint64(first)).
+			Uint64("to", uint64(last)).
+			Msg("pending blocks saving error on shutdown")
+		return
+	}
+	module.Log.Info().
+		Uint64("from", uint64(first)).
+		Uint64("to", uint64(last)).
+		Msg("pending blocks saved on shutdown")
+}
+
+func (module *Module) saveBatch(ctx context.Context, blocks []*storage.Block) (storage.State, error) {
+	start := time.Now()
+	first, last := blocks[0].Height, blocks[len(blocks)-1].Height
+	module.Log.Info().
+		Uint64("from", uint64(first)).
+		Uint64("to", uint64(last)).
+		Msg("saving blocks in bulk mode...")
+
+	tx, err := postgres.BeginTransaction(ctx, module.storage)
+	if err != nil {
+		return storage.State{}, err
+	}
+	defer tx.Close(ctx)
+
+	state, err := module.processBatchInTransaction(ctx, tx, blocks)
+	if err != nil {
+		return state, tx.HandleError(ctx, err)
+	}
+
+	if err := module.emitTx(ctx, tx, blocks...); err != nil {
+		return state, tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return state, tx.HandleError(ctx, err)
+	}
+
+	var txCount int
+	for i := range blocks {
+		txCount += len(blocks[i].Txs)
+	}
+	module.Log.Info().
+		Uint64("from", uint64(first)).
+		Uint64("to", uint64(last)).
+		Time("block_time", blocks[len(blocks)-1].Time).
+		Int64("ms", time.Since(start).Milliseconds()).
+		Int("tx_count", txCount).
+		Msg("blocks saved")
+	return state, nil
+}
+
+func (module *Module) processBatchInTransaction(ctx context.Context, tx storage.Transaction, blocks []*storage.Block) (storage.State, error) {
+	state, err := tx.State(ctx, module.indexerName)
+	if err != nil {
+		return state, err
+	}
+
+	if err := module.prepareBlocks(ctx, tx, state, blocks); err != nil {
+		return state, err
+	}
+
+	addresses := mergeAddresses(blocks)
+	addrToId, totalAccounts, err := saveAddresses(ctx, tx, addresses)
+	if err != nil {
+		return state, err
+	}
+	for i := range blocks {
+		for key, address := range blocks[i].Addresses {
+			address.Id = addresses[key].Id
+		}
+	}
+
+	rollups := mergeRollups(blocks)
+	var totalRollups int64
+	if len(rollups) > 0 {
+		totalRollups, err = saveRollups(ctx, tx, addrToId, rollups)
+		if err != nil {
+			return state, err
+		}
+	}
+	for i := range blocks {
+		for key, rollup := range blocks[i].Rollups {
+			rollup.Id = rollups[key].Id
+		}
+	}
+	if err := saveRollupAddresses(ctx, tx, mergeRollupAddresses(blocks)); err != nil {
+		return state, err
+	}
+
+	if err := copyBlocks(ctx, tx, blocks); err != nil {
+		return state, err
+	}
+	if err := copyTransactions(ctx, tx, addrToId, blocks); err != nil {
+		return state, err
+	}
+	if err := copyActions(ctx, tx, blocks); err != nil {
+		return state, err
+	}
+	if err := module.copyBlockSignatures(ctx, tx, blocks); err != nil {
+		return state, err
+	}
+
+	for i := range blocks {
+		updateState(blocks[i], 0, 0, &state)
+	}
+	state.TotalAccounts += totalAccounts
+	state.TotalRollups += totalRollups
+	if err := tx.Update(ctx, &state); err != nil {
+		return state, err
+	}
+
+	return state, nil
+}
+
+// prepareBlocks - fills block time, proposer and reserves internal identities of blocks
+func (module *Module) prepareBlocks(ctx context.Context, tx storage.Transaction, state storage.State, blocks []*storage.Block) error {
+	ids, err := tx.ReserveIds(ctx, storage.Block{}.TableName(), len(blocks))
+	if err != nil {
+		return errors.Wrap(err, "reserve block ids")
+	}
+
+	lastTime := state.LastTime
+	for i := range blocks {
+		blocks[i].Id = ids[i]
+		blocks[i].Stats.BlockTime = uint64(blocks[i].Time.Sub(lastTime).Milliseconds())
+		lastTime = blocks[i].Time
+
+		proposerId, err := module.proposerId(ctx, tx, blocks[i].ProposerAddress)
+		if err != nil {
+			return err
+		}
+		blocks[i].ProposerId = proposerId
+	}
+	return nil
+}
+
+// mergeAddresses - merges address deltas of all blocks to one entity per address
+func mergeAddresses(blocks []*storage.Block) map[string]*storage.Address {
+	merged := make(map[string]*storage.Address)
+	for i := range blocks {
+		for key, address := range blocks[i].Addresses {
+			m, ok := merged[key]
+			if !ok {
+				balance := storage.EmptyBalance()
+				if address.Balance != nil {
+					balance.Currency = address.Balance.Currency
+				}
+				m = &storage.Address{
+					Height:  address.Height,
+					Hash:    address.Hash,
+					Balance: &balance,
+				}
+				merged[key] = m
+			}
+
+			m.ActionsCount += address.ActionsCount
+			m.SignedTxCount += address.SignedTxCount
+			if address.Nonce > m.Nonce {
+				m.Nonce = address.Nonce
+			}
+			if address.Balance != nil {
+				m.Balance.Total = m.Balance.Total.Add(address.Balance.Total)
+			}
+		}
+	}
+	return merged
+}
+
+// mergeRollups - merges rollup deltas of all blocks to one entity per rollup
+func mergeRollups(blocks []*storage.Block) map[string]*storage.Rollup {
+	merged := make(map[string]*storage.Rollup)
+	for i := range blocks {
+		for key, rollup := range blocks[i].Rollups {
+			m, ok := merged[key]
+			if !ok {
+				m = &storage.Rollup{
+					FirstHeight: rollup.FirstHeight,
+					AstriaId:    rollup.AstriaId,
+				}
+				merged[key] = m
+			}
+
+			m.ActionsCount += rollup.ActionsCount
+			m.Size += rollup.Size
+			if rollup.BridgeAddress != nil {
+				m.BridgeAddress = rollup.BridgeAddress
+			}
+		}
+	}
+	return merged
+}
+
+// mergeRollupAddresses - keeps the first occurrence of rollup address pair in the batch
+func mergeRollupAddresses(blocks []*storage.Block) map[string]*storage.RollupAddress {
+	merged := make(map[string]*storage.RollupAddress)
+	for i := range blocks {
+		for key, ra := range blocks[i].RollupAddress {
+			if _, ok := merged[key]; !ok {
+				merged[key] = ra
+			}
+		}
+	}
+	return merged
+}
+
+func copyBlocks(ctx context.Context, tx storage.Transaction, blocks []*storage.Block) error {
+	data := make([]sdk.Copiable, len(blocks))
+	stats := make([]sdk.Copiable, len(blocks))
+	raws := make([]sdk.Copiable, 0, len(blocks))
+	for i := range blocks {
+		data[i] = blocks[i]
+		stats[i] = blocks[i].Stats
+		if blocks[i].Raw != nil {
+			raws = append(raws, blocks[i].Raw)
+		}
+	}
+
+	if err := tx.CopyFrom(ctx, storage.Block{}.TableName(), data); err != nil {
+		return errors.Wrap(err, "copy blocks")
+	}
+	if err := tx.CopyFrom(ctx, storage.BlockStats{}.TableName(), stats); err != nil {
+		return errors.Wrap(err, "copy block stats")
+	}
+	if err := tx.CopyFrom(ctx, storage.RawBlock{}.TableName(), raws); err != nil {
+		return errors.Wrap(err, "copy raw blocks")
+	}
+	return nil
+}
+
+func copyTransactions(ctx context.Context, tx storage.Transaction, addrToId map[string]uint64, blocks []*storage.Block) error {
+	txs := make([]*storage.Tx, 0)
+	for i := range blocks {
+		txs = append(txs, blocks[i].Txs...)
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids, err := tx.ReserveIds(ctx, storage.Tx{}.TableName(), len(txs))
+	if err != nil {
+		return errors.Wrap(err, "reserve tx ids")
+	}
+
+	data := make([]sdk.Copiable, len(txs))
+	for i := range txs {
+		txs[i].Id = ids[i]
+		if signerId, ok := addrToId[txs[i].Signer.String()]; ok {
+			txs[i].SignerId = signerId
+		} else {
+			return errors.Errorf("unknown signer id")
+		}
+		data[i] = txs[i]
+	}
+
+	if err := tx.CopyFrom(ctx, storage.Tx{}.TableName(), data); err != nil {
+		return errors.Wrap(err, "copy transactions")
+	}
+
+	raws := rawTxs(txs)
+	rawData := make([]sdk.Copiable, len(raws))
+	for i := range raws {
+		rawData[i] = raws[i]
+	}
+	if err := tx.CopyFrom(ctx, storage.RawTx{}.TableName(), rawData); err != nil {
+		return errors.Wrap(err, "copy raw transactions")
+	}
+	return nil
+}
+
+func copyActions(ctx context.Context, tx storage.Transaction, blocks []*storage.Block) error {
+	actions := make([]*storage.Action, 0)
+	for i := range blocks {
+		for j := range blocks[i].Txs {
+			for k := range blocks[i].Txs[j].Actions {
+				blocks[i].Txs[j].Actions[k].TxId = blocks[i].Txs[j].Id
+				actions = append(actions, &blocks[i].Txs[j].Actions[k])
+			}
+		}
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+
+	ids, err := tx.ReserveIds(ctx, storage.Action{}.TableName(), len(actions))
+	if err != nil {
+		return errors.Wrap(err, "reserve action ids")
+	}
+
+	var (
+		data           = make([]sdk.Copiable, len(actions))
+		rollupActions  = make([]sdk.Copiable, 0)
+		addrActions    = make([]sdk.Copiable, 0)
+		balanceUpdates = make([]sdk.Copiable, 0)
+	)
+	for i := range actions {
+		actions[i].Id = ids[i]
+		data[i] = actions[i]
+
+		if actions[i].RollupAction != nil {
+			actions[i].RollupAction.ActionId = actions[i].Id
+			actions[i].RollupAction.RollupId = actions[i].RollupAction.Rollup.Id
+			actions[i].RollupAction.TxId = actions[i].TxId
+			if actions[i].RollupAction.Sender != nil {
+				actions[i].RollupAction.SenderId = actions[i].RollupAction.Sender.Id
+			}
+			rollupActions = append(rollupActions, actions[i].RollupAction)
+		}
+
+		for j := range actions[i].Addresses {
+			actions[i].Addresses[j].ActionId = actions[i].Id
+			actions[i].Addresses[j].AddressId = actions[i].Addresses[j].Address.Id
+			actions[i].Addresses[j].TxId = actions[i].TxId
+			addrActions = append(addrActions, actions[i].Addresses[j])
+		}
+
+		for j := range actions[i].BalanceUpdates {
+			actions[i].BalanceUpdates[j].AddressId = actions[i].BalanceUpdates[j].Address.Id
+			balanceUpdates = append(balanceUpdates, actions[i].BalanceUpdates[j])
+		}
+	}
+
+	if err := tx.CopyFrom(ctx, storage.Action{}.TableName(), data); err != nil {
+		return errors.Wrap(err, "copy actions")
+	}
+	if err := tx.CopyFrom(ctx, storage.RollupAction{}.TableName(), rollupActions); err != nil {
+		return errors.Wrap(err, "copy rollup actions")
+	}
+	if err := tx.CopyFrom(ctx, storage.AddressAction{}.TableName(), addrActions); err != nil {
+		return errors.Wrap(err, "copy address actions")
+	}
+	if err := tx.CopyFrom(ctx, storage.BalanceUpdate{}.TableName(), balanceUpdates); err != nil {
+		return errors.Wrap(err, "copy balance updates")
+	}
+	return nil
+}
+
+func (module *Module) copyBlockSignatures(ctx context.Context, tx storage.Transaction, blocks []*storage.Block) error {
+	last := blocks[len(blocks)-1].Height
+	retentionLevel := last - countOfStoringSignsInLevels
+	if retentionLevel > 0 {
+		if err := tx.RetentionBlockSignatures(ctx, retentionLevel); err != nil {
+			return err
+		}
+	}
+
+	if err := module.loadValidators(ctx, tx); err != nil {
+		return err
+	}
+
+	data := make([]sdk.Copiable, 0)
+	for i := range blocks {
+		for j := range blocks[i].BlockSignatures {
+			sign := &blocks[i].BlockSignatures[j]
+			if sign.Height <= retentionLevel {
+				continue
+			}
+			if sign.Validator == nil {
+				return errors.New("nil validator of block signature")
+			}
+			id, ok := module.validators[sign.Validator.Address]
+			if !ok {
+				return errors.Errorf("unknown validator: %s", sign.Validator.Address)
+			}
+			sign.ValidatorId = id
+			data = append(data, sign)
+		}
+	}
+
+	if err := tx.CopyFrom(ctx, storage.BlockSignature{}.TableName(), data); err != nil {
+		return errors.Wrap(err, "copy block signatures")
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2024 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mergeAddresses(t *testing.T) {
+	blocks := []*storage.Block{
+		{
+			Height: 100,
+			Addresses: map[string]*storage.Address{
+				"DEADBEAF": {
+					Height:        100,
+					Hash:          testsuite.MustHexDecode("deadbeaf"),
+					Nonce:         1,
+					ActionsCount:  2,
+					SignedTxCount: 1,
+					Balance: &storage.Balance{
+						Currency: "nria",
+						Total:    decimal.RequireFromString("-10"),
+					},
+				},
+			},
+		}, {
+			Height: 101,
+			Addresses: map[string]*storage.Address{
+				"DEADBEAF": {
+					Height:        101,
+					Hash:          testsuite.MustHexDecode("deadbeaf"),
+					Nonce:         2,
+					ActionsCount:  1,
+					SignedTxCount: 1,
+					Balance: &storage.Balance{
+						Currency: "nria",
+						Total:    decimal.RequireFromString("3"),
+					},
+				},
+				"BEAFDEAD": {
+					Height: 101,
+					Hash:   testsuite.MustHexDecode("beafdead"),
+					Balance: &storage.Balance{
+						Currency: "nria",
+						Total:    decimal.RequireFromString("7"),
+					},
+				},
+			},
+		},
+	}
+
+	merged := mergeAddresses(blocks)
+	require.Len(t, merged, 2)
+
+	addr, ok := merged["DEADBEAF"]
+	require.True(t, ok)
+	require.EqualValues(t, 100, addr.Height)
+	require.EqualValues(t, 2, addr.Nonce)
+	require.EqualValues(t, 3, addr.ActionsCount)
+	require.EqualValues(t, 2, addr.SignedTxCount)
+	require.Equal(t, "nria", addr.Balance.Currency)
+	require.Equal(t, "-7", addr.Balance.Total.String())
+
+	addr, ok = merged["BEAFDEAD"]
+	require.True(t, ok)
+	require.EqualValues(t, 101, addr.Height)
+	require.Equal(t, "7", addr.Balance.Total.String())
+
+	// source entities should not be changed by merging
+	require.Equal(t, "-10", blocks[0].Addresses["DEADBEAF"].Balance.Total.String())
+}
+
+func Test_mergeRollups(t *testing.T) {
+	bridge := &storage.Address{Hash: testsuite.MustHexDecode("deadbeaf")}
+	blocks := []*storage.Block{
+		{
+			Height: 100,
+			Rollups: map[string]*storage.Rollup{
+				"0102": {
+					FirstHeight:  100,
+					AstriaId:     testsuite.MustHexDecode("0102"),
+					ActionsCount: 1,
+					Size:         10,
+				},
+			},
+		}, {
+			Height: 101,
+			Rollups: map[string]*storage.Rollup{
+				"0102": {
+					FirstHeight:   101,
+					AstriaId:      testsuite.MustHexDecode("0102"),
+					ActionsCount:  2,
+					Size:          20,
+					BridgeAddress: bridge,
+				},
+			},
+		},
+	}
+
+	merged := mergeRollups(blocks)
+	require.Len(t, merged, 1)
+
+	rollup, ok := merged["0102"]
+	require.True(t, ok)
+	require.EqualValues(t, 100, rollup.FirstHeight)
+	require.EqualValues(t, 3, rollup.ActionsCount)
+	require.EqualValues(t, 30, rollup.Size)
+	require.Equal(t, bridge, rollup.BridgeAddress)
+}
+
+func TestModule_isBulk(t *testing.T) {
+	tests := []struct {
+		name     string
+		bulkSize int
+		time     time.Time
+		want     bool
+	}{
+		{
+			name:     "disabled",
+			bulkSize: 0,
+			time:     time.Now().Add(-24 * time.Hour),
+			want:     false,
+		}, {
+			name:     "old block",
+			bulkSize: 100,
+			time:     time.Now().Add(-24 * time.Hour),
+			want:     true,
+		}, {
+			name:     "near head",
+			bulkSize: 100,
+			time:     time.Now().Add(-time.Minute),
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module := Module{bulkSize: tt.bulkSize}
+			require.Equal(t, tt.want, module.isBulk(&storage.Block{Time: tt.time}))
+		})
+	}
+}
+
+type failedTransactable struct {
+	calls *atomic.Int32
+}
+
+func (tx failedTransactable) BeginTransaction(ctx context.Context) (sdk.Transaction, error) {
+	tx.calls.Add(1)
+	return nil, errors.New("connection refused")
+}
+
+func TestModule_StopOnceOnFlushError(t *testing.T) {
+	transactable := failedTransactable{calls: new(atomic.Int32)}
+	module := NewModule(transactable, nil, config.Indexer{BulkSize: 2})
+
+	stopperModule := modules.New("stopper-module")
+	stopInputName := "stop-signal"
+	stopperModule.CreateInput(stopInputName)
+	require.NoError(t, stopperModule.AttachTo(&module, StopOutput, stopInputName))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	module.Start(ctx)
+
+	blockTime := time.Now().Add(-24 * time.Hour)
+	for i := 0; i < 6; i++ {
+		module.MustInput(InputName).Push(&storage.Block{
+			Height: 100,
+			Time:   blockTime,
+		})
+	}
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("stop by cancelled context")
+	case <-stopperModule.MustInput(stopInputName).Listen():
+	}
+
+	select {
+	case <-time.After(200 * time.Millisecond):
+	case <-stopperModule.MustInput(stopInputName).Listen():
+		t.Fatal("stop signal should be sent once")
+	}
+
+	cancel()
+	require.NoError(t, module.Close())
+	require.EqualValues(t, 1, transactable.calls.Load(), "failed batch isn't retried on shutdown")
+}
@@ -21,6 +21,24 @@ func (module *Module) saveRollup(
 		return 0, nil
 	}
 
+	count, err := saveRollups(ctx, tx, addrToId, rollups)
+	if err != nil {
+		return count, err
+	}
+
+	if err := saveRollupAddresses(ctx, tx, rollupAddress); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func saveRollups(
+	ctx context.Context,
+	tx storage.Transaction,
+	addrToId map[string]uint64,
+	rollups map[string]*storage.Rollup,
+) (int64, error) {
 	data := make([]*storage.Rollup, 0)
 	for _, value := range rollups {
 		if value.BridgeAddress != nil {
@@ -33,20 +51,19 @@ func (module *Module) saveRollup(
 		data = append(data, value)
 	}
 
-	count, err := tx.SaveRollups(ctx, data...)
-	if err != nil {
-		return count, err
-	}
+	return tx.SaveRollups(ctx, data...)
+}
 
+func saveRollupAddresses(
+	ctx context.Context,
+	tx storage.Transaction,
+	rollupAddress map[string]*storage.RollupAddress,
+) error {
 	ra := make([]*storage.RollupAddress, 0)
 	for _, value := range rollupAddress {
 		value.RollupId = value.Rollup.Id
 		value.AddressId = value.Address.Id
 		ra = append(ra, value)
 	}
-	if err := tx.SaveRollupAddresses(ctx, ra...); err != nil {
-		return 0, err
-	}
-
-	return count, nil
+	return tx.SaveRollupAddresses(ctx, ra...)
 }
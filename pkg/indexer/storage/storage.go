@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/sink"
 	"github.com/pkg/errors"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -36,6 +37,11 @@ type Module struct {
 	notificator storage.Notificator
 	indexerName string
 	validators  map[string]uint64
+	bulkSize    int
+	batch       []*storage.Block
+	sinks       sink.Sinks
+	// stopped - set after the first failed batch flush. Failed batch stays pending, so it isn't retried and following blocks are skipped.
+	stopped bool
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -45,6 +51,7 @@ func NewModule(
 	storage sdk.Transactable,
 	notificator storage.Notificator,
 	cfg config.Indexer,
+	sinks ...sink.Sink,
 ) Module {
 	m := Module{
 		BaseModule:  modules.New("storage"),
@@ -52,6 +59,8 @@ func NewModule(
 		indexerName: cfg.Name,
 		notificator: notificator,
 		validators:  make(map[string]uint64),
+		bulkSize:    cfg.BulkSize,
+		sinks:       sinks,
 	}
 
 	m.CreateInputWithCapacity(InputName, 16)
@@ -69,10 +78,21 @@ func (module *Module) listen(ctx context.Context) {
 	module.Log.Info().Msg("module started")
 	input := module.MustInput(InputName)
 
+	ticker := time.NewTicker(bulkFlushInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
+			module.flushOnShutdown()
 			return
+		case <-ticker.C:
+			if module.stopped {
+				continue
+			}
+			if err := module.flushBatch(ctx); err != nil {
+				module.stopOnBatchError(err)
+			}
 		case msg, ok := <-input.Listen():
 			if !ok {
 				module.Log.Warn().Msg("can't read message from input")
@@ -84,6 +104,26 @@ func (module *Module) listen(ctx context.Context) {
 				module.Log.Warn().Msgf("invalid message type: %T", msg)
 				continue
 			}
+			if module.stopped {
+				continue
+			}
+
+			if module.isBulk(block) {
+				module.batch = append(module.batch, block)
+				if len(module.batch) < module.bulkSize {
+					continue
+				}
+				if err := module.flushBatch(ctx); err != nil {
+					module.stopOnBatchError(err)
+				}
+				continue
+			}
+
+			// switching to per-block mode: pending blocks should be saved before the current one
+			if err := module.flushBatch(ctx); err != nil {
+				module.stopOnBatchError(err)
+				continue
+			}
 
 			state, err := module.saveBlock(ctx, block)
 			if err != nil {
@@ -97,6 +137,7 @@ func (module *Module) listen(ctx context.Context) {
 			if err := module.notify(ctx, state, block); err != nil {
 				module.Log.Err(err).Msg("block notification error")
 			}
+			module.emit(ctx, block)
 		}
 	}
 }
@@ -122,6 +163,10 @@ func (module *Module) saveBlock(ctx context.Context, block *storage.Block) (stor
 		return state, tx.HandleError(ctx, err)
 	}
 
+	if err := module.emitTx(ctx, tx, block); err != nil {
+		return state, tx.HandleError(ctx, err)
+	}
+
 	if err := tx.Flush(ctx); err != nil {
 		return state, tx.HandleError(ctx, err)
 	}
@@ -141,19 +186,11 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 	}
 	block.Stats.BlockTime = uint64(block.Time.Sub(state.LastTime).Milliseconds())
 
-	if len(module.validators) > 0 {
-		if id, ok := module.validators[block.ProposerAddress]; ok {
-			block.ProposerId = id
-		} else {
-			return state, errors.Errorf("unknown block proposer: %s", block.ProposerAddress)
-		}
-	} else {
-		proposerId, err := tx.GetProposerId(ctx, block.ProposerAddress)
-		if err != nil {
-			return state, errors.Wrap(err, "can't find block proposer")
-		}
-		block.ProposerId = proposerId
+	proposerId, err := module.proposerId(ctx, tx, block.ProposerAddress)
+	if err != nil {
+		return state, err
 	}
+	block.ProposerId = proposerId
 
 	if err := tx.Add(ctx, block); err != nil {
 		return state, err
@@ -163,6 +200,12 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
+	if block.Raw != nil {
+		if err := tx.SaveRawBlocks(ctx, *block.Raw); err != nil {
+			return state, err
+		}
+	}
+
 	addrToId, totalAccounts, err := saveAddresses(ctx, tx, block.Addresses)
 	if err != nil {
 		return state, err
@@ -202,8 +245,49 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 	return state, nil
 }
 
+// emit - sends events of saved blocks to sinks
+func (module *Module) emit(ctx context.Context, blocks ...*storage.Block) {
+	if len(module.sinks) == 0 {
+		return
+	}
+	if err := module.sinks.Emit(ctx, blockEvents(blocks...)); err != nil {
+		module.Log.Err(err).Msg("events emitting error")
+	}
+}
+
+// emitTx - saves events of blocks to transactional sinks before commit
+func (module *Module) emitTx(ctx context.Context, tx storage.Transaction, blocks ...*storage.Block) error {
+	if !module.sinks.HasTx() {
+		return nil
+	}
+	return module.sinks.EmitTx(ctx, tx, blockEvents(blocks...))
+}
+
+func blockEvents(blocks ...*storage.Block) []sink.Event {
+	events := make([]sink.Event, 0)
+	for i := range blocks {
+		events = append(events, sink.BlockEvents(blocks[i])...)
+	}
+	return events
+}
+
+func (module *Module) proposerId(ctx context.Context, tx storage.Transaction, address string) (uint64, error) {
+	if len(module.validators) > 0 {
+		if id, ok := module.validators[address]; ok {
+			return id, nil
+		}
+		return 0, errors.Errorf("unknown block proposer: %s", address)
+	}
+
+	proposerId, err := tx.GetProposerId(ctx, address)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't find block proposer")
+	}
+	return proposerId, nil
+}
+
 func (module *Module) notify(ctx context.Context, state storage.State, block *storage.Block) error {
-	if time.Since(block.Time) > time.Hour {
+	if time.Since(block.Time) > initialSyncThreshold {
 		// do not notify all about events if initial indexing is in progress
 		return nil
 	}
@@ -221,5 +305,12 @@ func (module *Module) notify(ctx context.Context, state storage.State, block *st
 		return err
 	}
 
+	for i := range block.Txs {
+		txId := strconv.FormatUint(block.Txs[i].Id, 10)
+		if err := module.notificator.Notify(ctx, storage.ChannelTx, txId); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -13,9 +13,11 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
 	indexerCfg "github.com/celenium-io/astria-indexer/pkg/indexer/config"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -115,6 +117,88 @@ func (s *ModuleTestSuite) TestBlockLast() {
 	s.Require().NoError(module.Close())
 }
 
+func (s *ModuleTestSuite) TestBulkSave() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	module := NewModule(s.storage.Transactable, s.storage.Notificator, indexerCfg.Indexer{
+		Name:     testIndexerName,
+		BulkSize: 2,
+	})
+	module.Start(ctx)
+
+	blockTime := time.Date(2023, 12, 1, 1, 20, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		module.MustInput(InputName).Push(&storage.Block{
+			Height:          types.Level(7966 + i),
+			Hash:            []byte{byte(i)},
+			VersionBlock:    11,
+			VersionApp:      1,
+			ProposerAddress: "115F94D8C98FFD73FE65182611140F0EDC7C3C94",
+			Time:            blockTime.Add(time.Duration(i) * time.Second),
+			Stats: &storage.BlockStats{
+				Height:       types.Level(7966 + i),
+				Time:         blockTime.Add(time.Duration(i) * time.Second),
+				SupplyChange: decimal.Zero,
+				Fee:          decimal.Zero,
+			},
+		})
+	}
+	time.Sleep(time.Second)
+
+	block, err := s.storage.Blocks.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(7967, block.Height)
+
+	stats, err := s.storage.BlockStats.ByHeight(ctx, 7967)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1000, stats.BlockTime)
+
+	state, err := s.storage.State.ByName(ctx, testIndexerName)
+	s.Require().NoError(err)
+	s.Require().EqualValues(7967, state.LastHeight)
+	s.Require().EqualValues(block.Time.UTC(), state.LastTime.UTC())
+
+	s.Require().NoError(module.Close())
+}
+
+func (s *ModuleTestSuite) TestBulkFlushOnShutdown() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	moduleCtx, moduleCancel := context.WithCancel(ctx)
+	module := NewModule(s.storage.Transactable, s.storage.Notificator, indexerCfg.Indexer{
+		Name:     testIndexerName,
+		BulkSize: 100,
+	})
+	module.Start(moduleCtx)
+
+	blockTime := time.Date(2023, 12, 1, 1, 20, 0, 0, time.UTC)
+	module.MustInput(InputName).Push(&storage.Block{
+		Height:          7966,
+		Hash:            []byte{0},
+		VersionBlock:    11,
+		VersionApp:      1,
+		ProposerAddress: "115F94D8C98FFD73FE65182611140F0EDC7C3C94",
+		Time:            blockTime,
+		Stats: &storage.BlockStats{
+			Height:       7966,
+			Time:         blockTime,
+			SupplyChange: decimal.Zero,
+			Fee:          decimal.Zero,
+		},
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	// batch isn't full and flush interval isn't passed: block is pending until shutdown
+	moduleCancel()
+	s.Require().NoError(module.Close())
+
+	state, err := s.storage.State.ByName(ctx, testIndexerName)
+	s.Require().NoError(err)
+	s.Require().EqualValues(7966, state.LastHeight)
+}
+
 func TestSuiteModule_Run(t *testing.T) {
 	suite.Run(t, new(ModuleTestSuite))
 }
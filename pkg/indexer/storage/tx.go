@@ -28,5 +28,26 @@ func (module *Module) saveTransactions(
 		}
 	}
 
-	return tx.SaveTransactions(ctx, txs...)
+	if err := tx.SaveTransactions(ctx, txs...); err != nil {
+		return err
+	}
+	return tx.SaveRawTxs(ctx, rawTxs(txs)...)
+}
+
+// rawTxs - returns raw bytes of saved transactions. Transactions must have ids.
+func rawTxs(txs []*storage.Tx) []storage.RawTx {
+	raws := make([]storage.RawTx, 0, len(txs))
+	for i := range txs {
+		if txs[i].Raw == nil || len(txs[i].Raw.Data) == 0 {
+			continue
+		}
+		raws = append(raws, storage.RawTx{
+			Id:          txs[i].Id,
+			Height:      txs[i].Height,
+			Time:        txs[i].Time,
+			Compression: txs[i].Raw.Compression,
+			Data:        txs[i].Raw.Data,
+		})
+	}
+	return raws
 }
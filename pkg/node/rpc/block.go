@@ -10,6 +10,7 @@ import (
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 
 	"github.com/celenium-io/astria-indexer/pkg/node/types"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
 )
 
@@ -33,9 +34,10 @@ func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.Resul
 	return gbr.Result, nil
 }
 
+// BlockData - requests block and block results in a batch. Results are kept as they were received from the node.
 func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
-	block := types.Response[pkgTypes.ResultBlock]{}
-	results := types.Response[pkgTypes.ResultBlockResults]{}
+	block := types.Response[jsoniter.RawMessage]{}
+	results := types.Response[jsoniter.RawMessage]{}
 
 	responses := []any{
 		&block,
@@ -75,25 +77,54 @@ func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.B
 		return blockData, errors.Wrapf(types.ErrRequest, "request error: %s", results.Error.Error())
 	}
 
-	blockData.ResultBlock = block.Result
-	blockData.ResultBlockResults = results.Result
-	return blockData, nil
+	return decodeBlockData(block.Result, results.Result)
 }
 
+// BlockDataGet - requests block and block results one by one. Results are kept as they were received from the node.
 func (api *API) BlockDataGet(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
 	var blockData pkgTypes.BlockData
 
-	block, err := api.Block(ctx, level)
+	block, err := api.raw(ctx, pathBlock, level)
 	if err != nil {
 		return blockData, errors.Wrapf(types.ErrRequest, "request error: %s", err.Error())
 	}
 
-	results, err := api.BlockResults(ctx, level)
+	results, err := api.raw(ctx, pathBlockResults, level)
 	if err != nil {
 		return blockData, errors.Wrapf(types.ErrRequest, "request error: %s", err.Error())
 	}
 
-	blockData.ResultBlock = block
-	blockData.ResultBlockResults = results
+	return decodeBlockData(block, results)
+}
+
+func (api *API) raw(ctx context.Context, path string, level pkgTypes.Level) (jsoniter.RawMessage, error) {
+	args := make(map[string]string)
+	if level != 0 {
+		args["height"] = strconv.FormatUint(uint64(level), 10)
+	}
+
+	var gbr types.Response[jsoniter.RawMessage]
+	if err := api.get(ctx, path, args, &gbr); err != nil {
+		return nil, errors.Wrap(err, "api.get")
+	}
+
+	if gbr.Error != nil {
+		return nil, errors.Wrapf(types.ErrRequest, "request %d error: %s", gbr.Id, gbr.Error.Error())
+	}
+
+	return gbr.Result, nil
+}
+
+func decodeBlockData(block, results jsoniter.RawMessage) (pkgTypes.BlockData, error) {
+	blockData := pkgTypes.BlockData{
+		RawBlock:        block,
+		RawBlockResults: results,
+	}
+	if err := json.Unmarshal(block, &blockData.ResultBlock); err != nil {
+		return blockData, errors.Wrap(err, "decoding block")
+	}
+	if err := json.Unmarshal(results, &blockData.ResultBlockResults); err != nil {
+		return blockData, errors.Wrap(err, "decoding block results")
+	}
 	return blockData, nil
 }
@@ -6,4 +6,7 @@ package types
 type BlockData struct {
 	ResultBlock
 	ResultBlockResults
+
+	RawBlock        []byte `json:"-"` // result of `block` request as it was received from the node
+	RawBlockResults []byte `json:"-"` // result of `block_results` request as it was received from the node
 }